@@ -23,6 +23,8 @@ import (
 )
 
 func main() {
+	logger.SetDefault()
+
 	var resolver resource.AwsResourceResolver
 	var err error
 
@@ -19,4 +19,6 @@ package common
 const (
 	// DefaultWsStageName - Also used to connect to the ws, e.g. wss://<api-id>.execute-api.<region>.amazonaws.com/<stage>
 	DefaultWsStageName = "ws"
+	// ScheduleTriggerPrefix - Prefixes the names of the one-time schedules that trigger a schedule on demand, in the group of the schedule
+	ScheduleTriggerPrefix = "nitric-trigger-"
 )
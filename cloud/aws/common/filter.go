@@ -0,0 +1,57 @@
+// Copyright 2021 Nitric Technologies Pty Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package common
+
+import (
+	"encoding/json"
+	"fmt"
+
+	topicspb "github.com/nitrictech/nitric/core/pkg/proto/topics/v1"
+	"github.com/nitrictech/nitric/core/pkg/workers/topics"
+)
+
+// SnsFilterPolicy converts a subscription filter to an SNS filter policy on message attributes
+func SnsFilterPolicy(filter *topicspb.SubscriptionFilter) (string, error) {
+	if err := topics.ValidateFilter(filter); err != nil {
+		return "", err
+	}
+
+	policy := map[string]interface{}{}
+
+	for _, attributeFilter := range filter.GetAttributes() {
+		var rule interface{}
+
+		switch condition := attributeFilter.GetCondition().(type) {
+		case *topicspb.AttributeFilter_AnyOf:
+			rule = condition.AnyOf.GetValues()
+		case *topicspb.AttributeFilter_NoneOf:
+			rule = []interface{}{map[string]interface{}{"anything-but": condition.NoneOf.GetValues()}}
+		case *topicspb.AttributeFilter_Prefix:
+			rule = []interface{}{map[string]interface{}{"prefix": condition.Prefix}}
+		case *topicspb.AttributeFilter_Exists:
+			rule = []interface{}{map[string]interface{}{"exists": condition.Exists}}
+		}
+
+		if _, ok := policy[attributeFilter.GetName()]; ok {
+			return "", fmt.Errorf("SNS filter policies support a single filter per attribute, attribute %s has several", attributeFilter.GetName())
+		}
+
+		policy[attributeFilter.GetName()] = rule
+	}
+
+	policyJson, err := json.Marshal(policy)
+
+	return string(policyJson), err
+}
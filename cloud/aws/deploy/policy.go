@@ -43,9 +43,12 @@ var awsActionsMap map[resourcespb.Action][]string = map[resourcespb.Action][]str
 	},
 	resourcespb.Action_BucketFileGet: {
 		"s3:GetObject",
+		"s3:GetObjectTagging", // required to copy a tagged object out of the bucket
 	},
 	resourcespb.Action_BucketFilePut: {
 		"s3:PutObject",
+		"s3:PutObjectTagging",     // required to copy a tagged object into the bucket
+		"s3:AbortMultipartUpload", // required to clean up failed streamed writes and large copies
 	},
 	resourcespb.Action_BucketFileDelete: {
 		"s3:DeleteObject",
@@ -86,6 +89,7 @@ var awsActionsMap map[resourcespb.Action][]string = map[resourcespb.Action][]str
 	resourcespb.Action_QueueDequeue: {
 		"sqs:ReceiveMessage",
 		"sqs:DeleteMessage",
+		"sqs:ChangeMessageVisibility",
 		"sqs:GetQueueAttributes",
 		"sqs:GetQueueUrl",
 		"sqs:ListQueueTags",
@@ -93,6 +97,14 @@ var awsActionsMap map[resourcespb.Action][]string = map[resourcespb.Action][]str
 	resourcespb.Action_JobSubmit: {
 		"batch:SubmitJob",
 	},
+	resourcespb.Action_ScheduleManage: {
+		"scheduler:GetSchedule",
+		"scheduler:UpdateSchedule",
+		// schedules are triggered through a one-time schedule with the same target, see common.ScheduleTriggerPrefix
+		"scheduler:CreateSchedule",
+		// creating or updating a schedule passes its execution role to the scheduler, the role can only invoke the schedule's target
+		"iam:PassRole",
+	},
 }
 
 func actionsToAwsActions(actions []resourcespb.Action) []string {
@@ -134,6 +146,10 @@ func (a *NitricAwsPulumiProvider) arnForResource(resource *deploymentspb.Resourc
 		if w, ok := a.Websockets[resource.Id.Name]; ok {
 			return []interface{}{pulumi.Sprintf("%s/*", w.ExecutionArn)}, nil
 		}
+	case resourcespb.ResourceType_Schedule:
+		if s, ok := a.Schedules[resource.Id.Name]; ok {
+			return []interface{}{s.Arn, scheduleTriggerArns(s.Arn), s.Target.RoleArn()}, nil
+		}
 	case resourcespb.ResourceType_Job:
 		if l, ok := a.JobDefinitions[resource.Id.Name]; ok {
 			// replace the revision with a wildcard
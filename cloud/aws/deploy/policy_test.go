@@ -0,0 +1,56 @@
+// Copyright Nitric Pty Ltd.
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at:
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package deploy
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	resourcespb "github.com/nitrictech/nitric/core/pkg/proto/resources/v1"
+)
+
+var _ = Describe("Policy", func() {
+	When("granting the actions required to copy a file", func() {
+		It("should allow the source file and its tags to be read", func() {
+			Expect(actionsToAwsActions([]resourcespb.Action{resourcespb.Action_BucketFileGet})).To(ContainElements(
+				"s3:GetObject",
+				"s3:GetObjectTagging",
+			))
+		})
+
+		It("should allow the destination file and its tags to be written in parts", func() {
+			Expect(actionsToAwsActions([]resourcespb.Action{resourcespb.Action_BucketFilePut})).To(ContainElements(
+				"s3:PutObject",
+				"s3:PutObjectTagging",
+				"s3:AbortMultipartUpload",
+			))
+		})
+	})
+
+	When("granting the actions required to move a file", func() {
+		It("should allow the source file to be deleted", func() {
+			Expect(actionsToAwsActions([]resourcespb.Action{
+				resourcespb.Action_BucketFileGet,
+				resourcespb.Action_BucketFileDelete,
+			})).To(ContainElements(
+				"s3:GetObject",
+				"s3:GetObjectTagging",
+				"s3:DeleteObject",
+			))
+		})
+	})
+})
@@ -83,6 +83,11 @@ func (a *NitricAwsPulumiProvider) resourcesStore(ctx *pulumi.Context) error {
 		httpProxyEndpointMap[name] = proxy.ApiEndpoint
 	}
 
+	scheduleArnMap := pulumi.StringMap{}
+	for name, schedule := range a.Schedules {
+		scheduleArnMap[name] = schedule.Arn
+	}
+
 	// Build the index from the provider information
 	resourceIndexJson := pulumi.All(
 		bucketArnMap,
@@ -97,6 +102,7 @@ func (a *NitricAwsPulumiProvider) resourcesStore(ctx *pulumi.Context) error {
 		stateMachArnMap,
 		httpProxyArnMap,
 		httpProxyEndpointMap,
+		scheduleArnMap,
 	).ApplyT(func(args []interface{}) (string, error) {
 		bucketNameMap := args[0].(map[string]string)
 		apiArnMap := args[1].(map[string]string)
@@ -110,6 +116,7 @@ func (a *NitricAwsPulumiProvider) resourcesStore(ctx *pulumi.Context) error {
 		stateMachArnMap := args[9].(map[string]string)
 		httpProxyArnMap := args[10].(map[string]string)
 		httpProxyEndpointMap := args[11].(map[string]string)
+		scheduleArnMap := args[12].(map[string]string)
 
 		index := common.NewResourceIndex()
 		for name, bucket := range bucketNameMap {
@@ -156,6 +163,10 @@ func (a *NitricAwsPulumiProvider) resourcesStore(ctx *pulumi.Context) error {
 			index.Secrets[name] = arn
 		}
 
+		for name, arn := range scheduleArnMap {
+			index.Schedules[name] = arn
+		}
+
 		indexJson, err := json.Marshal(index)
 		if err != nil {
 			return "", err
@@ -18,7 +18,9 @@ package deploy
 
 import (
 	"fmt"
+	"strings"
 
+	"github.com/nitrictech/nitric/cloud/aws/common"
 	"github.com/nitrictech/nitric/cloud/aws/deploy/embeds"
 	deploymentspb "github.com/nitrictech/nitric/core/pkg/proto/deployments/v1"
 	"github.com/pulumi/pulumi-aws/sdk/v5/go/aws/iam"
@@ -96,3 +98,10 @@ func (a *NitricAwsPulumiProvider) Schedule(ctx *pulumi.Context, parent pulumi.Re
 
 	return nil
 }
+
+// scheduleTriggerArns matches the one-time schedules that trigger the schedule, they're created in its group
+func scheduleTriggerArns(scheduleArn pulumi.StringOutput) pulumi.StringOutput {
+	return scheduleArn.ApplyT(func(arn string) string {
+		return arn[:strings.LastIndex(arn, "/")+1] + common.ScheduleTriggerPrefix + "*"
+	}).(pulumi.StringOutput)
+}
@@ -174,6 +174,7 @@ func (a *NitricAwsPulumiProvider) Service(ctx *pulumi.Context, parent pulumi.Res
 	envVars := pulumi.StringMap{
 		"NITRIC_ENVIRONMENT":     pulumi.String("cloud"),
 		"NITRIC_STACK_ID":        pulumi.String(a.StackId),
+		"NITRIC_SERVICE_NAME":    pulumi.String(name),
 		"MIN_WORKERS":            pulumi.String(fmt.Sprint(config.Workers)),
 		"NITRIC_HTTP_PROXY_PORT": pulumi.String(fmt.Sprint(3000)),
 	}
@@ -20,15 +20,18 @@ import (
 	"encoding/json"
 	"fmt"
 
+	awscommon "github.com/nitrictech/nitric/cloud/aws/common"
 	"github.com/nitrictech/nitric/cloud/common/deploy/resources"
 	common "github.com/nitrictech/nitric/cloud/common/deploy/tags"
 	deploymentspb "github.com/nitrictech/nitric/core/pkg/proto/deployments/v1"
+	topicspb "github.com/nitrictech/nitric/core/pkg/proto/topics/v1"
 	"github.com/pkg/errors"
 	"github.com/pulumi/pulumi-aws/sdk/v5/go/aws/iam"
 	"github.com/pulumi/pulumi-aws/sdk/v5/go/aws/lambda"
 	awslambda "github.com/pulumi/pulumi-aws/sdk/v5/go/aws/lambda"
 	"github.com/pulumi/pulumi-aws/sdk/v5/go/aws/sfn"
 	"github.com/pulumi/pulumi-aws/sdk/v5/go/aws/sns"
+	"github.com/pulumi/pulumi-aws/sdk/v5/go/aws/sqs"
 	"github.com/pulumi/pulumi/sdk/v3/go/pulumi"
 )
 
@@ -37,7 +40,24 @@ type topic struct {
 	sfn *sfn.StateMachine
 }
 
-func createSubscription(ctx *pulumi.Context, parent pulumi.Resource, name string, topic *sns.Topic, target *lambda.Function) error {
+// withFilterPolicy applies the subscription filter to the SNS subscription, if the subscription has one
+func withFilterPolicy(args *sns.TopicSubscriptionArgs, filter *topicspb.SubscriptionFilter) error {
+	if len(filter.GetAttributes()) == 0 {
+		return nil
+	}
+
+	policy, err := awscommon.SnsFilterPolicy(filter)
+	if err != nil {
+		return err
+	}
+
+	args.FilterPolicy = pulumi.String(policy)
+	args.FilterPolicyScope = pulumi.String("MessageAttributes")
+
+	return nil
+}
+
+func createSubscription(ctx *pulumi.Context, parent pulumi.Resource, name string, topic *sns.Topic, target *lambda.Function, filter *topicspb.SubscriptionFilter) error {
 	var err error
 
 	opts := []pulumi.ResourceOption{pulumi.Parent(parent)}
@@ -52,15 +72,236 @@ func createSubscription(ctx *pulumi.Context, parent pulumi.Resource, name string
 		return err
 	}
 
-	_, err = sns.NewTopicSubscription(ctx, name+"Subscription", &sns.TopicSubscriptionArgs{
+	subscriptionArgs := &sns.TopicSubscriptionArgs{
 		Endpoint: target.Arn,
 		Protocol: pulumi.String("lambda"),
 		Topic:    topic.ID(),
+	}
+
+	if err := withFilterPolicy(subscriptionArgs, filter); err != nil {
+		return errors.WithMessagef(err, "subscription %s", name)
+	}
+
+	_, err = sns.NewTopicSubscription(ctx, name+"Subscription", subscriptionArgs, opts...)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+const (
+	// defaultMaxReceiveCount is the number of delivery attempts before a message is dead-lettered, if not configured
+	defaultMaxReceiveCount = 3
+	// defaultLambdaTimeout is the AWS default lambda timeout, used when the service doesn't configure one
+	defaultLambdaTimeout = 3
+	// maxVisibilityTimeout is the maximum SQS visibility timeout (12 hours)
+	maxVisibilityTimeout = 43200
+	// minMessageRetention is the minimum SQS message retention period (1 minute)
+	minMessageRetention = 60
+)
+
+// subscriptionRedrivePolicy returns the SQS redrive policy for a subscription's delivery queue,
+// an empty policy is returned if the subscription doesn't limit delivery attempts
+func (a *NitricAwsPulumiProvider) subscriptionRedrivePolicy(ctx *pulumi.Context, subName string, subscription *deploymentspb.SubscriptionTarget, fifo bool, opts []pulumi.ResourceOption) (pulumi.StringInput, error) {
+	maxAttempts := subscription.GetRetryPolicy().GetMaxAttempts()
+	if maxAttempts < 0 {
+		return nil, fmt.Errorf("invalid retry policy: max_attempts must not be negative")
+	}
+
+	var deadLetterArn pulumi.StringOutput
+
+	switch {
+	case subscription.GetDeadLetter() != nil:
+		queueName := subscription.GetDeadLetter().GetQueue()
+		if queueName == "" {
+			return nil, fmt.Errorf("unsupported dead-letter destination: only queues are supported on AWS")
+		}
+
+		// the delivery queues of ordered topics are FIFO queues, which can only dead-letter to FIFO queues
+		if fifo {
+			return nil, fmt.Errorf("subscriptions to ordered topics can't dead-letter to queues on AWS")
+		}
+
+		deadLetterQueue, ok := a.Queues[queueName]
+		if !ok {
+			return nil, fmt.Errorf("unable to find dead-letter queue %s", queueName)
+		}
+
+		deadLetterArn = deadLetterQueue.Arn
+	case maxAttempts > 0:
+		// SQS only limits receives by redriving messages, so messages that exhaust their attempts
+		// are moved to a queue owned by the subscription which discards them
+		discardQueue, err := sqs.NewQueue(ctx, subName+"-discarded", &sqs.QueueArgs{
+			MessageRetentionSeconds: pulumi.Int(minMessageRetention),
+			FifoQueue:               pulumi.Bool(fifo),
+		}, opts...)
+		if err != nil {
+			return nil, errors.WithMessage(err, "subscription discard queue")
+		}
+
+		deadLetterArn = discardQueue.Arn
+	default:
+		return pulumi.String(""), nil
+	}
+
+	if maxAttempts == 0 {
+		maxAttempts = defaultMaxReceiveCount
+	}
+
+	return deadLetterArn.ApplyT(func(arn string) (string, error) {
+		policy, err := json.Marshal(map[string]interface{}{
+			"deadLetterTargetArn": arn,
+			"maxReceiveCount":     maxAttempts,
+		})
+
+		return string(policy), err
+	}).(pulumi.StringOutput), nil
+}
+
+// createQueuedSubscription delivers topic messages to the target lambda through an SQS queue,
+// enabling retries with backoff and dead-lettering of messages that fail delivery.
+// Ordered topics deliver through FIFO queues, as FIFO topics can't deliver to lambdas.
+func (a *NitricAwsPulumiProvider) createQueuedSubscription(ctx *pulumi.Context, parent pulumi.Resource, name string, topic *sns.Topic, subscription *deploymentspb.SubscriptionTarget, fifo bool) error {
+	serviceName := subscription.GetService()
+	subName := fmt.Sprintf("%s-%s", name, serviceName)
+
+	opts := []pulumi.ResourceOption{pulumi.Parent(parent)}
+
+	target, ok := a.Lambdas[serviceName]
+	if !ok {
+		return fmt.Errorf("unable to find lambda %s for subscription", serviceName)
+	}
+
+	role, ok := a.LambdaRoles[serviceName]
+	if !ok {
+		return fmt.Errorf("unable to find role for lambda %s for subscription", serviceName)
+	}
+
+	retryPolicy := subscription.GetRetryPolicy()
+	if retryPolicy.GetMinBackoffSeconds() < 0 || retryPolicy.GetMaxBackoffSeconds() < 0 {
+		return fmt.Errorf("invalid retry policy: backoff must not be negative")
+	}
+
+	if retryPolicy.GetMaxBackoffSeconds() > 0 {
+		_ = ctx.Log.Warn(fmt.Sprintf("subscription %s: max_backoff_seconds is not supported on AWS, messages are retried after min_backoff_seconds", subName), nil)
+	}
+
+	redrivePolicy, err := a.subscriptionRedrivePolicy(ctx, subName, subscription, fifo, opts)
+	if err != nil {
+		return errors.WithMessagef(err, "subscription %s", subName)
+	}
+
+	// failed messages become visible for redelivery once the visibility timeout expires,
+	// which must be no less than the lambda timeout
+	minBackoff := int(retryPolicy.GetMinBackoffSeconds())
+	if minBackoff > maxVisibilityTimeout {
+		return fmt.Errorf("subscription %s: min_backoff_seconds must not exceed %d", subName, maxVisibilityTimeout)
+	}
+
+	visibilityTimeout := target.Timeout.ApplyT(func(timeout *int) int {
+		lambdaTimeout := defaultLambdaTimeout
+		if timeout != nil {
+			lambdaTimeout = *timeout
+		}
+
+		if minBackoff > lambdaTimeout {
+			return minBackoff
+		}
+
+		return lambdaTimeout
+	}).(pulumi.IntOutput)
+
+	// the delivery queue isn't tagged as a nitric queue, so it isn't resolvable at runtime
+	queue, err := sqs.NewQueue(ctx, subName+"-delivery", &sqs.QueueArgs{
+		VisibilityTimeoutSeconds: visibilityTimeout,
+		RedrivePolicy:            redrivePolicy,
+		FifoQueue:                pulumi.Bool(fifo),
+	}, opts...)
+	if err != nil {
+		return errors.WithMessage(err, "subscription delivery queue")
+	}
+
+	queuePolicy, err := sqs.NewQueuePolicy(ctx, subName+"-delivery", &sqs.QueuePolicyArgs{
+		QueueUrl: queue.Url,
+		Policy: pulumi.All(queue.Arn, topic.Arn).ApplyT(func(args []interface{}) (string, error) {
+			policy, err := json.Marshal(map[string]interface{}{
+				"Version": "2012-10-17",
+				"Statement": []map[string]interface{}{
+					{
+						"Effect": "Allow",
+						"Principal": map[string]interface{}{
+							"Service": "sns.amazonaws.com",
+						},
+						"Action":   "sqs:SendMessage",
+						"Resource": args[0],
+						"Condition": map[string]interface{}{
+							"ArnEquals": map[string]interface{}{
+								"aws:SourceArn": args[1],
+							},
+						},
+					},
+				},
+			})
+
+			return string(policy), err
+		}).(pulumi.StringOutput),
 	}, opts...)
+	if err != nil {
+		return errors.WithMessage(err, "subscription delivery queue policy")
+	}
+
+	rolePolicy, err := iam.NewRolePolicy(ctx, subName+"-delivery", &iam.RolePolicyArgs{
+		Role: role.ID(),
+		Policy: queue.Arn.ApplyT(func(arn string) (string, error) {
+			policy, err := json.Marshal(map[string]interface{}{
+				"Version": "2012-10-17",
+				"Statement": []map[string]interface{}{
+					{
+						"Effect": "Allow",
+						"Action": []string{
+							"sqs:ReceiveMessage",
+							"sqs:DeleteMessage",
+							"sqs:GetQueueAttributes",
+							"sqs:ChangeMessageVisibility",
+						},
+						"Resource": arn,
+					},
+				},
+			})
+
+			return string(policy), err
+		}).(pulumi.StringOutput),
+	}, opts...)
+	if err != nil {
+		return errors.WithMessage(err, "subscription delivery role policy")
+	}
+
+	subscriptionArgs := &sns.TopicSubscriptionArgs{
+		Endpoint: queue.Arn,
+		Protocol: pulumi.String("sqs"),
+		Topic:    topic.ID(),
+	}
+
+	if err := withFilterPolicy(subscriptionArgs, subscription.GetFilter()); err != nil {
+		return errors.WithMessagef(err, "subscription %s", subName)
+	}
+
+	_, err = sns.NewTopicSubscription(ctx, subName+"Subscription", subscriptionArgs, append(opts, pulumi.DependsOn([]pulumi.Resource{queuePolicy}))...)
 	if err != nil {
 		return err
 	}
 
+	// messages are delivered one at a time, so a failure only retries the failed message
+	_, err = awslambda.NewEventSourceMapping(ctx, subName+"-delivery", &awslambda.EventSourceMappingArgs{
+		EventSourceArn: queue.Arn,
+		FunctionName:   target.Arn,
+		BatchSize:      pulumi.Int(1),
+	}, append(opts, pulumi.DependsOn([]pulumi.Resource{rolePolicy}))...)
+	if err != nil {
+		return errors.WithMessage(err, "subscription event source mapping")
+	}
+
 	return nil
 }
 
@@ -71,9 +312,10 @@ func (a *NitricAwsPulumiProvider) Topic(ctx *pulumi.Context, parent pulumi.Resou
 
 	opts := []pulumi.ResourceOption{pulumi.Parent(parent)}
 
-	// create the SNS topic
+	// create the SNS topic, ordered topics are FIFO topics
 	a.Topics[name].sns, err = sns.NewTopic(ctx, name, &sns.TopicArgs{
-		Tags: pulumi.ToStringMap(common.Tags(a.StackId, name, resources.Topic)),
+		Tags:      pulumi.ToStringMap(common.Tags(a.StackId, name, resources.Topic)),
+		FifoTopic: pulumi.Bool(config.GetOrdered()),
 	}, opts...)
 	if err != nil {
 		return err
@@ -130,6 +372,18 @@ func (a *NitricAwsPulumiProvider) Topic(ctx *pulumi.Context, parent pulumi.Resou
 	}
 
 	sfnDef := a.Topics[name].sns.Arn.ApplyT(func(arn string) (string, error) {
+		publishParameters := map[string]string{
+			"TopicArn":            arn,
+			"Message.$":           "$.message",
+			"MessageAttributes.$": "$.attributes",
+		}
+
+		// FIFO topics require the message group and deduplication ids of each message
+		if config.GetOrdered() {
+			publishParameters["MessageGroupId.$"] = "$.groupId"
+			publishParameters["MessageDeduplicationId.$"] = "$.deduplicationId"
+		}
+
 		def, err := json.Marshal(map[string]interface{}{
 			"Comment": "",
 			"StartAt": "Wait",
@@ -140,13 +394,10 @@ func (a *NitricAwsPulumiProvider) Topic(ctx *pulumi.Context, parent pulumi.Resou
 					"Next":        "Publish",
 				},
 				"Publish": map[string]interface{}{
-					"Type":     "Task",
-					"Resource": "arn:aws:states:::sns:publish",
-					"Parameters": map[string]string{
-						"TopicArn":  arn,
-						"Message.$": "$.message",
-					},
-					"End": true,
+					"Type":       "Task",
+					"Resource":   "arn:aws:states:::sns:publish",
+					"Parameters": publishParameters,
+					"End":        true,
 				},
 			},
 		})
@@ -167,12 +418,21 @@ func (a *NitricAwsPulumiProvider) Topic(ctx *pulumi.Context, parent pulumi.Resou
 	}
 
 	for _, sub := range config.Subscriptions {
+		if config.GetOrdered() || sub.GetRetryPolicy() != nil || sub.GetDeadLetter() != nil {
+			err := a.createQueuedSubscription(ctx, parent, name, a.Topics[name].sns, sub, config.GetOrdered())
+			if err != nil {
+				return err
+			}
+
+			continue
+		}
+
 		targetLambda, ok := a.Lambdas[sub.GetService()]
 		if !ok {
 			return fmt.Errorf("unable to find lambda %s for subscription", sub.GetService())
 		}
 
-		err := createSubscription(ctx, parent, name, a.Topics[name].sns, targetLambda)
+		err := createSubscription(ctx, parent, name, a.Topics[name].sns, targetLambda, sub.GetFilter())
 		if err != nil {
 			return err
 		}
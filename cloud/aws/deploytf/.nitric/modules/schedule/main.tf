@@ -44,6 +44,8 @@ resource "aws_scheduler_schedule" "schedule" {
 
     input = jsonencode({
         "x-nitric-schedule": var.schedule_name
+        "x-nitric-scheduled-time": "<aws.scheduler.scheduled-time>"
+        "x-nitric-attempt": "<aws.scheduler.attempt-number>"
     })
   }
 }
\ No newline at end of file
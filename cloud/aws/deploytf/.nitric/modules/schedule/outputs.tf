@@ -0,0 +1,14 @@
+output "schedule_arn" {
+  description = "The ARN of the deployed schedule"
+  value       = aws_scheduler_schedule.schedule.arn
+}
+
+output "role_arn" {
+  description = "The ARN of the role the schedule invokes its target with"
+  value       = aws_iam_role.role.arn
+}
+
+output "trigger_schedule_arns" {
+  description = "The ARN pattern of the one-time schedules that trigger the schedule on demand, named with the nitric-trigger- prefix in its group"
+  value       = "${dirname(aws_scheduler_schedule.schedule.arn)}/nitric-trigger-*"
+}
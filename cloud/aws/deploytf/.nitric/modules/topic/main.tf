@@ -9,9 +9,10 @@ resource "random_id" "topic_id" {
 }
 
 
-# AWS SNS Topic
+# AWS SNS Topic, ordered topics are FIFO topics
 resource "aws_sns_topic" "topic" {
-  name = "${var.topic_name}-${random_id.topic_id.hex}"
+  name       = "${var.topic_name}-${random_id.topic_id.hex}${var.ordered ? ".fifo" : ""}"
+  fifo_topic = var.ordered
 
   tags = {
     "x-nitric-${var.stack_id}-name" = var.topic_name
@@ -23,9 +24,11 @@ resource "aws_sns_topic" "topic" {
 resource "aws_sns_topic_subscription" "subscription" {
   for_each = var.lambda_subscribers
 
-  topic_arn = aws_sns_topic.topic.arn
-  protocol  = "lambda"
-  endpoint  = each.value
+  topic_arn           = aws_sns_topic.topic.arn
+  protocol            = "lambda"
+  endpoint            = each.value
+  filter_policy       = lookup(var.filter_policies, each.key, null)
+  filter_policy_scope = contains(keys(var.filter_policies), each.key) ? "MessageAttributes" : null
 }
 
 resource "aws_lambda_permission" "sns" {
@@ -37,6 +40,99 @@ resource "aws_lambda_permission" "sns" {
   source_arn    = aws_sns_topic.topic.arn
 }
 
+# SQS only limits receives by redriving messages, so messages that exhaust their attempts
+# without a dead-letter destination are moved to a queue that discards them
+resource "aws_sqs_queue" "discarded" {
+  for_each = {
+    for name, subscriber in var.queued_subscribers : name => subscriber
+    if subscriber.max_receive_count != null && subscriber.dead_letter_arn == null
+  }
+
+  message_retention_seconds = 60
+  fifo_queue                = var.ordered
+}
+
+# Queued subscribers receive messages through an SQS queue, to retry failed deliveries with backoff and dead-letter them.
+# Ordered topics deliver through FIFO queues, as FIFO topics can't deliver to lambdas
+resource "aws_sqs_queue" "delivery" {
+  for_each = var.queued_subscribers
+
+  visibility_timeout_seconds = each.value.visibility_timeout
+  fifo_queue                 = var.ordered
+  redrive_policy = each.value.max_receive_count == null ? null : jsonencode({
+    deadLetterTargetArn = each.value.dead_letter_arn != null ? each.value.dead_letter_arn : try(aws_sqs_queue.discarded[each.key].arn, null)
+    maxReceiveCount     = each.value.max_receive_count
+  })
+}
+
+resource "aws_sqs_queue_policy" "delivery" {
+  for_each = var.queued_subscribers
+
+  queue_url = aws_sqs_queue.delivery[each.key].id
+  policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [
+      {
+        Effect = "Allow"
+        Principal = {
+          Service = "sns.amazonaws.com"
+        }
+        Action   = "sqs:SendMessage"
+        Resource = aws_sqs_queue.delivery[each.key].arn
+        Condition = {
+          ArnEquals = {
+            "aws:SourceArn" = aws_sns_topic.topic.arn
+          }
+        }
+      }
+    ]
+  })
+}
+
+resource "aws_iam_role_policy" "delivery" {
+  for_each = var.queued_subscribers
+
+  role = each.value.lambda_role_name
+  policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [
+      {
+        Effect = "Allow"
+        Action = [
+          "sqs:ReceiveMessage",
+          "sqs:DeleteMessage",
+          "sqs:GetQueueAttributes",
+          "sqs:ChangeMessageVisibility"
+        ]
+        Resource = aws_sqs_queue.delivery[each.key].arn
+      }
+    ]
+  })
+}
+
+resource "aws_sns_topic_subscription" "queued_subscription" {
+  for_each = var.queued_subscribers
+
+  topic_arn           = aws_sns_topic.topic.arn
+  protocol            = "sqs"
+  endpoint            = aws_sqs_queue.delivery[each.key].arn
+  filter_policy       = lookup(var.filter_policies, each.key, null)
+  filter_policy_scope = contains(keys(var.filter_policies), each.key) ? "MessageAttributes" : null
+
+  depends_on = [aws_sqs_queue_policy.delivery]
+}
+
+# Messages are delivered one at a time, so a failure only retries the failed message
+resource "aws_lambda_event_source_mapping" "delivery" {
+  for_each = var.queued_subscribers
+
+  event_source_arn = aws_sqs_queue.delivery[each.key].arn
+  function_name    = each.value.lambda_arn
+  batch_size       = 1
+
+  depends_on = [aws_iam_role_policy.delivery]
+}
+
 resource "aws_iam_role" "sns_publish_role" {
   name = "${var.topic_name}-sns-publish-role"
 
@@ -72,6 +168,18 @@ resource "aws_iam_role_policy" "publish_policy" {
   })
 }
 
+locals {
+  # FIFO topics require the message group and deduplication ids of each message
+  publish_parameters = merge({
+    TopicArn = aws_sns_topic.topic.arn,
+    "Message.$" : "$.message",
+    "MessageAttributes.$" : "$.attributes",
+  }, var.ordered ? {
+    "MessageGroupId.$" : "$.groupId",
+    "MessageDeduplicationId.$" : "$.deduplicationId",
+  } : {})
+}
+
 # Create a step function that publishes to the topic
 resource "aws_sfn_state_machine" "publish_to_topic" {
   name     = "${var.topic_name}-publish-to-topic"
@@ -88,10 +196,7 @@ resource "aws_sfn_state_machine" "publish_to_topic" {
       Publish = {
         Type     = "Task",
         Resource = "arn:aws:states:::sns:publish",
-        Parameters = {
-          TopicArn = aws_sns_topic.topic.arn,
-          "Message.$" : "$.message",
-        },
+        Parameters = local.publish_parameters,
         End = true
       }
     }
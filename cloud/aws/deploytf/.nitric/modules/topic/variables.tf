@@ -12,3 +12,27 @@ variable "lambda_subscribers" {
   description = "A list of lambda ARNs to subscribe to the topic"
   type        = map(string)
 }
+
+variable "queued_subscribers" {
+  description = "Lambdas to subscribe to the topic through an SQS delivery queue, to retry and dead-letter failed deliveries"
+  type = map(object({
+    lambda_arn         = string
+    lambda_role_name   = string
+    visibility_timeout = number
+    max_receive_count  = optional(number)
+    dead_letter_arn    = optional(string)
+  }))
+  default = {}
+}
+
+variable "filter_policies" {
+  description = "SNS filter policies on message attributes, by subscriber"
+  type        = map(string)
+  default     = {}
+}
+
+variable "ordered" {
+  description = "Deliver messages with the same ordering key in order, as a FIFO topic"
+  type        = bool
+  default     = false
+}
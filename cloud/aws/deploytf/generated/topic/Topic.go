@@ -22,6 +22,8 @@ type Topic interface {
 	DependsOn() *[]*string
 	// Experimental.
 	SetDependsOn(val *[]*string)
+	FilterPolicies() *map[string]*string
+	SetFilterPolicies(val *map[string]*string)
 	// Experimental.
 	ForEach() cdktf.ITerraformIterator
 	// Experimental.
@@ -34,8 +36,12 @@ type Topic interface {
 	SetLambdaSubscribers(val *map[string]*string)
 	// The tree node.
 	Node() constructs.Node
+	Ordered() *bool
+	SetOrdered(val *bool)
 	// Experimental.
 	Providers() *[]interface{}
+	QueuedSubscribers() interface{}
+	SetQueuedSubscribers(val interface{})
 	// Experimental.
 	RawOverrides() interface{}
 	SfnArnOutput() *string
@@ -111,6 +117,16 @@ func (j *jsiiProxy_Topic) DependsOn() *[]*string {
 	return returns
 }
 
+func (j *jsiiProxy_Topic) FilterPolicies() *map[string]*string {
+	var returns *map[string]*string
+	_jsii_.Get(
+		j,
+		"filterPolicies",
+		&returns,
+	)
+	return returns
+}
+
 func (j *jsiiProxy_Topic) ForEach() cdktf.ITerraformIterator {
 	var returns cdktf.ITerraformIterator
 	_jsii_.Get(
@@ -161,6 +177,16 @@ func (j *jsiiProxy_Topic) Node() constructs.Node {
 	return returns
 }
 
+func (j *jsiiProxy_Topic) Ordered() *bool {
+	var returns *bool
+	_jsii_.Get(
+		j,
+		"ordered",
+		&returns,
+	)
+	return returns
+}
+
 func (j *jsiiProxy_Topic) Providers() *[]interface{} {
 	var returns *[]interface{}
 	_jsii_.Get(
@@ -171,6 +197,16 @@ func (j *jsiiProxy_Topic) Providers() *[]interface{} {
 	return returns
 }
 
+func (j *jsiiProxy_Topic) QueuedSubscribers() interface{} {
+	var returns interface{}
+	_jsii_.Get(
+		j,
+		"queuedSubscribers",
+		&returns,
+	)
+	return returns
+}
+
 func (j *jsiiProxy_Topic) RawOverrides() interface{} {
 	var returns interface{}
 	_jsii_.Get(
@@ -287,6 +323,14 @@ func (j *jsiiProxy_Topic)SetDependsOn(val *[]*string) {
 	)
 }
 
+func (j *jsiiProxy_Topic)SetFilterPolicies(val *map[string]*string) {
+	_jsii_.Set(
+		j,
+		"filterPolicies",
+		val,
+	)
+}
+
 func (j *jsiiProxy_Topic)SetForEach(val cdktf.ITerraformIterator) {
 	_jsii_.Set(
 		j,
@@ -306,6 +350,25 @@ func (j *jsiiProxy_Topic)SetLambdaSubscribers(val *map[string]*string) {
 	)
 }
 
+func (j *jsiiProxy_Topic)SetOrdered(val *bool) {
+	_jsii_.Set(
+		j,
+		"ordered",
+		val,
+	)
+}
+
+func (j *jsiiProxy_Topic)SetQueuedSubscribers(val interface{}) {
+	if err := j.validateSetQueuedSubscribersParameters(val); err != nil {
+		panic(err)
+	}
+	_jsii_.Set(
+		j,
+		"queuedSubscribers",
+		val,
+	)
+}
+
 func (j *jsiiProxy_Topic)SetStackId(val *string) {
 	if err := j.validateSetStackIdParameters(val); err != nil {
 		panic(err)
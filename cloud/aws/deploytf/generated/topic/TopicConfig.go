@@ -19,5 +19,11 @@ type TopicConfig struct {
 	StackId *string `field:"required" json:"stackId" yaml:"stackId"`
 	// The name of the topic.
 	TopicName *string `field:"required" json:"topicName" yaml:"topicName"`
+	// SNS filter policies on message attributes, by subscriber The property type contains a map, they have special handling, please see {@link cdk.tf /module-map-inputs the docs}.
+	FilterPolicies *map[string]*string `field:"optional" json:"filterPolicies" yaml:"filterPolicies"`
+	// Deliver messages with the same ordering key in order, as a FIFO topic.
+	Ordered *bool `field:"optional" json:"ordered" yaml:"ordered"`
+	// Lambdas to subscribe to the topic through an SQS delivery queue, to retry and dead-letter failed deliveries.
+	QueuedSubscribers interface{} `field:"optional" json:"queuedSubscribers" yaml:"queuedSubscribers"`
 }
 
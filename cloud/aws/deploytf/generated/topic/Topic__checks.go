@@ -98,6 +98,14 @@ func (j *jsiiProxy_Topic) validateSetLambdaSubscribersParameters(val *map[string
 	return nil
 }
 
+func (j *jsiiProxy_Topic) validateSetQueuedSubscribersParameters(val interface{}) error {
+	if val == nil {
+		return fmt.Errorf("parameter val is required, but nil was provided")
+	}
+
+	return nil
+}
+
 func (j *jsiiProxy_Topic) validateSetStackIdParameters(val *string) error {
 	if val == nil {
 		return fmt.Errorf("parameter val is required, but nil was provided")
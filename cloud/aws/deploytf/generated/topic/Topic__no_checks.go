@@ -36,6 +36,10 @@ func (j *jsiiProxy_Topic) validateSetLambdaSubscribersParameters(val *map[string
 	return nil
 }
 
+func (j *jsiiProxy_Topic) validateSetQueuedSubscribersParameters(val interface{}) error {
+	return nil
+}
+
 func (j *jsiiProxy_Topic) validateSetStackIdParameters(val *string) error {
 	return nil
 }
@@ -31,9 +31,12 @@ var AwsActionsMap map[resourcespb.Action][]string = map[resourcespb.Action][]str
 	},
 	resourcespb.Action_BucketFileGet: {
 		"s3:GetObject",
+		"s3:GetObjectTagging", // required to copy a tagged object out of the bucket
 	},
 	resourcespb.Action_BucketFilePut: {
 		"s3:PutObject",
+		"s3:PutObjectTagging",     // required to copy a tagged object into the bucket
+		"s3:AbortMultipartUpload", // required to clean up failed streamed writes and large copies
 	},
 	resourcespb.Action_BucketFileDelete: {
 		"s3:DeleteObject",
@@ -74,10 +77,19 @@ var AwsActionsMap map[resourcespb.Action][]string = map[resourcespb.Action][]str
 	resourcespb.Action_QueueDequeue: {
 		"sqs:ReceiveMessage",
 		"sqs:DeleteMessage",
+		"sqs:ChangeMessageVisibility",
 		"sqs:GetQueueAttributes",
 		"sqs:GetQueueUrl",
 		"sqs:ListQueueTags",
 	},
+	resourcespb.Action_ScheduleManage: {
+		"scheduler:GetSchedule",
+		"scheduler:UpdateSchedule",
+		// schedules are triggered through a one-time schedule with the same target, see common.ScheduleTriggerPrefix
+		"scheduler:CreateSchedule",
+		// creating or updating a schedule passes its execution role to the scheduler, the role can only invoke the schedule's target
+		"iam:PassRole",
+	},
 }
 
 func ActionsToAwsActions(actions []resourcespb.Action) []string {
@@ -119,6 +131,10 @@ func (a *NitricAwsTerraformProvider) ArnForResource(resource *deploymentspb.Reso
 		if w, ok := a.Websockets[resource.Id.Name]; ok {
 			return []*string{jsii.String(fmt.Sprintf("%s/*", *w.WebsocketExecArnOutput()))}, nil
 		}
+	case resourcespb.ResourceType_Schedule:
+		if s, ok := a.Schedules[resource.Id.Name]; ok {
+			return []*string{scheduleOutput(s, "schedule_arn"), scheduleOutput(s, "trigger_schedule_arns"), scheduleOutput(s, "role_arn")}, nil
+		}
 	default:
 		return nil, fmt.Errorf(
 			"invalid resource type: %s. Did you mean to define it as a principal?", resource.Id.Type)
@@ -71,6 +71,10 @@ func (a *NitricAwsTerraformProvider) ResourcesStore(stack cdktf.TerraformStack,
 		index.Secrets[name] = *secret.SecretArnOutput()
 	}
 
+	for name, schedule := range a.Schedules {
+		index.Schedules[name] = *scheduleOutput(schedule, "schedule_arn")
+	}
+
 	indexJson, err := json.Marshal(index)
 	if err != nil {
 		return fmt.Errorf("failed to marshal resource index: %w", err)
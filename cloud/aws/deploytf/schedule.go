@@ -58,3 +58,8 @@ func (a *NitricAwsTerraformProvider) Schedule(stack cdktf.TerraformStack, name s
 
 	return nil
 }
+
+// scheduleOutput returns an output of the deployed schedule module
+func scheduleOutput(s schedule.Schedule, output string) *string {
+	return cdktf.Token_AsString(s.InterpolationForOutput(jsii.String(output)), nil)
+}
@@ -48,6 +48,7 @@ func (a *NitricAwsTerraformProvider) Service(stack cdktf.TerraformStack, name st
 
 	jsiiEnv := map[string]*string{
 		"NITRIC_STACK_ID":        a.Stack.StackIdOutput(),
+		"NITRIC_SERVICE_NAME":    jsii.String(name),
 		"NITRIC_ENVIRONMENT":     jsii.String("cloud"),
 		"MIN_WORKERS":            jsii.String(fmt.Sprint(config.Workers)),
 		"NITRIC_HTTP_PROXY_PORT": jsii.String(fmt.Sprint(3000)),
@@ -15,17 +15,127 @@
 package deploytf
 
 import (
+	"fmt"
+
 	"github.com/aws/jsii-runtime-go"
 	"github.com/hashicorp/terraform-cdk-go/cdktf"
+	"github.com/nitrictech/nitric/cloud/aws/common"
 	"github.com/nitrictech/nitric/cloud/aws/deploytf/generated/topic"
+	"github.com/nitrictech/nitric/core/pkg/logger"
 	deploymentspb "github.com/nitrictech/nitric/core/pkg/proto/deployments/v1"
 )
 
+const (
+	// defaultMaxReceiveCount is the number of delivery attempts before a message is dead-lettered, if not configured
+	defaultMaxReceiveCount = 3
+	// maxVisibilityTimeout is the maximum SQS visibility timeout (12 hours)
+	maxVisibilityTimeout = 43200
+)
+
+type QueuedSubscriber struct {
+	// Explicit JSON names required for JSII serialization
+	LambdaArn         *string `json:"lambda_arn"`
+	LambdaRoleName    *string `json:"lambda_role_name"`
+	VisibilityTimeout int     `json:"visibility_timeout"`
+	MaxReceiveCount   *int    `json:"max_receive_count"`
+	DeadLetterArn     *string `json:"dead_letter_arn"`
+}
+
+// queuedSubscriber returns the delivery queue configuration of a subscription to an ordered topic, or with a retry policy or dead-letter destination.
+// Ordered topics deliver through FIFO queues, as FIFO topics can't deliver to lambdas.
+func (a *NitricAwsTerraformProvider) queuedSubscriber(subscription *deploymentspb.SubscriptionTarget, fifo bool) (*QueuedSubscriber, error) {
+	serviceName := subscription.GetService()
+	lambdaService := a.Services[serviceName]
+
+	retryPolicy := subscription.GetRetryPolicy()
+	if retryPolicy.GetMaxAttempts() < 0 {
+		return nil, fmt.Errorf("invalid retry policy: max_attempts must not be negative")
+	}
+
+	if retryPolicy.GetMinBackoffSeconds() < 0 || retryPolicy.GetMaxBackoffSeconds() < 0 {
+		return nil, fmt.Errorf("invalid retry policy: backoff must not be negative")
+	}
+
+	if retryPolicy.GetMinBackoffSeconds() > maxVisibilityTimeout {
+		return nil, fmt.Errorf("min_backoff_seconds must not exceed %d", maxVisibilityTimeout)
+	}
+
+	if retryPolicy.GetMaxBackoffSeconds() > 0 {
+		logger.Warnf("subscription of service %s: max_backoff_seconds is not supported on AWS, messages are retried after min_backoff_seconds", serviceName)
+	}
+
+	// failed messages become visible for redelivery once the visibility timeout expires,
+	// which must be no less than the lambda timeout
+	visibilityTimeout := int(*lambdaService.Timeout())
+	if int(retryPolicy.GetMinBackoffSeconds()) > visibilityTimeout {
+		visibilityTimeout = int(retryPolicy.GetMinBackoffSeconds())
+	}
+
+	subscriber := &QueuedSubscriber{
+		LambdaArn:         lambdaService.LambdaArnOutput(),
+		LambdaRoleName:    lambdaService.RoleNameOutput(),
+		VisibilityTimeout: visibilityTimeout,
+	}
+
+	maxReceiveCount := int(retryPolicy.GetMaxAttempts())
+
+	if subscription.GetDeadLetter() != nil {
+		queueName := subscription.GetDeadLetter().GetQueue()
+		if queueName == "" {
+			return nil, fmt.Errorf("unsupported dead-letter destination: only queues are supported on AWS")
+		}
+
+		// FIFO queues can only dead-letter to FIFO queues
+		if fifo {
+			return nil, fmt.Errorf("subscriptions to ordered topics can't dead-letter to queues on AWS")
+		}
+
+		deadLetterQueue, ok := a.Queues[queueName]
+		if !ok {
+			return nil, fmt.Errorf("unable to find dead-letter queue %s", queueName)
+		}
+
+		subscriber.DeadLetterArn = deadLetterQueue.QueueArnOutput()
+
+		if maxReceiveCount == 0 {
+			maxReceiveCount = defaultMaxReceiveCount
+		}
+	}
+
+	// without a dead-letter destination, messages that exhaust their attempts are discarded by the topic module
+	if maxReceiveCount > 0 {
+		subscriber.MaxReceiveCount = &maxReceiveCount
+	}
+
+	return subscriber, nil
+}
+
 func (a *NitricAwsTerraformProvider) Topic(stack cdktf.TerraformStack, name string, config *deploymentspb.Topic) error {
 	lambdaSubscriberArns := map[string]*string{}
+	queuedSubscribers := map[string]*QueuedSubscriber{}
+	filterPolicies := map[string]*string{}
 
 	for _, subscriber := range config.Subscriptions {
-		// subscriber.GetService()
+		if len(subscriber.GetFilter().GetAttributes()) > 0 {
+			filterPolicy, err := common.SnsFilterPolicy(subscriber.GetFilter())
+			if err != nil {
+				return fmt.Errorf("invalid filter for subscription of service %s to topic %s: %w", subscriber.GetService(), name, err)
+			}
+
+			filterPolicies[subscriber.GetService()] = jsii.String(filterPolicy)
+		}
+
+		// subscriptions to ordered topics, or with a retry policy or dead-letter destination, are delivered through an SQS queue
+		if config.GetOrdered() || subscriber.GetRetryPolicy() != nil || subscriber.GetDeadLetter() != nil {
+			queuedSubscriber, err := a.queuedSubscriber(subscriber, config.GetOrdered())
+			if err != nil {
+				return fmt.Errorf("subscription of service %s to topic %s: %w", subscriber.GetService(), name, err)
+			}
+
+			queuedSubscribers[subscriber.GetService()] = queuedSubscriber
+			continue
+		}
+
 		lambdaService := a.Services[subscriber.GetService()]
 		lambdaSubscriberArns[subscriber.GetService()] = lambdaService.LambdaArnOutput()
 	}
@@ -34,6 +144,9 @@ func (a *NitricAwsTerraformProvider) Topic(stack cdktf.TerraformStack, name stri
 		StackId:           a.Stack.StackIdOutput(),
 		TopicName:         jsii.String(name),
 		LambdaSubscribers: &lambdaSubscriberArns,
+		QueuedSubscribers: queuedSubscribers,
+		FilterPolicies:    &filterPolicies,
+		Ordered:           jsii.Bool(config.GetOrdered()),
 	})
 
 	return nil
@@ -25,11 +25,11 @@ import (
 	"github.com/google/uuid"
 	"github.com/nitrictech/nitric/cloud/aws/common"
 	"github.com/nitrictech/nitric/cloud/aws/runtime/env"
+	commonbatch "github.com/nitrictech/nitric/cloud/common/runtime/batch"
 	commonenv "github.com/nitrictech/nitric/cloud/common/runtime/env"
 	batchpb "github.com/nitrictech/nitric/core/pkg/proto/batch/v1"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
-	"google.golang.org/protobuf/encoding/protojson"
 )
 
 type AwsBatchService struct {
@@ -49,7 +49,7 @@ func (a *AwsBatchService) SubmitJob(ctx context.Context, request *batchpb.JobSub
 
 	fmt.Printf("Submitting job to AWS Batch for JD: %s onto: %s \n", jobDefinitionName, a.jobQueueArn)
 
-	jsonData, err := protojson.Marshal(request.GetData())
+	jobData, err := commonbatch.EncodeJobData(request.GetData())
 	if err != nil {
 		return nil, err
 	}
@@ -62,7 +62,7 @@ func (a *AwsBatchService) SubmitJob(ctx context.Context, request *batchpb.JobSub
 			Environment: []types.KeyValuePair{
 				{
 					Name:  aws.String("NITRIC_JOB_DATA"),
-					Value: aws.String(string(jsonData)),
+					Value: aws.String(jobData),
 				},
 			},
 		},
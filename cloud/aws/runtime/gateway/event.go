@@ -17,6 +17,7 @@ package gateway
 import (
 	"encoding/json"
 	"fmt"
+	"strconv"
 
 	"github.com/aws/aws-lambda-go/events"
 )
@@ -26,6 +27,8 @@ type eventType int
 const (
 	unknown eventType = iota
 	sns
+	// SNS notifications delivered through an SQS queue, for subscriptions with a retry policy or dead-letter queue
+	sqs
 	s3
 	httpEvent
 	websocketEvent
@@ -47,10 +50,15 @@ type Record struct {
 	ResponseElements map[string]string
 	S3               events.S3Entity
 	SNS              events.SNSEntity
+	// The delivery attempt of the record, 0 if it isn't known
+	DeliveryAttempt int32
 }
 
 type nitricScheduleEvent struct {
 	Schedule string `json:"x-nitric-schedule,omitempty"`
+	// The time the run was scheduled for in RFC3339 format and its delivery attempt, set by EventBridge Scheduler
+	ScheduledTime string `json:"x-nitric-scheduled-time,omitempty"`
+	Attempt       string `json:"x-nitric-attempt,omitempty"`
 }
 
 // An event struct that embeds the AWS event types that we handle
@@ -72,6 +80,8 @@ func (e *Event) Type() eventType {
 		return healthcheck
 	} else if len(e.Records) > 0 && e.Records[0].EventSource == "aws:sns" {
 		return sns
+	} else if len(e.Records) > 0 && e.Records[0].EventSource == "aws:sqs" {
+		return sqs
 	} else if len(e.Records) > 0 && e.Records[0].EventSource == "aws:s3" {
 		return s3
 	} else if e.Schedule != "" {
@@ -125,6 +135,35 @@ func (e *Event) UnmarshalJSON(data []byte) error {
 			})
 		}
 
+	case sqs:
+		sqsEvent := &events.SQSEvent{}
+		err = json.Unmarshal(data, sqsEvent)
+		if err != nil {
+			return err
+		}
+
+		e.Records = make([]Record, 0)
+
+		for _, sqsMessage := range sqsEvent.Records {
+			// the message body is the SNS notification delivered to the queue
+			snsEntity := events.SNSEntity{}
+			err = json.Unmarshal([]byte(sqsMessage.Body), &snsEntity)
+			if err != nil {
+				return fmt.Errorf("error unmarshalling SNS notification from SQS message %s: %w", sqsMessage.MessageId, err)
+			}
+
+			deliveryAttempt, _ := strconv.Atoi(sqsMessage.Attributes["ApproximateReceiveCount"])
+
+			e.Records = append(e.Records, Record{
+				EventSource:      sqsMessage.EventSource,
+				EventSourceArn:   sqsMessage.EventSourceARN,
+				EventName:        snsEntity.Type,
+				ResponseElements: map[string]string{},
+				SNS:              snsEntity,
+				DeliveryAttempt:  int32(deliveryAttempt),
+			})
+		}
+
 	case httpEvent:
 		apiEvent := events.APIGatewayV2HTTPRequest{}
 		err = json.Unmarshal(data, &apiEvent)
@@ -206,6 +245,8 @@ func (e *Event) getEventType(data []byte) eventType {
 		return s3
 	case "aws:sns":
 		return sns
+	case "aws:sqs":
+		return sqs
 	}
 
 	return unknown
@@ -48,6 +48,8 @@ func (s *LambdaGateway) Start(opts *gateway.GatewayStartOpts) error {
 		WebsocketListeners: opts.WebsocketListenerPlugin,
 	}
 
+	opts.Started()
+
 	// Begin polling lambda for incoming requests...
 	s.runtime(func(ctx context.Context, evt json.RawMessage) (interface{}, error) {
 		return s.routeEvent(ctx, s.resolver, handlers, evt)
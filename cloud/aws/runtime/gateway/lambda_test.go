@@ -16,6 +16,7 @@ package gateway_test
 
 import (
 	"context"
+	"encoding/base64"
 	"encoding/json"
 	"fmt"
 	"os"
@@ -137,7 +138,7 @@ var _ = Describe("Lambda", func() {
 				// mockHandler.EXPECT().HandlesTrigger(gomock.Any()).Return(true)
 
 				By("Handling a single HTTP request")
-				mockManager.EXPECT().HandleRequest(gomock.Any(), EqProto(&apispb.ServerMessage{
+				mockManager.EXPECT().HandleRequest(gomock.Any(), gomock.Any(), EqProto(&apispb.ServerMessage{
 					Content: &apispb.ServerMessage_HttpRequest{
 						HttpRequest: &apispb.HttpRequest{
 							Method: "GET",
@@ -217,7 +218,7 @@ var _ = Describe("Lambda", func() {
 				}, nil)
 
 				By("Handling a single HTTP request")
-				mockManager.EXPECT().HandleRequest(&websocketspb.ServerMessage{
+				mockManager.EXPECT().HandleRequest(gomock.Any(), &websocketspb.ServerMessage{
 					Content: &websocketspb.ServerMessage_WebsocketEventRequest{
 						WebsocketEventRequest: &websocketspb.WebsocketEventRequest{
 							SocketName:   "test-api",
@@ -304,7 +305,7 @@ var _ = Describe("Lambda", func() {
 				mockManager.EXPECT().WorkerCount().Return(1)
 
 				By("Handling a single event")
-				mockManager.EXPECT().HandleRequest(EqProto(&topicspb.ServerMessage{
+				mockManager.EXPECT().HandleRequest(gomock.Any(), EqProto(&topicspb.ServerMessage{
 					Content: &topicspb.ServerMessage_MessageRequest{
 						MessageRequest: &topicspb.MessageRequest{
 							TopicName: "MyTopic",
@@ -330,6 +331,94 @@ var _ = Describe("Lambda", func() {
 		})
 	})
 
+	Context("SQS Events", func() {
+		When("The Lambda Gateway receives SNS notifications delivered through SQS", func() {
+			ctrl := gomock.NewController(GinkgoT())
+			mockResolver := mock_provider.NewMockAwsResourceResolver(ctrl)
+			mockManager := mock_topics.NewMockSubscriptionRequestHandler(ctrl)
+
+			content, _ := structpb.NewStruct(map[string]interface{}{
+				"test": "test",
+			})
+
+			message := topicspb.TopicMessage{
+				Content: &topicspb.TopicMessage_StructPayload{
+					StructPayload: content,
+				},
+			}
+
+			messageBytes, err := proto.Marshal(&message)
+			Expect(err).To(BeNil())
+
+			notification, err := json.Marshal(events.SNSEntity{
+				Type:      "Notification",
+				MessageID: "5678",
+				TopicArn:  "arn:aws:sns:us-east-1:12345678910:arn:MyTopic",
+				Message:   base64.StdEncoding.EncodeToString(messageBytes),
+				MessageAttributes: map[string]interface{}{
+					"kind":                  map[string]interface{}{"Type": "String", "Value": "created"},
+					"x-nitric-ordering-key": map[string]interface{}{"Type": "String", "Value": "order-1"},
+					"X-Amzn-Trace-Id":       map[string]interface{}{"Type": "String", "Value": "Root=1-5759e988-bd862e3fe1be46a994272793"},
+				},
+			})
+			Expect(err).To(BeNil())
+
+			runtime := MockLambdaRuntime{
+				eventQueue: []interface{}{&events.SQSEvent{
+					Records: []events.SQSMessage{
+						{
+							MessageId:      "1234",
+							EventSource:    "aws:sqs",
+							EventSourceARN: "arn:aws:sqs:us-east-1:12345678910:MyTopic-MyService-delivery",
+							Body:           string(notification),
+							Attributes: map[string]string{
+								"ApproximateReceiveCount": "2",
+							},
+						},
+					},
+				}},
+			}
+
+			client := gateway.New(mockResolver, gateway.WithRuntime(runtime.Start))
+
+			It("The gateway should translate into a standard NitricRequest with the delivery attempt", func() {
+				By("having the topic available")
+				mockResolver.EXPECT().GetResources(gomock.Any(), resource.AwsResource_Topic).Return(map[string]resource.ResolvedResource{
+					"MyTopic": {
+						ARN: "arn:aws:sns:us-east-1:12345678910:arn:MyTopic",
+					},
+				}, nil)
+
+				By("Handling a single event")
+				mockManager.EXPECT().HandleRequest(gomock.Any(), EqProto(&topicspb.ServerMessage{
+					Content: &topicspb.ServerMessage_MessageRequest{
+						MessageRequest: &topicspb.MessageRequest{
+							TopicName:       "MyTopic",
+							Message:         &message,
+							DeliveryAttempt: 2,
+							MessageId:       "5678",
+							Attributes:      map[string]string{"kind": "created"},
+							OrderingKey:     "order-1",
+						},
+					},
+				})).Return(&topicspb.ClientMessage{
+					Content: &topicspb.ClientMessage_MessageResponse{
+						MessageResponse: &topicspb.MessageResponse{
+							Success: true,
+						},
+					},
+				}, nil)
+
+				err := client.Start(&coreGateway.GatewayStartOpts{
+					TopicsListenerPlugin: mockManager,
+				})
+				Expect(err).To(BeNil())
+
+				ctrl.Finish()
+			})
+		})
+	})
+
 	Context("S3 Events", func() {
 		When("The Lambda Gateway receives S3 Put events", func() {
 			ctrl := gomock.NewController(GinkgoT())
@@ -379,7 +468,7 @@ var _ = Describe("Lambda", func() {
 				// mockHandler.EXPECT().HandlesTrigger(gomock.Any()).Return(true)
 
 				By("Handling a single Notification request")
-				mockManager.EXPECT().HandleRequest(&storagepb.ServerMessage{
+				mockManager.EXPECT().HandleRequest(gomock.Any(), &storagepb.ServerMessage{
 					Content: &storagepb.ServerMessage_BlobEventRequest{
 						BlobEventRequest: &storagepb.BlobEventRequest{
 							BucketName: "images",
@@ -452,7 +541,7 @@ var _ = Describe("Lambda", func() {
 				mockManager.EXPECT().WorkerCount().Return(1)
 
 				By("Handling a single Notification request")
-				mockManager.EXPECT().HandleRequest(&storagepb.ServerMessage{
+				mockManager.EXPECT().HandleRequest(gomock.Any(), &storagepb.ServerMessage{
 					Content: &storagepb.ServerMessage_BlobEventRequest{
 						BlobEventRequest: &storagepb.BlobEventRequest{
 							BucketName: "images",
@@ -18,9 +18,12 @@ import (
 	"context"
 	"encoding/base64"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"net/url"
+	"strconv"
 	"strings"
+	"time"
 
 	"github.com/aws/aws-lambda-go/events"
 	"github.com/nitrictech/nitric/cloud/aws/runtime/resource"
@@ -30,6 +33,7 @@ import (
 	storagepb "github.com/nitrictech/nitric/core/pkg/proto/storage/v1"
 	topicspb "github.com/nitrictech/nitric/core/pkg/proto/topics/v1"
 	websocketspb "github.com/nitrictech/nitric/core/pkg/proto/websockets/v1"
+	"github.com/nitrictech/nitric/core/pkg/tracing"
 	"github.com/nitrictech/nitric/core/pkg/workers/apis"
 	"github.com/nitrictech/nitric/core/pkg/workers/http"
 	"github.com/nitrictech/nitric/core/pkg/workers/schedules"
@@ -37,7 +41,11 @@ import (
 	"github.com/nitrictech/nitric/core/pkg/workers/topics"
 	"github.com/nitrictech/nitric/core/pkg/workers/websockets"
 	"github.com/valyala/fasthttp"
+	"go.opentelemetry.io/contrib/propagators/aws/xray"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 type Handlers struct {
@@ -88,18 +96,29 @@ func getBucketNameForArn(ctx context.Context, resolver resource.AwsResourceResol
 	return "", fmt.Errorf("could not find bucket for arn %s", bucketArn)
 }
 
+// snsMessageAttributes returns the string values of SNS message attributes,
+// which are delivered to lambda as objects with a Type and Value
+func snsMessageAttributes(messageAttributes map[string]interface{}) map[string]string {
+	attrs := map[string]string{}
+
+	for k, v := range messageAttributes {
+		switch attr := v.(type) {
+		case string:
+			attrs[k] = attr
+		case map[string]interface{}:
+			if value, ok := attr["Value"].(string); ok {
+				attrs[k] = value
+			}
+		}
+	}
+
+	return attrs
+}
+
 // handleSnsEvents translates AWS SNS events to Nitric topic events and forwards them to be handled by registered workers.
 func handleSnsEvents(ctx context.Context, resolver resource.AwsResourceResolver, subscriptions topics.SubscriptionRequestHandler, records []Record) (interface{}, error) {
 	for _, snsRecord := range records {
 		messageString := snsRecord.SNS.Message
-		attrs := map[string]string{}
-
-		for k, v := range snsRecord.SNS.MessageAttributes {
-			sv, ok := v.(string)
-			if ok {
-				attrs[k] = sv
-			}
-		}
 
 		tName, err := getTopicNameForArn(ctx, resolver, snsRecord.SNS.TopicArn)
 		if err != nil {
@@ -123,13 +142,19 @@ func handleSnsEvents(ctx context.Context, resolver resource.AwsResourceResolver,
 		request := &topicspb.ServerMessage{
 			Content: &topicspb.ServerMessage_MessageRequest{
 				MessageRequest: &topicspb.MessageRequest{
-					TopicName: tName,
-					Message:   &message,
+					TopicName:       tName,
+					Message:         &message,
+					DeliveryAttempt: snsRecord.DeliveryAttempt,
+					MessageId:       snsRecord.SNS.MessageID,
 				},
 			},
 		}
 
-		resp, err := subscriptions.HandleRequest(request)
+		attributes := snsMessageAttributes(snsRecord.SNS.MessageAttributes)
+		topics.SetMessageAttributes(request.GetMessageRequest(), attributes, append(xray.Propagator{}.Fields(), tracing.Fields()...)...)
+
+		// continue the trace of the publisher
+		resp, err := subscriptions.HandleRequest(tracing.Extract(ctx, attributes), request)
 		if err != nil {
 			return nil, err
 		}
@@ -148,16 +173,25 @@ func handleScheduleEvent(ctx context.Context, schedules schedules.ScheduleReques
 		return nil, fmt.Errorf("unable to identify source nitric schedule")
 	}
 
+	intervalRequest := &schedulespb.IntervalRequest{
+		ScheduleName: evt.Schedule,
+	}
+
+	if scheduledTime, err := time.Parse(time.RFC3339, evt.ScheduledTime); err == nil {
+		intervalRequest.ScheduledTime = timestamppb.New(scheduledTime)
+	}
+
+	if attempt, err := strconv.ParseInt(evt.Attempt, 10, 32); err == nil {
+		intervalRequest.Attempt = int32(attempt)
+	}
+
 	request := &schedulespb.ServerMessage{
-		// Send empty data for now (no reason to send data for schedules at the moment)
 		Content: &schedulespb.ServerMessage_IntervalRequest{
-			IntervalRequest: &schedulespb.IntervalRequest{
-				ScheduleName: evt.Schedule,
-			},
+			IntervalRequest: intervalRequest,
 		},
 	}
 
-	_, err := schedules.HandleRequest(request)
+	_, err := schedules.HandleRequest(ctx, request)
 	if err != nil {
 		return nil, err
 	}
@@ -276,8 +310,35 @@ func handleApiGatewayRequest(ctx context.Context, nitricName string, apismanager
 		},
 	}
 
-	resp, err := apismanager.HandleRequest(nitricName, req)
+	resp, err := apismanager.HandleRequest(tracing.Extract(ctx, evt.Headers), nitricName, req)
 	if err != nil {
+		if status.Code(err) == codes.DeadlineExceeded {
+			return events.APIGatewayProxyResponse{
+				StatusCode:      504,
+				Body:            "Gateway Timeout",
+				IsBase64Encoded: false,
+			}, nil
+		}
+
+		var methodNotAllowed *apis.MethodNotAllowedError
+		if errors.As(err, &methodNotAllowed) {
+			return events.APIGatewayProxyResponse{
+				StatusCode:      405,
+				Headers:         map[string]string{"Allow": strings.Join(methodNotAllowed.AllowedMethods, ", ")},
+				Body:            "Method Not Allowed",
+				IsBase64Encoded: false,
+			}, nil
+		}
+
+		var routeNotFound *apis.RouteNotFoundError
+		if errors.As(err, &routeNotFound) {
+			return events.APIGatewayProxyResponse{
+				StatusCode:      404,
+				Body:            "Not Found",
+				IsBase64Encoded: false,
+			}, nil
+		}
+
 		return events.APIGatewayProxyResponse{
 			StatusCode:      500,
 			Body:            "Internal Server Error",
@@ -358,7 +419,7 @@ func handleWebsocketEvent(ctx context.Context, resolver resource.AwsResourceReso
 		Content: wsEvent,
 	}
 
-	resp, err := websockets.HandleRequest(req)
+	resp, err := websockets.HandleRequest(ctx, req)
 	if err != nil {
 		return events.APIGatewayProxyResponse{
 			StatusCode:      500,
@@ -417,7 +478,7 @@ func handleS3Event(ctx context.Context, resolver resource.AwsResourceResolver, s
 			},
 		}
 
-		resp, err := storageListeners.HandleRequest(msg)
+		resp, err := storageListeners.HandleRequest(ctx, msg)
 		if err != nil {
 			return nil, err
 		}
@@ -464,7 +525,7 @@ func StandardEventRouter(ctx context.Context, resolver resource.AwsResourceResol
 		return handleApiEvent(ctx, resolver, handlers.Apis, handlers.Https, event.APIGatewayV2HTTPRequest)
 	case healthcheck:
 		return handleHealthCheck(ctx, event.healthCheckEvent)
-	case sns:
+	case sns, sqs:
 		return handleSnsEvents(ctx, resolver, handlers.Subscriptions, event.Records)
 	case s3:
 		return handleS3Event(ctx, resolver, handlers.StorageListeners, event.Records)
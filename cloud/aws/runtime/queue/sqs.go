@@ -17,9 +17,12 @@ package queue
 import (
 	"context"
 	"encoding/base64"
+	"encoding/json"
 	"errors"
 	"fmt"
+	"math"
 	"strings"
+	"time"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/config"
@@ -30,6 +33,7 @@ import (
 	"go.opentelemetry.io/contrib/instrumentation/github.com/aws/aws-sdk-go-v2/otelaws"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/durationpb"
 
 	"github.com/nitrictech/nitric/cloud/aws/ifaces/sqsiface"
 	"github.com/nitrictech/nitric/cloud/aws/runtime/env"
@@ -46,6 +50,38 @@ type SQSQueueService struct {
 
 var _ queuespb.QueuesServer = &SQSQueueService{}
 
+// The maximum visibility timeout of an SQS message, 12 hours
+const maxVisibilityTimeout = 12 * time.Hour
+
+// visibilityTimeout returns the lease duration in whole seconds, capped at the maximum SQS visibility timeout
+func visibilityTimeout(leaseDuration *durationpb.Duration) int32 {
+	duration := min(leaseDuration.AsDuration(), maxVisibilityTimeout)
+
+	return int32(math.Ceil(duration.Seconds()))
+}
+
+// snsNotification is the body of a topic message that was dead-lettered to the queue from a subscription
+type snsNotification struct {
+	Type    string
+	Message string
+}
+
+// messageBody returns the encoded nitric message of an SQS message body,
+// unwrapping topic messages that were dead-lettered to the queue from a subscription.
+// Topic and queue messages share the same encoding.
+func messageBody(body string) string {
+	if !strings.HasPrefix(body, "{") {
+		return body
+	}
+
+	var notification snsNotification
+	if err := json.Unmarshal([]byte(body), &notification); err != nil || notification.Type != "Notification" {
+		return body
+	}
+
+	return notification.Message
+}
+
 // Get the URL for a given queue name
 func (s *SQSQueueService) getUrlForQueueName(ctx context.Context, queue string) (*string, error) {
 	queues, err := s.provider.GetResources(ctx, resource.AwsResource_Queue)
@@ -169,7 +205,8 @@ func (s *SQSQueueService) Dequeue(ctx context.Context, req *queuespb.QueueDequeu
 				string(types.QueueAttributeNameAll),
 			},
 			QueueUrl: url,
-			// VisibilityTimeout:       nil,
+			// the queue's default visibility timeout is used when no lease duration is provided
+			VisibilityTimeout: visibilityTimeout(req.GetLeaseDuration()),
 			// WaitTimeSeconds:         nil,
 		}
 
@@ -194,7 +231,7 @@ func (s *SQSQueueService) Dequeue(ctx context.Context, req *queuespb.QueueDequeu
 		for _, m := range res.Messages {
 			var queueMessage queuespb.QueueMessage
 
-			msgBytes, err := base64.StdEncoding.DecodeString(*m.Body)
+			msgBytes, err := base64.StdEncoding.DecodeString(messageBody(*m.Body))
 			if err != nil {
 				return nil, newErr(
 					codes.Internal,
@@ -258,6 +295,67 @@ func (s *SQSQueueService) Complete(ctx context.Context, req *queuespb.QueueCompl
 	}
 }
 
+// Extends the lease of a previously popped queue item by changing its visibility timeout
+func (s *SQSQueueService) ExtendLease(ctx context.Context, req *queuespb.QueueExtendLeaseRequest) (*queuespb.QueueExtendLeaseResponse, error) {
+	newErr := grpc_errors.ErrorsWithScope("SQSQueueService.ExtendLease")
+
+	url, err := s.getUrlForQueueName(ctx, req.QueueName)
+	if err != nil {
+		return nil, newErr(
+			codes.NotFound,
+			"unable to find queue",
+			err,
+		)
+	}
+
+	if err := s.changeVisibility(ctx, url, req.LeaseId, visibilityTimeout(req.LeaseDuration)); err != nil {
+		return nil, newErr(
+			codes.Internal,
+			"failed to extend task lease",
+			err,
+		)
+	}
+
+	// SQS receipt handles are unchanged when the visibility timeout changes
+	return &queuespb.QueueExtendLeaseResponse{
+		LeaseId: req.LeaseId,
+	}, nil
+}
+
+// Releases a previously popped queue item, making it immediately visible to other consumers
+func (s *SQSQueueService) Release(ctx context.Context, req *queuespb.QueueReleaseRequest) (*queuespb.QueueReleaseResponse, error) {
+	newErr := grpc_errors.ErrorsWithScope("SQSQueueService.Release")
+
+	url, err := s.getUrlForQueueName(ctx, req.QueueName)
+	if err != nil {
+		return nil, newErr(
+			codes.NotFound,
+			"unable to find queue",
+			err,
+		)
+	}
+
+	if err := s.changeVisibility(ctx, url, req.LeaseId, 0); err != nil {
+		return nil, newErr(
+			codes.Internal,
+			"failed to release task",
+			err,
+		)
+	}
+
+	return &queuespb.QueueReleaseResponse{}, nil
+}
+
+func (s *SQSQueueService) changeVisibility(ctx context.Context, url *string, receiptHandle string, visibilityTimeout int32) error {
+	_, err := s.client.ChangeMessageVisibility(ctx, &sqs.ChangeMessageVisibilityInput{
+		QueueUrl:          url,
+		ReceiptHandle:     aws.String(receiptHandle),
+		VisibilityTimeout: visibilityTimeout,
+	})
+
+	return err
+}
+
 func New(provider resource.AwsResourceResolver) (queuespb.QueuesServer, error) {
 	awsRegion := env.AWS_REGION.String()
 
@@ -19,6 +19,7 @@ import (
 	"encoding/base64"
 	"errors"
 	"fmt"
+	"time"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/sqs"
@@ -26,6 +27,7 @@ import (
 	"github.com/aws/smithy-go"
 	"github.com/golang/mock/gomock"
 	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/durationpb"
 	"google.golang.org/protobuf/types/known/structpb"
 
 	. "github.com/onsi/ginkgo"
@@ -463,5 +465,129 @@ var _ = Describe("Sqs", func() {
 				})
 			})
 		})
+
+		Context("ExtendLease", func() {
+			When("The message visibility is successfully changed", func() {
+				It("Should extend the lease by the requested duration", func() {
+					ctrl := gomock.NewController(GinkgoT())
+					sqsMock := mocks_sqs.NewMockSQSAPI(ctrl)
+					providerMock := mock_provider.NewMockAwsResourceResolver(ctrl)
+					plugin := NewWithClient(providerMock, sqsMock)
+
+					queueUrl := aws.String("https://example.com/test-queue")
+
+					By("Calling GetResources to get the queue arn")
+					providerMock.EXPECT().GetResources(gomock.Any(), resource.AwsResource_Queue).Return(map[string]resource.ResolvedResource{
+						"test-queue": {
+							ARN: "arn:aws:sqs:us-east-2:444455556666:test-queue",
+						},
+					}, nil)
+
+					By("Calling GetQueueUrl to get the queueurl")
+					sqsMock.EXPECT().GetQueueUrl(gomock.Any(), gomock.Any()).Times(1).Return(&sqs.GetQueueUrlOutput{
+						QueueUrl: queueUrl,
+					}, nil)
+
+					By("Calling SQS with the lease duration as the visibility timeout")
+					sqsMock.EXPECT().ChangeMessageVisibility(gomock.Any(), &sqs.ChangeMessageVisibilityInput{
+						QueueUrl:          queueUrl,
+						ReceiptHandle:     aws.String("lease-id"),
+						VisibilityTimeout: int32(300),
+					}).Times(1).Return(&sqs.ChangeMessageVisibilityOutput{}, nil)
+
+					response, err := plugin.ExtendLease(context.TODO(), &queuepb.QueueExtendLeaseRequest{
+						QueueName:     "test-queue",
+						LeaseId:       "lease-id",
+						LeaseDuration: durationpb.New(5 * time.Minute),
+					})
+
+					By("Not returning an error")
+					Expect(err).ShouldNot(HaveOccurred())
+
+					By("Returning the unchanged lease id")
+					Expect(response.LeaseId).To(Equal("lease-id"))
+
+					ctrl.Finish()
+				})
+			})
+
+			When("The message visibility fails to change", func() {
+				It("Should return an error", func() {
+					ctrl := gomock.NewController(GinkgoT())
+					sqsMock := mocks_sqs.NewMockSQSAPI(ctrl)
+					providerMock := mock_provider.NewMockAwsResourceResolver(ctrl)
+					plugin := NewWithClient(providerMock, sqsMock)
+
+					queueUrl := aws.String("https://example.com/test-queue")
+
+					By("Calling GetResources to get the queue arn")
+					providerMock.EXPECT().GetResources(gomock.Any(), resource.AwsResource_Queue).Return(map[string]resource.ResolvedResource{
+						"test-queue": {
+							ARN: "arn:aws:sqs:us-east-2:444455556666:test-queue",
+						},
+					}, nil)
+
+					By("Calling GetQueueUrl to get the queueurl")
+					sqsMock.EXPECT().GetQueueUrl(gomock.Any(), gomock.Any()).Times(1).Return(&sqs.GetQueueUrlOutput{
+						QueueUrl: queueUrl,
+					}, nil)
+
+					sqsMock.EXPECT().ChangeMessageVisibility(gomock.Any(), gomock.Any()).Times(1).Return(nil, fmt.Errorf("mock-error"))
+
+					_, err := plugin.ExtendLease(context.TODO(), &queuepb.QueueExtendLeaseRequest{
+						QueueName:     "test-queue",
+						LeaseId:       "lease-id",
+						LeaseDuration: durationpb.New(5 * time.Minute),
+					})
+
+					By("returning the error")
+					Expect(err).Should(HaveOccurred())
+
+					ctrl.Finish()
+				})
+			})
+		})
+
+		Context("Release", func() {
+			When("The message visibility is successfully changed", func() {
+				It("Should make the message visible immediately", func() {
+					ctrl := gomock.NewController(GinkgoT())
+					sqsMock := mocks_sqs.NewMockSQSAPI(ctrl)
+					providerMock := mock_provider.NewMockAwsResourceResolver(ctrl)
+					plugin := NewWithClient(providerMock, sqsMock)
+
+					queueUrl := aws.String("https://example.com/test-queue")
+
+					By("Calling GetResources to get the queue arn")
+					providerMock.EXPECT().GetResources(gomock.Any(), resource.AwsResource_Queue).Return(map[string]resource.ResolvedResource{
+						"test-queue": {
+							ARN: "arn:aws:sqs:us-east-2:444455556666:test-queue",
+						},
+					}, nil)
+
+					By("Calling GetQueueUrl to get the queueurl")
+					sqsMock.EXPECT().GetQueueUrl(gomock.Any(), gomock.Any()).Times(1).Return(&sqs.GetQueueUrlOutput{
+						QueueUrl: queueUrl,
+					}, nil)
+
+					By("Calling SQS with a zero visibility timeout")
+					sqsMock.EXPECT().ChangeMessageVisibility(gomock.Any(), &sqs.ChangeMessageVisibilityInput{
+						QueueUrl:          queueUrl,
+						ReceiptHandle:     aws.String("lease-id"),
+						VisibilityTimeout: int32(0),
+					}).Times(1).Return(&sqs.ChangeMessageVisibilityOutput{}, nil)
+
+					_, err := plugin.Release(context.TODO(), &queuepb.QueueReleaseRequest{
+						QueueName: "test-queue",
+						LeaseId:   "lease-id",
+					})
+
+					By("Not returning an error")
+					Expect(err).ShouldNot(HaveOccurred())
+
+					ctrl.Finish()
+				})
+			})
+		})
 	})
 })
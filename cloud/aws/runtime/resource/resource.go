@@ -50,6 +50,7 @@ const (
 	AwsResource_Bucket       AwsResource = "s3:bucket"
 	AwsResource_Secret       AwsResource = "secretsmanager:secret"
 	AwsResource_EventRule    AwsResource = "events:rule"
+	AwsResource_Schedule     AwsResource = "scheduler:schedule"
 	AwsResource_Unknown      AwsResource = "unknown"
 )
 
@@ -196,6 +196,12 @@ func (a *AwsSSMResourceResolver) GetResources(ctx context.Context, resourceType
 				ARN: queue,
 			}
 		}
+	case AwsResource_Schedule:
+		for name, schedule := range a.cache.Schedules {
+			resolvedResources[name] = ResolvedResource{
+				ARN: schedule,
+			}
+		}
 	}
 
 	return resolvedResources, nil
@@ -0,0 +1,227 @@
+// Copyright 2021 Nitric Technologies Pty Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package schedule
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/arn"
+	"github.com/aws/aws-sdk-go-v2/config"
+	"github.com/aws/aws-sdk-go-v2/service/scheduler"
+	"github.com/aws/aws-sdk-go-v2/service/scheduler/types"
+	"github.com/aws/smithy-go"
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+	"go.opentelemetry.io/contrib/instrumentation/github.com/aws/aws-sdk-go-v2/otelaws"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/nitrictech/nitric/cloud/aws/common"
+	"github.com/nitrictech/nitric/cloud/aws/ifaces/scheduleriface"
+	"github.com/nitrictech/nitric/cloud/aws/runtime/env"
+	"github.com/nitrictech/nitric/cloud/aws/runtime/resource"
+	grpc_errors "github.com/nitrictech/nitric/core/pkg/grpc/errors"
+	schedulespb "github.com/nitrictech/nitric/core/pkg/proto/schedules/v1"
+)
+
+// EventbridgeScheduleService manages the EventBridge Scheduler schedules of a stack
+type EventbridgeScheduleService struct {
+	schedulespb.UnimplementedScheduleManagerServer
+
+	resolver resource.AwsResourceResolver
+	client   scheduleriface.SchedulerAPI
+}
+
+var _ schedulespb.ScheduleManagerServer = &EventbridgeScheduleService{}
+
+var errScheduleNotFound = errors.New("schedule not found")
+
+// errorCode returns the gRPC code for an error calling the AWS APIs
+func errorCode(err error) codes.Code {
+	if errors.Is(err, errScheduleNotFound) {
+		return codes.NotFound
+	}
+
+	var apiErr smithy.APIError
+	if !errors.As(err, &apiErr) {
+		return codes.Internal
+	}
+
+	switch apiErr.ErrorCode() {
+	case "ResourceNotFoundException":
+		return codes.NotFound
+	case "AccessDeniedException":
+		return codes.PermissionDenied
+	case "ConflictException":
+		return codes.FailedPrecondition
+	default:
+		return codes.Internal
+	}
+}
+
+// getSchedule returns a schedule, resolved from the nitric name of the schedule
+func (s *EventbridgeScheduleService) getSchedule(ctx context.Context, scheduleName string) (*scheduler.GetScheduleOutput, error) {
+	schedules, err := s.resolver.GetResources(ctx, resource.AwsResource_Schedule)
+	if err != nil {
+		return nil, fmt.Errorf("error finding schedules: %w", err)
+	}
+
+	schedule, ok := schedules[scheduleName]
+	if !ok {
+		return nil, fmt.Errorf("%w: %s", errScheduleNotFound, scheduleName)
+	}
+
+	// schedule arns have the form arn:aws:scheduler:region:account:schedule/group/name
+	parsedArn, err := arn.Parse(schedule.ARN)
+	if err != nil {
+		return nil, err
+	}
+
+	parts := strings.Split(parsedArn.Resource, "/")
+	if len(parts) != 3 {
+		return nil, fmt.Errorf("invalid schedule ARN %s", schedule.ARN)
+	}
+
+	return s.client.GetSchedule(ctx, &scheduler.GetScheduleInput{
+		GroupName: aws.String(parts[1]),
+		Name:      aws.String(parts[2]),
+	})
+}
+
+// setState enables or disables a schedule, an update replaces the whole schedule so its current configuration is sent back with the new state
+func (s *EventbridgeScheduleService) setState(ctx context.Context, scheduleName string, state types.ScheduleState) error {
+	schedule, err := s.getSchedule(ctx, scheduleName)
+	if err != nil {
+		return err
+	}
+
+	_, err = s.client.UpdateSchedule(ctx, &scheduler.UpdateScheduleInput{
+		Name:                       schedule.Name,
+		GroupName:                  schedule.GroupName,
+		ScheduleExpression:         schedule.ScheduleExpression,
+		ScheduleExpressionTimezone: schedule.ScheduleExpressionTimezone,
+		FlexibleTimeWindow:         schedule.FlexibleTimeWindow,
+		Target:                     schedule.Target,
+		ActionAfterCompletion:      schedule.ActionAfterCompletion,
+		Description:                schedule.Description,
+		StartDate:                  schedule.StartDate,
+		EndDate:                    schedule.EndDate,
+		KmsKeyArn:                  schedule.KmsKeyArn,
+		State:                      state,
+	})
+
+	return err
+}
+
+func (s *EventbridgeScheduleService) Trigger(ctx context.Context, req *schedulespb.ScheduleTriggerRequest) (*schedulespb.ScheduleTriggerResponse, error) {
+	newErr := grpc_errors.ErrorsWithScope("EventbridgeScheduleService.Trigger")
+
+	schedule, err := s.getSchedule(ctx, req.ScheduleName)
+	if err != nil {
+		return nil, newErr(errorCode(err), "error getting schedule", err)
+	}
+
+	// the target is triggered by a one-time schedule with the same target and execution role,
+	// it fires as soon as it's created and the scheduler deletes it once it has run
+	_, err = s.client.CreateSchedule(ctx, &scheduler.CreateScheduleInput{
+		Name:                       aws.String(common.ScheduleTriggerPrefix + uuid.NewString()),
+		GroupName:                  schedule.GroupName,
+		ScheduleExpression:         aws.String(fmt.Sprintf("at(%s)", time.Now().UTC().Format("2006-01-02T15:04:05"))),
+		ScheduleExpressionTimezone: aws.String("UTC"),
+		FlexibleTimeWindow:         &types.FlexibleTimeWindow{Mode: types.FlexibleTimeWindowModeOff},
+		ActionAfterCompletion:      types.ActionAfterCompletionDelete,
+		Target:                     schedule.Target,
+	})
+	if err != nil {
+		return nil, newErr(errorCode(err), "error triggering schedule", err)
+	}
+
+	return &schedulespb.ScheduleTriggerResponse{}, nil
+}
+
+func (s *EventbridgeScheduleService) Pause(ctx context.Context, req *schedulespb.SchedulePauseRequest) (*schedulespb.SchedulePauseResponse, error) {
+	newErr := grpc_errors.ErrorsWithScope("EventbridgeScheduleService.Pause")
+
+	if err := s.setState(ctx, req.ScheduleName, types.ScheduleStateDisabled); err != nil {
+		return nil, newErr(errorCode(err), "error pausing schedule", err)
+	}
+
+	return &schedulespb.SchedulePauseResponse{}, nil
+}
+
+func (s *EventbridgeScheduleService) Resume(ctx context.Context, req *schedulespb.ScheduleResumeRequest) (*schedulespb.ScheduleResumeResponse, error) {
+	newErr := grpc_errors.ErrorsWithScope("EventbridgeScheduleService.Resume")
+
+	if err := s.setState(ctx, req.ScheduleName, types.ScheduleStateEnabled); err != nil {
+		return nil, newErr(errorCode(err), "error resuming schedule", err)
+	}
+
+	return &schedulespb.ScheduleResumeResponse{}, nil
+}
+
+func (s *EventbridgeScheduleService) Describe(ctx context.Context, req *schedulespb.ScheduleDescribeRequest) (*schedulespb.ScheduleDescribeResponse, error) {
+	newErr := grpc_errors.ErrorsWithScope("EventbridgeScheduleService.Describe")
+
+	schedule, err := s.getSchedule(ctx, req.ScheduleName)
+	if err != nil {
+		return nil, newErr(errorCode(err), "error getting schedule", err)
+	}
+
+	if schedule.State == types.ScheduleStateDisabled {
+		return &schedulespb.ScheduleDescribeResponse{Paused: true}, nil
+	}
+
+	start := aws.ToTime(schedule.CreationDate)
+	if schedule.StartDate != nil {
+		start = *schedule.StartDate
+	}
+
+	times, err := nextFireTimes(aws.ToString(schedule.ScheduleExpression), aws.ToString(schedule.ScheduleExpressionTimezone), start, time.Now(), max(1, int(req.NextFireTimeCount)))
+	if err != nil {
+		return nil, newErr(codes.FailedPrecondition, "error calculating next fire times", err)
+	}
+
+	return &schedulespb.ScheduleDescribeResponse{
+		NextFireTimes: lo.Map(times, func(t time.Time, _ int) *timestamppb.Timestamp {
+			return timestamppb.New(t)
+		}),
+	}, nil
+}
+
+// New creates a schedule manager for the EventBridge Scheduler schedules of the stack
+func New(resolver resource.AwsResourceResolver) (*EventbridgeScheduleService, error) {
+	awsRegion := env.AWS_REGION.String()
+
+	cfg, sessionError := config.LoadDefaultConfig(context.TODO(), config.WithRegion(awsRegion))
+	if sessionError != nil {
+		return nil, fmt.Errorf("error creating new AWS session %w", sessionError)
+	}
+
+	otelaws.AppendMiddlewares(&cfg.APIOptions)
+
+	return NewWithClient(resolver, scheduler.NewFromConfig(cfg)), nil
+}
+
+func NewWithClient(resolver resource.AwsResourceResolver, client scheduleriface.SchedulerAPI) *EventbridgeScheduleService {
+	return &EventbridgeScheduleService{
+		resolver: resolver,
+		client:   client,
+	}
+}
@@ -0,0 +1,164 @@
+// Copyright 2021 Nitric Technologies Pty Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package schedule
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/robfig/cron/v3"
+)
+
+var (
+	atExpressionRegexp   = regexp.MustCompile(`^at\((.+)\)$`)
+	rateExpressionRegexp = regexp.MustCompile(`^rate\((\d+)\s+(minutes?|hours?|days?)\)$`)
+	cronExpressionRegexp = regexp.MustCompile(`^cron\((.+)\)$`)
+)
+
+// nextFireTimes returns up to count times after from that an EventBridge Scheduler expression fires,
+// start is the time rate expressions are counted from, i.e. the start date or creation date of the schedule.
+func nextFireTimes(expression string, timezone string, start time.Time, from time.Time, count int) ([]time.Time, error) {
+	loc := time.UTC
+	if timezone != "" {
+		var err error
+		loc, err = time.LoadLocation(timezone)
+		if err != nil {
+			return nil, fmt.Errorf("invalid schedule timezone %s: %w", timezone, err)
+		}
+	}
+
+	from = from.In(loc)
+	expression = strings.TrimSpace(expression)
+
+	if match := atExpressionRegexp.FindStringSubmatch(expression); match != nil {
+		at, err := time.ParseInLocation("2006-01-02T15:04:05", match[1], loc)
+		if err != nil {
+			return nil, fmt.Errorf("invalid at expression %s: %w", expression, err)
+		}
+
+		if !at.After(from) {
+			return []time.Time{}, nil
+		}
+
+		return []time.Time{at}, nil
+	}
+
+	if match := rateExpressionRegexp.FindStringSubmatch(expression); match != nil {
+		value, err := strconv.Atoi(match[1])
+		if err != nil || value < 1 {
+			return nil, fmt.Errorf("invalid rate expression %s", expression)
+		}
+
+		unit := time.Minute
+		switch strings.TrimSuffix(match[2], "s") {
+		case "hour":
+			unit = time.Hour
+		case "day":
+			unit = 24 * time.Hour
+		}
+
+		return nextRateTimes(time.Duration(value)*unit, start.In(loc), from, count), nil
+	}
+
+	if match := cronExpressionRegexp.FindStringSubmatch(expression); match != nil {
+		standard, err := toStandardCron(match[1])
+		if err != nil {
+			return nil, fmt.Errorf("unsupported cron expression %s: %w", expression, err)
+		}
+
+		schedule, err := cron.ParseStandard(standard)
+		if err != nil {
+			return nil, fmt.Errorf("invalid cron expression %s: %w", expression, err)
+		}
+
+		times := make([]time.Time, 0, count)
+		for next := schedule.Next(from); len(times) < count && !next.IsZero(); next = schedule.Next(next) {
+			times = append(times, next)
+		}
+
+		return times, nil
+	}
+
+	return nil, fmt.Errorf("unknown schedule expression %s, must be one of: at, rate, cron", expression)
+}
+
+// nextRateTimes returns the next count times after from, at multiples of rate from start
+func nextRateTimes(rate time.Duration, start time.Time, from time.Time, count int) []time.Time {
+	next := start
+	if !from.Before(start) {
+		next = start.Add((from.Sub(start)/rate + 1) * rate)
+	}
+
+	times := make([]time.Time, 0, count)
+	for ; len(times) < count; next = next.Add(rate) {
+		times = append(times, next)
+	}
+
+	return times
+}
+
+// toStandardCron converts an AWS cron expression, "Minutes Hours Day-of-month Month Day-of-week Year",
+// into a standard 5 field cron expression. This is the inverse of the conversion made when deploying schedules,
+// so the L, W and # wildcards and year restrictions aren't supported.
+func toStandardCron(expression string) (string, error) {
+	const (
+		DOM = 2
+		DOW = 4
+		YEA = 5
+	)
+
+	fields := strings.Fields(expression)
+	if len(fields) != 6 {
+		return "", fmt.Errorf("expected 6 fields, found %d", len(fields))
+	}
+
+	if fields[YEA] != "*" {
+		return "", fmt.Errorf("year restrictions are not supported")
+	}
+
+	if strings.ContainsAny(fields[DOM], "LW") || strings.ContainsAny(fields[DOW], "#") || strings.HasSuffix(fields[DOW], "L") {
+		return "", fmt.Errorf("the L, W and # wildcards are not supported")
+	}
+
+	for _, i := range []int{DOM, DOW} {
+		if fields[i] == "?" {
+			fields[i] = "*"
+		}
+	}
+
+	// Day-of-week numbers are one-indexed in AWS but zero-indexed in standard cron
+	items := strings.Split(fields[DOW], ",")
+	for i, item := range items {
+		days, step, hasStep := strings.Cut(item, "/")
+
+		bounds := strings.Split(days, "-")
+		for j, bound := range bounds {
+			if day, err := strconv.Atoi(bound); err == nil {
+				bounds[j] = strconv.Itoa(day - 1)
+			}
+		}
+
+		items[i] = strings.Join(bounds, "-")
+		if hasStep {
+			items[i] += "/" + step
+		}
+	}
+	fields[DOW] = strings.Join(items, ",")
+
+	return strings.Join(fields[:YEA], " "), nil
+}
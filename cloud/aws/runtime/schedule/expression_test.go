@@ -0,0 +1,78 @@
+// Copyright 2021 Nitric Technologies Pty Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package schedule
+
+import (
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("nextFireTimes", func() {
+	// Monday 1st of January 2024
+	from := time.Date(2024, 1, 1, 10, 30, 0, 0, time.UTC)
+
+	When("The schedule is a cron expression", func() {
+		It("Should convert AWS days of the week to standard days of the week", func() {
+			times, err := nextFireTimes("cron(0 9 ? * 2-6 *)", "", from, from, 2)
+
+			Expect(err).ToNot(HaveOccurred())
+			Expect(times).To(Equal([]time.Time{
+				time.Date(2024, 1, 2, 9, 0, 0, 0, time.UTC),
+				time.Date(2024, 1, 3, 9, 0, 0, 0, time.UTC),
+			}))
+		})
+
+		It("Should calculate the times in the schedule timezone", func() {
+			sydney, _ := time.LoadLocation("Australia/Sydney")
+
+			times, err := nextFireTimes("cron(0 0 ? * 1 *)", "Australia/Sydney", from, from, 1)
+
+			Expect(err).ToNot(HaveOccurred())
+			Expect(times).To(HaveLen(1))
+			Expect(times[0].Equal(time.Date(2024, 1, 7, 0, 0, 0, 0, sydney))).To(BeTrue())
+		})
+
+		It("Should reject unsupported wildcards", func() {
+			_, err := nextFireTimes("cron(0 9 L * ? *)", "", from, from, 1)
+
+			Expect(err).To(MatchError(ContainSubstring("not supported")))
+		})
+	})
+
+	When("The schedule is a rate expression", func() {
+		It("Should count from the start of the schedule", func() {
+			start := from.Add(-7 * time.Minute)
+
+			times, err := nextFireTimes("rate(5 minutes)", "", start, from, 2)
+
+			Expect(err).ToNot(HaveOccurred())
+			Expect(times).To(Equal([]time.Time{
+				start.Add(10 * time.Minute),
+				start.Add(15 * time.Minute),
+			}))
+		})
+	})
+
+	When("The schedule is a one time expression", func() {
+		It("Should return no times once it has passed", func() {
+			times, err := nextFireTimes("at(2023-12-31T00:00:00)", "", from, from, 1)
+
+			Expect(err).ToNot(HaveOccurred())
+			Expect(times).To(BeEmpty())
+		})
+	})
+})
@@ -21,6 +21,7 @@ import (
 	"github.com/nitrictech/nitric/cloud/aws/runtime/keyvalue"
 	"github.com/nitrictech/nitric/cloud/aws/runtime/queue"
 	"github.com/nitrictech/nitric/cloud/aws/runtime/resource"
+	"github.com/nitrictech/nitric/cloud/aws/runtime/schedule"
 	"github.com/nitrictech/nitric/cloud/aws/runtime/secret"
 	sql_service "github.com/nitrictech/nitric/cloud/aws/runtime/sql"
 	aws_storage "github.com/nitrictech/nitric/cloud/aws/runtime/storage"
@@ -38,6 +39,7 @@ func NewAwsRuntimeServer(resolver resource.AwsResourceResolver, opts ...server.S
 	topicsPlugin, _ := topic.New(resolver)
 	storagePlugin, _ := aws_storage.New(resolver)
 	batchPlugin, _ := batch.New()
+	schedulePlugin, _ := schedule.New(resolver)
 
 	websocketPlugin, _ := websocket.NewAwsApiGatewayWebsocket(resolver)
 	queuesPlugin, _ := queue.New(resolver)
@@ -52,6 +54,7 @@ func NewAwsRuntimeServer(resolver resource.AwsResourceResolver, opts ...server.S
 	sqlPlugin := sql_service.NewRdsSqlService()
 
 	defaultAwsOpts := []server.ServerOption{
+		server.WithProvider("aws"),
 		server.WithBatchPlugin(batchPlugin),
 		server.WithKeyValuePlugin(keyValuePlugin),
 		server.WithSecretManagerPlugin(secretPlugin),
@@ -62,6 +65,7 @@ func NewAwsRuntimeServer(resolver resource.AwsResourceResolver, opts ...server.S
 		server.WithQueuesPlugin(queuesPlugin),
 		server.WithApiPlugin(apiPlugin),
 		server.WithSqlPlugin(sqlPlugin),
+		server.WithScheduleManagerPlugin(schedulePlugin),
 	}
 
 	// append overrides
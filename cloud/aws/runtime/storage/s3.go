@@ -20,14 +20,22 @@ import (
 	"errors"
 	"fmt"
 	"io"
+	"net/url"
+	"slices"
 	"strings"
+	"time"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/config"
 	"github.com/aws/aws-sdk-go-v2/service/s3"
+	"github.com/aws/aws-sdk-go-v2/service/s3/types"
 	"github.com/aws/smithy-go"
+	"github.com/aws/smithy-go/middleware"
+	smithyhttp "github.com/aws/smithy-go/transport/http"
 	"go.opentelemetry.io/contrib/instrumentation/github.com/aws/aws-sdk-go-v2/otelaws"
+	"golang.org/x/sync/errgroup"
 	"google.golang.org/grpc/codes"
+	"google.golang.org/protobuf/types/known/timestamppb"
 
 	"github.com/nitrictech/nitric/cloud/aws/ifaces/s3iface"
 	"github.com/nitrictech/nitric/cloud/aws/runtime/env"
@@ -41,8 +49,19 @@ const (
 	// ErrCodeNoSuchTagSet - AWS API neglects to include a constant for this error code.
 	ErrCodeNoSuchTagSet = "NoSuchTagSet"
 	ErrCodeAccessDenied = "AccessDenied"
+	ErrCodeInvalidRange = "InvalidRange"
+	// returned when a conditional request's precondition isn't met
+	ErrCodePreconditionFailed = "PreconditionFailed"
+	// returned when a concurrent conditional write to the same key wins
+	ErrCodeConditionalRequestConflict = "ConditionalRequestConflict"
 )
 
+// multipartPartSize is the size of the parts streamed writes are uploaded in, S3 requires every part but the last to be at least 5 MiB
+const multipartPartSize = 8 * 1024 * 1024
+
+// headConcurrency is the number of objects listed with their attributes that are read at once
+const headConcurrency = 16
+
 // S3StorageService - an AWS S3 implementation of the Nitric Storage Service
 type S3StorageService struct {
 	s3Client      s3iface.S3API
@@ -82,6 +101,67 @@ func isS3AccessDeniedErr(err error) bool {
 	return false
 }
 
+func isS3InvalidRangeErr(err error) bool {
+	var apiErr smithy.APIError
+	if errors.As(err, &apiErr) {
+		return apiErr.ErrorCode() == ErrCodeInvalidRange
+	}
+	return false
+}
+
+func isS3NotFoundErr(err error) bool {
+	var notFound *types.NotFound
+	return errors.As(err, &notFound)
+}
+
+func isS3NoSuchKeyErr(err error) bool {
+	var noSuchKey *types.NoSuchKey
+	return errors.As(err, &noSuchKey)
+}
+
+// isS3PreconditionFailedErr returns true for failed conditional requests, including conflicting concurrent conditional writes
+func isS3PreconditionFailedErr(err error) bool {
+	var apiErr smithy.APIError
+	if errors.As(err, &apiErr) {
+		return apiErr.ErrorCode() == ErrCodePreconditionFailed || apiErr.ErrorCode() == ErrCodeConditionalRequestConflict
+	}
+	return false
+}
+
+// preconditions returns the options sending the conditional request headers of a write or delete,
+// the headers are set directly as this version of the client doesn't model them
+func preconditions(ifMatch string, ifNoneMatch string) []func(*s3.Options) {
+	apiOptions := []func(*middleware.Stack) error{}
+	if ifMatch != "" {
+		apiOptions = append(apiOptions, smithyhttp.SetHeaderValue("If-Match", ifMatch))
+	}
+	if ifNoneMatch != "" {
+		apiOptions = append(apiOptions, smithyhttp.SetHeaderValue("If-None-Match", ifNoneMatch))
+	}
+
+	if len(apiOptions) == 0 {
+		return nil
+	}
+
+	return []func(*s3.Options){s3.WithAPIOptions(apiOptions...)}
+}
+
+// optionalString returns nil for empty strings, so optional headers aren't sent empty
+func optionalString(s string) *string {
+	if s == "" {
+		return nil
+	}
+	return aws.String(s)
+}
+
+// optionalTimestamp returns nil for nil times
+func optionalTimestamp(t *time.Time) *timestamppb.Timestamp {
+	if t == nil {
+		return nil
+	}
+	return timestamppb.New(*t)
+}
+
 // Read and return the contents of a file in a bucket
 func (s *S3StorageService) Read(ctx context.Context, req *storagepb.StorageReadRequest) (*storagepb.StorageReadResponse, error) {
 	newErr := grpc_errors.ErrorsWithScope("S3StorageService.Read")
@@ -130,14 +210,16 @@ func (s *S3StorageService) Write(ctx context.Context, req *storagepb.StorageWrit
 	newErr := grpc_errors.ErrorsWithScope("S3StorageService.Write")
 
 	if b, err := s.getS3BucketName(ctx, req.BucketName); err == nil {
-		contentType := content.DetectContentType(req.Key, req.Body)
+		contentType := content.ContentType(req.ContentType, req.Key, req.Body)
 
 		if _, err := s.s3Client.PutObject(ctx, &s3.PutObjectInput{
-			Bucket:      b,
-			Body:        bytes.NewReader(req.Body),
-			ContentType: &contentType,
-			Key:         aws.String(req.Key),
-		}); err != nil {
+			Bucket:       b,
+			Body:         bytes.NewReader(req.Body),
+			ContentType:  &contentType,
+			CacheControl: optionalString(req.CacheControl),
+			Metadata:     req.Metadata,
+			Key:          aws.String(req.Key),
+		}, preconditions(req.IfMatch, req.IfNoneMatch)...); err != nil {
 			if isS3AccessDeniedErr(err) {
 				return nil, newErr(
 					codes.PermissionDenied,
@@ -146,6 +228,10 @@ func (s *S3StorageService) Write(ctx context.Context, req *storagepb.StorageWrit
 				)
 			}
 
+			if isS3PreconditionFailedErr(err) {
+				return nil, newErr(codes.FailedPrecondition, "file was not written, its precondition was not met", err)
+			}
+
 			return nil, newErr(
 				codes.Unknown,
 				"error writing file",
@@ -171,7 +257,7 @@ func (s *S3StorageService) Delete(ctx context.Context, req *storagepb.StorageDel
 		if _, err := s.s3Client.DeleteObject(ctx, &s3.DeleteObjectInput{
 			Bucket: b,
 			Key:    aws.String(req.Key),
-		}); err != nil {
+		}, preconditions(req.IfMatch, "")...); err != nil {
 			if isS3AccessDeniedErr(err) {
 				return nil, newErr(
 					codes.PermissionDenied,
@@ -180,6 +266,10 @@ func (s *S3StorageService) Delete(ctx context.Context, req *storagepb.StorageDel
 				)
 			}
 
+			if isS3PreconditionFailedErr(err) {
+				return nil, newErr(codes.FailedPrecondition, "file was not deleted, its precondition was not met", err)
+			}
+
 			return nil, newErr(
 				codes.Unknown,
 				"error deleting file",
@@ -256,36 +346,80 @@ func (s *S3StorageService) ListBlobs(ctx context.Context, req *storagepb.Storage
 		prefix = &req.Prefix
 	}
 
+	var maxKeys *int32 = nil
+	if req.PageSize > 0 {
+		maxKeys = aws.Int32(req.PageSize)
+	}
+
+	// without a page size or token every page is listed, callers that don't page expect the complete listing
+	listAll := req.PageSize <= 0 && req.PageToken == ""
+
 	if b, err := s.getS3BucketName(ctx, req.BucketName); err == nil {
-		objects, err := s.s3Client.ListObjectsV2(ctx, &s3.ListObjectsV2Input{
-			Bucket: b,
-			Prefix: prefix,
-		})
-		if err != nil {
-			if isS3AccessDeniedErr(err) {
+		files := []*storagepb.Blob{}
+		prefixes := []string{}
+		nextPageToken := ""
+		continuationToken := optionalString(req.PageToken)
+
+		for {
+			objects, err := s.s3Client.ListObjectsV2(ctx, &s3.ListObjectsV2Input{
+				Bucket:            b,
+				Prefix:            prefix,
+				MaxKeys:           maxKeys,
+				ContinuationToken: continuationToken,
+				Delimiter:         optionalString(req.Delimiter),
+			})
+			if err != nil {
+				if isS3AccessDeniedErr(err) {
+					return nil, newErr(
+						codes.PermissionDenied,
+						"unable to list files, this may be due to a missing permissions request in your code.",
+						err,
+					)
+				}
+
 				return nil, newErr(
-					codes.PermissionDenied,
-					"unable to list files, this may be due to a missing permissions request in your code.",
+					codes.Unknown,
+					"error listing files",
 					err,
 				)
 			}
 
-			return nil, newErr(
-				codes.Unknown,
-				"error listing files",
-				err,
-			)
+			for _, o := range objects.Contents {
+				files = append(files, &storagepb.Blob{
+					Key:          *o.Key,
+					Size:         aws.ToInt64(o.Size),
+					Etag:         aws.ToString(o.ETag),
+					LastModified: optionalTimestamp(o.LastModified),
+				})
+			}
+
+			for _, p := range objects.CommonPrefixes {
+				prefixes = append(prefixes, aws.ToString(p.Prefix))
+			}
+
+			if !aws.ToBool(objects.IsTruncated) {
+				break
+			}
+
+			if !listAll {
+				nextPageToken = aws.ToString(objects.NextContinuationToken)
+				break
+			}
+
+			continuationToken = objects.NextContinuationToken
 		}
 
-		files := make([]*storagepb.Blob, 0, len(objects.Contents))
-		for _, o := range objects.Contents {
-			files = append(files, &storagepb.Blob{
-				Key: *o.Key,
-			})
+		if req.IncludeAttributes {
+			files, err = s.headBlobs(ctx, b, files)
+			if err != nil {
+				return nil, statErr(newErr, err)
+			}
 		}
 
 		return &storagepb.StorageListBlobsResponse{
-			Blobs: files,
+			Blobs:          files,
+			CommonPrefixes: prefixes,
+			NextPageToken:  nextPageToken,
 		}, nil
 	} else {
 		return nil, newErr(
@@ -327,6 +461,459 @@ func (s *S3StorageService) Exists(ctx context.Context, req *storagepb.StorageExi
 	}, nil
 }
 
+// Stat returns the attributes and metadata of a file in a bucket
+func (s *S3StorageService) Stat(ctx context.Context, req *storagepb.StorageStatRequest) (*storagepb.StorageStatResponse, error) {
+	newErr := grpc_errors.ErrorsWithScope("S3StorageService.Stat")
+
+	b, err := s.getS3BucketName(ctx, req.BucketName)
+	if err != nil {
+		return nil, newErr(codes.NotFound, "error finding S3 bucket", err)
+	}
+
+	blob, err := s.headBlob(ctx, b, req.Key)
+	if err != nil {
+		return nil, statErr(newErr, err)
+	}
+
+	return &storagepb.StorageStatResponse{
+		Blob: blob,
+	}, nil
+}
+
+// headBlob retrieves the attributes and metadata of an object
+func (s *S3StorageService) headBlob(ctx context.Context, bucket *string, key string) (*storagepb.Blob, error) {
+	head, err := s.s3Client.HeadObject(ctx, &s3.HeadObjectInput{
+		Bucket: bucket,
+		Key:    aws.String(key),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &storagepb.Blob{
+		Key:          key,
+		Size:         aws.ToInt64(head.ContentLength),
+		Etag:         aws.ToString(head.ETag),
+		LastModified: optionalTimestamp(head.LastModified),
+		ContentType:  aws.ToString(head.ContentType),
+		CacheControl: aws.ToString(head.CacheControl),
+		Metadata:     head.Metadata,
+	}, nil
+}
+
+// headBlobs retrieves the attributes and metadata of listed objects, which S3 listings leave out,
+// dropping objects deleted since they were listed
+func (s *S3StorageService) headBlobs(ctx context.Context, bucket *string, listed []*storagepb.Blob) ([]*storagepb.Blob, error) {
+	blobs := make([]*storagepb.Blob, len(listed))
+
+	g, gctx := errgroup.WithContext(ctx)
+	g.SetLimit(headConcurrency)
+
+	for i, l := range listed {
+		g.Go(func() error {
+			blob, err := s.headBlob(gctx, bucket, l.Key)
+			if isS3NotFoundErr(err) {
+				return nil
+			}
+
+			blobs[i] = blob
+
+			return err
+		})
+	}
+
+	if err := g.Wait(); err != nil {
+		return nil, err
+	}
+
+	return slices.DeleteFunc(blobs, func(b *storagepb.Blob) bool { return b == nil }), nil
+}
+
+// statErr maps an error reading the attributes of an object to a gRPC error
+func statErr(newErr grpc_errors.ScopedErrorFactory, err error) error {
+	if isS3AccessDeniedErr(err) {
+		return newErr(
+			codes.PermissionDenied,
+			"unable to read file attributes, this may be due to a missing permissions request in your code.",
+			err,
+		)
+	}
+
+	if isS3NotFoundErr(err) {
+		return newErr(codes.NotFound, "file does not exist", err)
+	}
+
+	return newErr(
+		codes.Unknown,
+		"error reading file attributes",
+		err,
+	)
+}
+
+// ReadStream streams the contents of a file in a bucket, or a byte range of it, in chunks
+func (s *S3StorageService) ReadStream(req *storagepb.StorageReadStreamRequest, stream storagepb.Storage_ReadStreamServer) error {
+	newErr := grpc_errors.ErrorsWithScope("S3StorageService.ReadStream")
+	ctx := stream.Context()
+
+	b, err := s.getS3BucketName(ctx, req.BucketName)
+	if err != nil {
+		return newErr(codes.NotFound, "error finding S3 bucket", err)
+	}
+
+	input := &s3.GetObjectInput{
+		Bucket: b,
+		Key:    aws.String(req.Key),
+	}
+
+	if byteRange := content.ByteRange(req.Offset, req.Length); byteRange != "" {
+		input.Range = aws.String(byteRange)
+	}
+
+	resp, err := s.s3Client.GetObject(ctx, input)
+	if err != nil {
+		if isS3AccessDeniedErr(err) {
+			return newErr(
+				codes.PermissionDenied,
+				"unable to read file, this may be due to a missing permissions request in your code.",
+				err,
+			)
+		}
+
+		if isS3InvalidRangeErr(err) {
+			return newErr(codes.OutOfRange, "requested range is outside of the file", err)
+		}
+
+		return newErr(
+			codes.Unknown,
+			"error reading file",
+			err,
+		)
+	}
+	defer resp.Body.Close()
+
+	if err := content.SendChunks(resp.Body, stream); err != nil {
+		return newErr(codes.Unknown, "error streaming file", err)
+	}
+
+	return nil
+}
+
+// WriteStream writes a file streamed in chunks to a bucket, files larger than a single part are uploaded with a multipart upload
+func (s *S3StorageService) WriteStream(stream storagepb.Storage_WriteStreamServer) error {
+	newErr := grpc_errors.ErrorsWithScope("S3StorageService.WriteStream")
+	ctx := stream.Context()
+
+	writeStream, err := content.ReceiveWriteStream(stream)
+	if err != nil {
+		return newErr(codes.InvalidArgument, "invalid write stream", err)
+	}
+
+	b, err := s.getS3BucketName(ctx, writeStream.Header.BucketName)
+	if err != nil {
+		return newErr(codes.NotFound, "error finding S3 bucket", err)
+	}
+
+	key := aws.String(writeStream.Header.Key)
+	contentType := aws.String(writeStream.ContentType())
+	cacheControl := optionalString(writeStream.Header.CacheControl)
+
+	part := make([]byte, multipartPartSize)
+
+	n, err := readPart(writeStream, part)
+	if err != nil {
+		return newErr(content.ErrorCode(err, codes.Unknown), "error receiving file", err)
+	}
+
+	// files that fit in a single part are put in one request
+	if n < multipartPartSize {
+		if _, err := s.s3Client.PutObject(ctx, &s3.PutObjectInput{
+			Bucket:       b,
+			Body:         bytes.NewReader(part[:n]),
+			ContentType:  contentType,
+			CacheControl: cacheControl,
+			Metadata:     writeStream.Header.Metadata,
+			Key:          key,
+		}); err != nil {
+			if isS3AccessDeniedErr(err) {
+				return newErr(
+					codes.PermissionDenied,
+					"unable to write file, this may be due to a missing permissions request in your code.",
+					err,
+				)
+			}
+
+			return newErr(
+				codes.Unknown,
+				"error writing file",
+				err,
+			)
+		}
+
+		return stream.SendAndClose(&storagepb.StorageWriteStreamResponse{Size: int64(n)})
+	}
+
+	upload, err := s.s3Client.CreateMultipartUpload(ctx, &s3.CreateMultipartUploadInput{
+		Bucket:       b,
+		ContentType:  contentType,
+		CacheControl: cacheControl,
+		Metadata:     writeStream.Header.Metadata,
+		Key:          key,
+	})
+	if err != nil {
+		if isS3AccessDeniedErr(err) {
+			return newErr(
+				codes.PermissionDenied,
+				"unable to write file, this may be due to a missing permissions request in your code.",
+				err,
+			)
+		}
+
+		return newErr(
+			codes.Unknown,
+			"error starting file upload",
+			err,
+		)
+	}
+
+	// abort failed uploads, otherwise the parts uploaded so far are kept, and billed, until they're cleaned up
+	abort := func() {
+		_, _ = s.s3Client.AbortMultipartUpload(context.WithoutCancel(ctx), &s3.AbortMultipartUploadInput{
+			Bucket:   b,
+			Key:      key,
+			UploadId: upload.UploadId,
+		})
+	}
+
+	parts := []types.CompletedPart{}
+	for partNumber := int32(1); n > 0; partNumber++ {
+		out, err := s.s3Client.UploadPart(ctx, &s3.UploadPartInput{
+			Bucket:     b,
+			Key:        key,
+			UploadId:   upload.UploadId,
+			PartNumber: aws.Int32(partNumber),
+			Body:       bytes.NewReader(part[:n]),
+		})
+		if err != nil {
+			abort()
+			return newErr(codes.Unknown, "error uploading file part", err)
+		}
+
+		parts = append(parts, types.CompletedPart{
+			ETag:       out.ETag,
+			PartNumber: aws.Int32(partNumber),
+		})
+
+		n, err = readPart(writeStream, part)
+		if err != nil {
+			abort()
+			return newErr(content.ErrorCode(err, codes.Unknown), "error receiving file", err)
+		}
+	}
+
+	if _, err := s.s3Client.CompleteMultipartUpload(ctx, &s3.CompleteMultipartUploadInput{
+		Bucket:   b,
+		Key:      key,
+		UploadId: upload.UploadId,
+		MultipartUpload: &types.CompletedMultipartUpload{
+			Parts: parts,
+		},
+	}); err != nil {
+		abort()
+		return newErr(codes.Unknown, "error completing file upload", err)
+	}
+
+	return stream.SendAndClose(&storagepb.StorageWriteStreamResponse{Size: writeStream.Size()})
+}
+
+// maxCopyObjectSize is the largest object a single S3 CopyObject call accepts
+const maxCopyObjectSize int64 = 5 * 1024 * 1024 * 1024
+
+// minCopyPartSize is the smallest part used when copying larger objects in parts
+const minCopyPartSize int64 = 512 * 1024 * 1024
+
+// maxCopyParts is the largest number of parts S3 accepts in a multipart upload
+const maxCopyParts int64 = 10000
+
+func copyErr(newErr grpc_errors.ScopedErrorFactory, err error) error {
+	if isS3AccessDeniedErr(err) {
+		return newErr(
+			codes.PermissionDenied,
+			"unable to copy file, this may be due to a missing permissions request in your code.",
+			err,
+		)
+	}
+
+	if isS3NotFoundErr(err) || isS3NoSuchKeyErr(err) {
+		return newErr(codes.NotFound, "source file does not exist", err)
+	}
+
+	return newErr(codes.Unknown, "error copying file", err)
+}
+
+// copyObject copies an object server-side between the given S3 buckets,
+// objects larger than CopyObject accepts are copied in parts
+func (s *S3StorageService) copyObject(ctx context.Context, newErr grpc_errors.ScopedErrorFactory, src *string, sourceKey string, dst *string, destinationKey string) error {
+	copySource := aws.String(url.PathEscape(*src + "/" + sourceKey))
+
+	head, err := s.s3Client.HeadObject(ctx, &s3.HeadObjectInput{
+		Bucket: src,
+		Key:    aws.String(sourceKey),
+	})
+	if err != nil {
+		return copyErr(newErr, err)
+	}
+
+	if aws.ToInt64(head.ContentLength) > maxCopyObjectSize {
+		return s.copyObjectInParts(ctx, newErr, copySource, head, dst, aws.String(destinationKey))
+	}
+
+	if _, err := s.s3Client.CopyObject(ctx, &s3.CopyObjectInput{
+		Bucket:     dst,
+		Key:        aws.String(destinationKey),
+		CopySource: copySource,
+	}); err != nil {
+		return copyErr(newErr, err)
+	}
+
+	return nil
+}
+
+// copyObjectInParts copies an object with a multipart upload of server-side part copies
+func (s *S3StorageService) copyObjectInParts(ctx context.Context, newErr grpc_errors.ScopedErrorFactory, copySource *string, head *s3.HeadObjectOutput, dst *string, key *string) error {
+	size := aws.ToInt64(head.ContentLength)
+
+	partSize := minCopyPartSize
+	if size > partSize*maxCopyParts {
+		partSize = (size + maxCopyParts - 1) / maxCopyParts
+	}
+
+	upload, err := s.s3Client.CreateMultipartUpload(ctx, &s3.CreateMultipartUploadInput{
+		Bucket:       dst,
+		Key:          key,
+		ContentType:  head.ContentType,
+		CacheControl: head.CacheControl,
+		Metadata:     head.Metadata,
+	})
+	if err != nil {
+		return copyErr(newErr, err)
+	}
+
+	abort := func() {
+		_, _ = s.s3Client.AbortMultipartUpload(context.WithoutCancel(ctx), &s3.AbortMultipartUploadInput{
+			Bucket:   dst,
+			Key:      key,
+			UploadId: upload.UploadId,
+		})
+	}
+
+	parts := []types.CompletedPart{}
+
+	for start, partNumber := int64(0), int32(1); start < size; start, partNumber = start+partSize, partNumber+1 {
+		end := min(start+partSize, size) - 1
+
+		out, err := s.s3Client.UploadPartCopy(ctx, &s3.UploadPartCopyInput{
+			Bucket:          dst,
+			Key:             key,
+			UploadId:        upload.UploadId,
+			PartNumber:      aws.Int32(partNumber),
+			CopySource:      copySource,
+			CopySourceRange: aws.String(fmt.Sprintf("bytes=%d-%d", start, end)),
+			// fail rather than assemble parts of different versions if the source changes mid-copy
+			CopySourceIfMatch: head.ETag,
+		})
+		if err != nil {
+			abort()
+			return copyErr(newErr, err)
+		}
+
+		parts = append(parts, types.CompletedPart{
+			ETag:       out.CopyPartResult.ETag,
+			PartNumber: aws.Int32(partNumber),
+		})
+	}
+
+	if _, err := s.s3Client.CompleteMultipartUpload(ctx, &s3.CompleteMultipartUploadInput{
+		Bucket:   dst,
+		Key:      key,
+		UploadId: upload.UploadId,
+		MultipartUpload: &types.CompletedMultipartUpload{
+			Parts: parts,
+		},
+	}); err != nil {
+		abort()
+		return copyErr(newErr, err)
+	}
+
+	return nil
+}
+
+// Copy an object within or between buckets without transferring its contents through the runtime
+func (s *S3StorageService) Copy(ctx context.Context, req *storagepb.StorageCopyRequest) (*storagepb.StorageCopyResponse, error) {
+	newErr := grpc_errors.ErrorsWithScope("S3StorageService.Copy")
+
+	src, err := s.getS3BucketName(ctx, req.SourceBucketName)
+	if err != nil {
+		return nil, newErr(codes.NotFound, "error finding source S3 bucket", err)
+	}
+
+	dst, err := s.getS3BucketName(ctx, req.DestinationBucketName)
+	if err != nil {
+		return nil, newErr(codes.NotFound, "error finding destination S3 bucket", err)
+	}
+
+	if err := s.copyObject(ctx, newErr, src, req.SourceKey, dst, req.DestinationKey); err != nil {
+		return nil, err
+	}
+
+	return &storagepb.StorageCopyResponse{}, nil
+}
+
+// Move an object within or between buckets, S3 has no rename so the object is copied and the source deleted
+func (s *S3StorageService) Move(ctx context.Context, req *storagepb.StorageMoveRequest) (*storagepb.StorageMoveResponse, error) {
+	newErr := grpc_errors.ErrorsWithScope("S3StorageService.Move")
+
+	src, err := s.getS3BucketName(ctx, req.SourceBucketName)
+	if err != nil {
+		return nil, newErr(codes.NotFound, "error finding source S3 bucket", err)
+	}
+
+	dst, err := s.getS3BucketName(ctx, req.DestinationBucketName)
+	if err != nil {
+		return nil, newErr(codes.NotFound, "error finding destination S3 bucket", err)
+	}
+
+	if err := s.copyObject(ctx, newErr, src, req.SourceKey, dst, req.DestinationKey); err != nil {
+		return nil, err
+	}
+
+	if _, err := s.s3Client.DeleteObject(ctx, &s3.DeleteObjectInput{
+		Bucket: src,
+		Key:    aws.String(req.SourceKey),
+	}); err != nil {
+		if isS3AccessDeniedErr(err) {
+			return nil, newErr(
+				codes.PermissionDenied,
+				"unable to delete source file, this may be due to a missing permissions request in your code.",
+				err,
+			)
+		}
+
+		return nil, newErr(codes.Unknown, "error deleting source file", err)
+	}
+
+	return &storagepb.StorageMoveResponse{}, nil
+}
+
+// readPart fills part from r, returning fewer bytes than its size only at the end of r
+func readPart(r io.Reader, part []byte) (int, error) {
+	n, err := io.ReadFull(r, part)
+	if errors.Is(err, io.EOF) || errors.Is(err, io.ErrUnexpectedEOF) {
+		return n, nil
+	}
+
+	return n, err
+}
+
 // New creates a new default S3 storage plugin
 func New(resolver resource.AwsResourceResolver) (*S3StorageService, error) {
 	awsRegion := env.AWS_REGION.String()
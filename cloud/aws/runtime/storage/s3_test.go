@@ -19,6 +19,7 @@ import (
 	"context"
 	"fmt"
 	"io"
+	"net/http"
 	"time"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
@@ -26,10 +27,15 @@ import (
 	"github.com/aws/aws-sdk-go-v2/service/s3"
 	"github.com/aws/aws-sdk-go-v2/service/s3/types"
 	"github.com/aws/smithy-go"
+	"github.com/aws/smithy-go/middleware"
+	smithyhttp "github.com/aws/smithy-go/transport/http"
 	"github.com/golang/mock/gomock"
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
 	"github.com/pkg/errors"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/types/known/durationpb"
 
 	mock_provider "github.com/nitrictech/nitric/cloud/aws/mocks/provider"
@@ -39,6 +45,76 @@ import (
 	storagepb "github.com/nitrictech/nitric/core/pkg/proto/storage/v1"
 )
 
+// requestHeaders returns the headers the given per-call options add to a request
+func requestHeaders(optFns ...func(*s3.Options)) http.Header {
+	opts := s3.Options{}
+	for _, fn := range optFns {
+		fn(&opts)
+	}
+
+	stack := middleware.NewStack("test", smithyhttp.NewStackRequest)
+	for _, fn := range opts.APIOptions {
+		Expect(fn(stack)).To(Succeed())
+	}
+
+	req := smithyhttp.NewStackRequest().(*smithyhttp.Request)
+	_, _, err := stack.Build.HandleMiddleware(context.TODO(), req, middleware.HandlerFunc(func(ctx context.Context, input interface{}) (interface{}, middleware.Metadata, error) {
+		return nil, middleware.Metadata{}, nil
+	}))
+	Expect(err).ToNot(HaveOccurred())
+
+	return req.Header
+}
+
+type mockReadStream struct {
+	grpc.ServerStream
+	chunks [][]byte
+}
+
+func (m *mockReadStream) Context() context.Context {
+	return context.TODO()
+}
+
+func (m *mockReadStream) Send(resp *storagepb.StorageReadStreamResponse) error {
+	m.chunks = append(m.chunks, resp.Chunk)
+	return nil
+}
+
+type mockWriteStream struct {
+	grpc.ServerStream
+	requests []*storagepb.StorageWriteStreamRequest
+	response *storagepb.StorageWriteStreamResponse
+}
+
+func newMockWriteStream(bucket string, key string, body []byte) *mockWriteStream {
+	return &mockWriteStream{
+		requests: []*storagepb.StorageWriteStreamRequest{
+			{Content: &storagepb.StorageWriteStreamRequest_Header{Header: &storagepb.StorageWriteStreamHeader{BucketName: bucket, Key: key}}},
+			{Content: &storagepb.StorageWriteStreamRequest_Chunk{Chunk: body}},
+		},
+	}
+}
+
+func (m *mockWriteStream) Context() context.Context {
+	return context.TODO()
+}
+
+func (m *mockWriteStream) Recv() (*storagepb.StorageWriteStreamRequest, error) {
+	if len(m.requests) == 0 {
+		return nil, io.EOF
+	}
+
+	req := m.requests[0]
+	m.requests = m.requests[1:]
+
+	return req, nil
+}
+
+func (m *mockWriteStream) SendAndClose(resp *storagepb.StorageWriteStreamResponse) error {
+	m.response = resp
+	return nil
+}
+
 var _ = Describe("S3", func() {
 	When("Write", func() {
 		When("Given the S3 backend is available", func() {
@@ -70,6 +146,108 @@ var _ = Describe("S3", func() {
 				})
 			})
 
+			When("Creating an object with a content type and metadata", func() {
+				ctrl := gomock.NewController(GinkgoT())
+
+				mockStorageClient := mock_s3iface.NewMockS3API(ctrl)
+				mockPSStorageClient := mock_s3iface.NewMockPreSignAPI(ctrl)
+				mockProvider := mock_provider.NewMockAwsResourceResolver(ctrl)
+				storagePlugin, _ := s3_service.NewWithClient(mockProvider, mockStorageClient, mockPSStorageClient)
+
+				It("Should store the object with them", func() {
+					By("the bucket existing")
+					mockProvider.EXPECT().GetResources(gomock.Any(), resource.AwsResource_Bucket).Return(map[string]resource.ResolvedResource{
+						"my-bucket": {ARN: "arn:aws:s3:::my-bucket"},
+					}, nil)
+
+					By("writing the item with the requested headers")
+					mockStorageClient.EXPECT().PutObject(gomock.Any(), gomock.Any()).DoAndReturn(func(ctx context.Context, input *s3.PutObjectInput, opts ...func(*s3.Options)) (*s3.PutObjectOutput, error) {
+						Expect(*input.ContentType).To(Equal("text/css"))
+						Expect(*input.CacheControl).To(Equal("max-age=60"))
+						Expect(input.Metadata).To(Equal(map[string]string{"owner": "me"}))
+						return &s3.PutObjectOutput{}, nil
+					})
+
+					_, err := storagePlugin.Write(context.TODO(), &storagepb.StorageWriteRequest{
+						BucketName:   "my-bucket",
+						Key:          "test-item",
+						Body:         []byte("body {}"),
+						ContentType:  "text/css",
+						CacheControl: "max-age=60",
+						Metadata:     map[string]string{"owner": "me"},
+					})
+					By("Not returning an error")
+					Expect(err).ShouldNot(HaveOccurred())
+				})
+			})
+
+			When("Creating an object only if it doesn't exist", func() {
+				ctrl := gomock.NewController(GinkgoT())
+
+				mockStorageClient := mock_s3iface.NewMockS3API(ctrl)
+				mockPSStorageClient := mock_s3iface.NewMockPreSignAPI(ctrl)
+				mockProvider := mock_provider.NewMockAwsResourceResolver(ctrl)
+				storagePlugin, _ := s3_service.NewWithClient(mockProvider, mockStorageClient, mockPSStorageClient)
+
+				It("Should send the precondition and report when it isn't met", func() {
+					By("the bucket existing")
+					mockProvider.EXPECT().GetResources(gomock.Any(), resource.AwsResource_Bucket).Return(map[string]resource.ResolvedResource{
+						"my-bucket": {ARN: "arn:aws:s3:::my-bucket"},
+					}, nil)
+
+					By("the object already existing")
+					mockStorageClient.EXPECT().PutObject(gomock.Any(), gomock.Any(), gomock.Any()).DoAndReturn(func(ctx context.Context, input *s3.PutObjectInput, opts ...func(*s3.Options)) (*s3.PutObjectOutput, error) {
+						headers := requestHeaders(opts...)
+						Expect(headers.Get("If-None-Match")).To(Equal("*"))
+						Expect(headers.Get("If-Match")).To(BeEmpty())
+						return nil, &smithy.GenericAPIError{Code: "PreconditionFailed"}
+					})
+
+					_, err := storagePlugin.Write(context.TODO(), &storagepb.StorageWriteRequest{
+						BucketName:  "my-bucket",
+						Key:         "manifest.json",
+						Body:        []byte("{}"),
+						IfNoneMatch: "*",
+					})
+
+					By("Returning a failed precondition error")
+					Expect(err).Should(HaveOccurred())
+					Expect(err.Error()).To(ContainSubstring("FailedPrecondition"))
+				})
+			})
+
+			When("Updating an object matching an etag", func() {
+				ctrl := gomock.NewController(GinkgoT())
+
+				mockStorageClient := mock_s3iface.NewMockS3API(ctrl)
+				mockPSStorageClient := mock_s3iface.NewMockPreSignAPI(ctrl)
+				mockProvider := mock_provider.NewMockAwsResourceResolver(ctrl)
+				storagePlugin, _ := s3_service.NewWithClient(mockProvider, mockStorageClient, mockPSStorageClient)
+
+				It("Should send the etag as a precondition", func() {
+					By("the bucket existing")
+					mockProvider.EXPECT().GetResources(gomock.Any(), resource.AwsResource_Bucket).Return(map[string]resource.ResolvedResource{
+						"my-bucket": {ARN: "arn:aws:s3:::my-bucket"},
+					}, nil)
+
+					By("writing the item with the precondition")
+					mockStorageClient.EXPECT().PutObject(gomock.Any(), gomock.Any(), gomock.Any()).DoAndReturn(func(ctx context.Context, input *s3.PutObjectInput, opts ...func(*s3.Options)) (*s3.PutObjectOutput, error) {
+						Expect(requestHeaders(opts...).Get("If-Match")).To(Equal("\"etag\""))
+						return &s3.PutObjectOutput{}, nil
+					})
+
+					_, err := storagePlugin.Write(context.TODO(), &storagepb.StorageWriteRequest{
+						BucketName: "my-bucket",
+						Key:        "manifest.json",
+						Body:       []byte("{}"),
+						IfMatch:    "\"etag\"",
+					})
+
+					By("Not returning an error")
+					Expect(err).ShouldNot(HaveOccurred())
+				})
+			})
+
 			When("Creating an object in a non-existent bucket", func() {
 				ctrl := gomock.NewController(GinkgoT())
 				mockStorageClient := mock_s3iface.NewMockS3API(ctrl)
@@ -243,6 +421,37 @@ var _ = Describe("S3", func() {
 				When("The item doesn't exist", func() {
 				})
 
+				When("The item's etag doesn't match", func() {
+					ctrl := gomock.NewController(GinkgoT())
+					mockStorageClient := mock_s3iface.NewMockS3API(ctrl)
+					mockPSStorageClient := mock_s3iface.NewMockPreSignAPI(ctrl)
+					mockProvider := mock_provider.NewMockAwsResourceResolver(ctrl)
+					storagePlugin, _ := s3_service.NewWithClient(mockProvider, mockStorageClient, mockPSStorageClient)
+
+					It("Should not delete the object", func() {
+						By("the bucket existing")
+						mockProvider.EXPECT().GetResources(gomock.Any(), resource.AwsResource_Bucket).Return(map[string]resource.ResolvedResource{
+							"test-bucket": {ARN: "arn:aws:s3:::test-bucket"},
+						}, nil)
+
+						By("s3 rejecting the precondition")
+						mockStorageClient.EXPECT().DeleteObject(gomock.Any(), gomock.Any(), gomock.Any()).DoAndReturn(func(ctx context.Context, input *s3.DeleteObjectInput, opts ...func(*s3.Options)) (*s3.DeleteObjectOutput, error) {
+							Expect(requestHeaders(opts...).Get("If-Match")).To(Equal("\"old\""))
+							return nil, &smithy.GenericAPIError{Code: "PreconditionFailed"}
+						})
+
+						_, err := storagePlugin.Delete(context.TODO(), &storagepb.StorageDeleteRequest{
+							BucketName: "test-bucket",
+							Key:        "test-key",
+							IfMatch:    "\"old\"",
+						})
+
+						By("Returning a failed precondition error")
+						Expect(err).Should(HaveOccurred())
+						Expect(err.Error()).To(ContainSubstring("FailedPrecondition"))
+					})
+				})
+
 				When("Accessing without permissions", func() {
 					ctrl := gomock.NewController(GinkgoT())
 					mockStorageClient := mock_s3iface.NewMockS3API(ctrl)
@@ -310,6 +519,251 @@ var _ = Describe("S3", func() {
 		})
 	})
 
+	When("Copy", func() {
+		When("The buckets exist", func() {
+			ctrl := gomock.NewController(GinkgoT())
+			mockStorageClient := mock_s3iface.NewMockS3API(ctrl)
+			mockPSStorageClient := mock_s3iface.NewMockPreSignAPI(ctrl)
+			mockProvider := mock_provider.NewMockAwsResourceResolver(ctrl)
+			storagePlugin, _ := s3_service.NewWithClient(mockProvider, mockStorageClient, mockPSStorageClient)
+
+			It("should copy the object between the buckets", func() {
+				By("the buckets existing")
+				mockProvider.EXPECT().GetResources(gomock.Any(), resource.AwsResource_Bucket).Times(2).Return(map[string]resource.ResolvedResource{
+					"incoming":  {ARN: "arn:aws:s3:::incoming-aaa111"},
+					"processed": {ARN: "arn:aws:s3:::processed-bbb222"},
+				}, nil)
+
+				By("the source object being smaller than the copy limit")
+				mockStorageClient.EXPECT().HeadObject(gomock.Any(), &s3.HeadObjectInput{
+					Bucket: aws.String("incoming-aaa111"),
+					Key:    aws.String("uploads/report 1.csv"),
+				}).Return(&s3.HeadObjectOutput{ContentLength: aws.Int64(1024)}, nil)
+
+				By("s3 copying the object")
+				mockStorageClient.EXPECT().CopyObject(gomock.Any(), &s3.CopyObjectInput{
+					Bucket:     aws.String("processed-bbb222"),
+					Key:        aws.String("uploads/report 1.csv"),
+					CopySource: aws.String("incoming-aaa111%2Fuploads%2Freport%201.csv"),
+				}).Return(&s3.CopyObjectOutput{}, nil)
+
+				_, err := storagePlugin.Copy(context.TODO(), &storagepb.StorageCopyRequest{
+					SourceBucketName:      "incoming",
+					SourceKey:             "uploads/report 1.csv",
+					DestinationBucketName: "processed",
+					DestinationKey:        "uploads/report 1.csv",
+				})
+
+				By("not returning an error")
+				Expect(err).ShouldNot(HaveOccurred())
+			})
+
+			It("should return not found when the source object doesn't exist", func() {
+				By("the buckets existing")
+				mockProvider.EXPECT().GetResources(gomock.Any(), resource.AwsResource_Bucket).Times(2).Return(map[string]resource.ResolvedResource{
+					"incoming":  {ARN: "arn:aws:s3:::incoming-aaa111"},
+					"processed": {ARN: "arn:aws:s3:::processed-bbb222"},
+				}, nil)
+
+				By("s3 not finding the source object")
+				mockStorageClient.EXPECT().HeadObject(gomock.Any(), gomock.Any()).Return(nil, &types.NotFound{})
+
+				_, err := storagePlugin.Copy(context.TODO(), &storagepb.StorageCopyRequest{
+					SourceBucketName:      "incoming",
+					SourceKey:             "missing",
+					DestinationBucketName: "processed",
+					DestinationKey:        "missing",
+				})
+
+				By("returning a not found error")
+				Expect(err.Error()).To(ContainSubstring("NotFound"))
+			})
+
+			It("should copy objects larger than 5GiB in parts", func() {
+				By("the buckets existing")
+				mockProvider.EXPECT().GetResources(gomock.Any(), resource.AwsResource_Bucket).Times(2).Return(map[string]resource.ResolvedResource{
+					"incoming":  {ARN: "arn:aws:s3:::incoming-aaa111"},
+					"processed": {ARN: "arn:aws:s3:::processed-bbb222"},
+				}, nil)
+
+				gib := int64(1024 * 1024 * 1024)
+
+				By("the source object being larger than the copy limit")
+				mockStorageClient.EXPECT().HeadObject(gomock.Any(), gomock.Any()).Return(&s3.HeadObjectOutput{
+					ContentLength: aws.Int64(6 * gib),
+					ContentType:   aws.String("video/mp4"),
+					ETag:          aws.String("source-etag"),
+				}, nil)
+
+				By("s3 copying the object in 512MiB parts")
+				mockStorageClient.EXPECT().CreateMultipartUpload(gomock.Any(), &s3.CreateMultipartUploadInput{
+					Bucket:      aws.String("processed-bbb222"),
+					Key:         aws.String("large.mp4"),
+					ContentType: aws.String("video/mp4"),
+				}).Return(&s3.CreateMultipartUploadOutput{UploadId: aws.String("upload-id")}, nil)
+
+				ranges := []string{}
+				mockStorageClient.EXPECT().UploadPartCopy(gomock.Any(), gomock.Any()).Times(12).DoAndReturn(
+					func(ctx context.Context, in *s3.UploadPartCopyInput, opts ...func(*s3.Options)) (*s3.UploadPartCopyOutput, error) {
+						Expect(*in.CopySource).To(Equal("incoming-aaa111%2Flarge.mp4"))
+						Expect(*in.CopySourceIfMatch).To(Equal("source-etag"))
+						Expect(*in.PartNumber).To(Equal(int32(len(ranges) + 1)))
+
+						ranges = append(ranges, *in.CopySourceRange)
+
+						return &s3.UploadPartCopyOutput{CopyPartResult: &types.CopyPartResult{ETag: aws.String(fmt.Sprintf("etag-%d", *in.PartNumber))}}, nil
+					},
+				)
+
+				mockStorageClient.EXPECT().CompleteMultipartUpload(gomock.Any(), gomock.Any()).DoAndReturn(
+					func(ctx context.Context, in *s3.CompleteMultipartUploadInput, opts ...func(*s3.Options)) (*s3.CompleteMultipartUploadOutput, error) {
+						Expect(in.MultipartUpload.Parts).To(HaveLen(12))
+						Expect(*in.MultipartUpload.Parts[11].ETag).To(Equal("etag-12"))
+
+						return &s3.CompleteMultipartUploadOutput{}, nil
+					},
+				)
+
+				_, err := storagePlugin.Copy(context.TODO(), &storagepb.StorageCopyRequest{
+					SourceBucketName:      "incoming",
+					SourceKey:             "large.mp4",
+					DestinationBucketName: "processed",
+					DestinationKey:        "large.mp4",
+				})
+
+				By("not returning an error")
+				Expect(err).ShouldNot(HaveOccurred())
+
+				By("covering the whole object")
+				Expect(ranges[0]).To(Equal(fmt.Sprintf("bytes=0-%d", gib/2-1)))
+				Expect(ranges[11]).To(Equal(fmt.Sprintf("bytes=%d-%d", 6*gib-gib/2, 6*gib-1)))
+			})
+
+			It("should abort the upload when a part fails to copy", func() {
+				By("the buckets existing")
+				mockProvider.EXPECT().GetResources(gomock.Any(), resource.AwsResource_Bucket).Times(2).Return(map[string]resource.ResolvedResource{
+					"incoming":  {ARN: "arn:aws:s3:::incoming-aaa111"},
+					"processed": {ARN: "arn:aws:s3:::processed-bbb222"},
+				}, nil)
+
+				By("the source object being larger than the copy limit")
+				mockStorageClient.EXPECT().HeadObject(gomock.Any(), gomock.Any()).Return(&s3.HeadObjectOutput{
+					ContentLength: aws.Int64(6 * 1024 * 1024 * 1024),
+				}, nil)
+
+				mockStorageClient.EXPECT().CreateMultipartUpload(gomock.Any(), gomock.Any()).Return(&s3.CreateMultipartUploadOutput{UploadId: aws.String("upload-id")}, nil)
+
+				By("a part copy failing")
+				mockStorageClient.EXPECT().UploadPartCopy(gomock.Any(), gomock.Any()).Return(nil, errors.New("mock-error"))
+
+				mockStorageClient.EXPECT().AbortMultipartUpload(gomock.Any(), &s3.AbortMultipartUploadInput{
+					Bucket:   aws.String("processed-bbb222"),
+					Key:      aws.String("large.mp4"),
+					UploadId: aws.String("upload-id"),
+				}).Return(&s3.AbortMultipartUploadOutput{}, nil)
+
+				_, err := storagePlugin.Copy(context.TODO(), &storagepb.StorageCopyRequest{
+					SourceBucketName:      "incoming",
+					SourceKey:             "large.mp4",
+					DestinationBucketName: "processed",
+					DestinationKey:        "large.mp4",
+				})
+
+				By("returning an error")
+				Expect(err).Should(HaveOccurred())
+			})
+		})
+
+		When("The destination bucket doesn't exist", func() {
+			ctrl := gomock.NewController(GinkgoT())
+			mockStorageClient := mock_s3iface.NewMockS3API(ctrl)
+			mockPSStorageClient := mock_s3iface.NewMockPreSignAPI(ctrl)
+			mockProvider := mock_provider.NewMockAwsResourceResolver(ctrl)
+			storagePlugin, _ := s3_service.NewWithClient(mockProvider, mockStorageClient, mockPSStorageClient)
+
+			It("should return an error without copying", func() {
+				By("only the source bucket existing")
+				mockProvider.EXPECT().GetResources(gomock.Any(), resource.AwsResource_Bucket).Times(2).Return(map[string]resource.ResolvedResource{
+					"incoming": {ARN: "arn:aws:s3:::incoming-aaa111"},
+				}, nil)
+
+				_, err := storagePlugin.Copy(context.TODO(), &storagepb.StorageCopyRequest{
+					SourceBucketName:      "incoming",
+					SourceKey:             "test-key",
+					DestinationBucketName: "processed",
+					DestinationKey:        "test-key",
+				})
+
+				By("returning a not found error")
+				Expect(err.Error()).To(ContainSubstring("NotFound"))
+			})
+		})
+	})
+
+	When("Move", func() {
+		When("The buckets exist", func() {
+			ctrl := gomock.NewController(GinkgoT())
+			mockStorageClient := mock_s3iface.NewMockS3API(ctrl)
+			mockPSStorageClient := mock_s3iface.NewMockPreSignAPI(ctrl)
+			mockProvider := mock_provider.NewMockAwsResourceResolver(ctrl)
+			storagePlugin, _ := s3_service.NewWithClient(mockProvider, mockStorageClient, mockPSStorageClient)
+
+			It("should copy the object and delete the source", func() {
+				By("the buckets existing")
+				mockProvider.EXPECT().GetResources(gomock.Any(), resource.AwsResource_Bucket).Times(2).Return(map[string]resource.ResolvedResource{
+					"incoming":  {ARN: "arn:aws:s3:::incoming-aaa111"},
+					"processed": {ARN: "arn:aws:s3:::processed-bbb222"},
+				}, nil)
+
+				By("s3 copying the object then deleting the source")
+				gomock.InOrder(
+					mockStorageClient.EXPECT().HeadObject(gomock.Any(), gomock.Any()).Return(&s3.HeadObjectOutput{ContentLength: aws.Int64(1024)}, nil),
+					mockStorageClient.EXPECT().CopyObject(gomock.Any(), &s3.CopyObjectInput{
+						Bucket:     aws.String("processed-bbb222"),
+						Key:        aws.String("done/test-key"),
+						CopySource: aws.String("incoming-aaa111%2Ftest-key"),
+					}).Return(&s3.CopyObjectOutput{}, nil),
+					mockStorageClient.EXPECT().DeleteObject(gomock.Any(), &s3.DeleteObjectInput{
+						Bucket: aws.String("incoming-aaa111"),
+						Key:    aws.String("test-key"),
+					}).Return(&s3.DeleteObjectOutput{}, nil),
+				)
+
+				_, err := storagePlugin.Move(context.TODO(), &storagepb.StorageMoveRequest{
+					SourceBucketName:      "incoming",
+					SourceKey:             "test-key",
+					DestinationBucketName: "processed",
+					DestinationKey:        "done/test-key",
+				})
+
+				By("not returning an error")
+				Expect(err).ShouldNot(HaveOccurred())
+			})
+
+			It("should not delete the source when the copy fails", func() {
+				By("the buckets existing")
+				mockProvider.EXPECT().GetResources(gomock.Any(), resource.AwsResource_Bucket).Times(2).Return(map[string]resource.ResolvedResource{
+					"incoming":  {ARN: "arn:aws:s3:::incoming-aaa111"},
+					"processed": {ARN: "arn:aws:s3:::processed-bbb222"},
+				}, nil)
+
+				By("s3 failing to copy the object")
+				mockStorageClient.EXPECT().HeadObject(gomock.Any(), gomock.Any()).Return(&s3.HeadObjectOutput{ContentLength: aws.Int64(1024)}, nil)
+				mockStorageClient.EXPECT().CopyObject(gomock.Any(), gomock.Any()).Return(nil, errors.New("mock-error"))
+
+				_, err := storagePlugin.Move(context.TODO(), &storagepb.StorageMoveRequest{
+					SourceBucketName:      "incoming",
+					SourceKey:             "test-key",
+					DestinationBucketName: "processed",
+					DestinationKey:        "test-key",
+				})
+
+				By("returning an error")
+				Expect(err).Should(HaveOccurred())
+			})
+		})
+	})
+
 	When("ListFiles", func() {
 		When("The bucket exists", func() {
 			When("The s3 backend is available", func() {
@@ -331,7 +785,10 @@ var _ = Describe("S3", func() {
 						Prefix: aws.String("test/"),
 					}).Return(&s3.ListObjectsV2Output{
 						Contents: []types.Object{{
-							Key: aws.String("test/test"),
+							Key:          aws.String("test/test"),
+							Size:         aws.Int64(12),
+							ETag:         aws.String("\"etag\""),
+							LastModified: aws.Time(time.Date(2024, time.March, 4, 10, 0, 0, 0, time.UTC)),
 						}},
 					}, nil)
 
@@ -348,6 +805,140 @@ var _ = Describe("S3", func() {
 
 					By("having the returned keys")
 					Expect(resp.Blobs[0].Key).To(Equal("test/test"))
+
+					By("having the returned attributes")
+					Expect(resp.Blobs[0].Size).To(Equal(int64(12)))
+					Expect(resp.Blobs[0].Etag).To(Equal("\"etag\""))
+					Expect(resp.Blobs[0].LastModified.AsTime()).To(Equal(time.Date(2024, time.March, 4, 10, 0, 0, 0, time.UTC)))
+				})
+
+				It("should list the files on every page when no page is requested", func() {
+					By("the bucket existing")
+					mockProvider.EXPECT().GetResources(gomock.Any(), resource.AwsResource_Bucket).Return(map[string]resource.ResolvedResource{
+						"test-bucket": {ARN: "arn:aws:s3:::test-bucket-aaa111"},
+					}, nil)
+
+					By("s3 returning the files in two pages")
+					gomock.InOrder(
+						mockStorageClient.EXPECT().ListObjectsV2(gomock.Any(), &s3.ListObjectsV2Input{
+							Bucket: aws.String("test-bucket-aaa111"),
+						}).Return(&s3.ListObjectsV2Output{
+							Contents:              []types.Object{{Key: aws.String("first")}},
+							IsTruncated:           aws.Bool(true),
+							NextContinuationToken: aws.String("page-2"),
+						}, nil),
+						mockStorageClient.EXPECT().ListObjectsV2(gomock.Any(), &s3.ListObjectsV2Input{
+							Bucket:            aws.String("test-bucket-aaa111"),
+							ContinuationToken: aws.String("page-2"),
+						}).Return(&s3.ListObjectsV2Output{
+							Contents:    []types.Object{{Key: aws.String("second")}},
+							IsTruncated: aws.Bool(false),
+						}, nil),
+					)
+
+					resp, err := storagePlugin.ListBlobs(context.TODO(), &storagepb.StorageListBlobsRequest{
+						BucketName: "test-bucket",
+					})
+
+					By("not returning an error")
+					Expect(err).ShouldNot(HaveOccurred())
+
+					By("returning the files from both pages")
+					Expect(resp.Blobs).To(HaveLen(2))
+					Expect(resp.Blobs[0].Key).To(Equal("first"))
+					Expect(resp.Blobs[1].Key).To(Equal("second"))
+
+					By("not returning a next page token")
+					Expect(resp.NextPageToken).To(BeEmpty())
+				})
+
+				It("should list a page of files and common prefixes", func() {
+					By("the bucket existing")
+					mockProvider.EXPECT().GetResources(gomock.Any(), resource.AwsResource_Bucket).Return(map[string]resource.ResolvedResource{
+						"test-bucket": {ARN: "arn:aws:s3:::test-bucket-aaa111"},
+					}, nil)
+
+					By("s3 returning a truncated page")
+					mockStorageClient.EXPECT().ListObjectsV2(gomock.Any(), &s3.ListObjectsV2Input{
+						Bucket:            aws.String("test-bucket-aaa111"),
+						Prefix:            aws.String("logs/"),
+						MaxKeys:           aws.Int32(2),
+						ContinuationToken: aws.String("page-1"),
+						Delimiter:         aws.String("/"),
+					}).Return(&s3.ListObjectsV2Output{
+						Contents:              []types.Object{{Key: aws.String("logs/index")}},
+						CommonPrefixes:        []types.CommonPrefix{{Prefix: aws.String("logs/2024-03-04/")}},
+						IsTruncated:           aws.Bool(true),
+						NextContinuationToken: aws.String("page-2"),
+					}, nil)
+
+					resp, err := storagePlugin.ListBlobs(context.TODO(), &storagepb.StorageListBlobsRequest{
+						BucketName: "test-bucket",
+						Prefix:     "logs/",
+						PageSize:   2,
+						PageToken:  "page-1",
+						Delimiter:  "/",
+					})
+
+					By("not returning an error")
+					Expect(err).ShouldNot(HaveOccurred())
+
+					By("returning the files and common prefixes")
+					Expect(resp.Blobs).To(HaveLen(1))
+					Expect(resp.Blobs[0].Key).To(Equal("logs/index"))
+					Expect(resp.CommonPrefixes).To(Equal([]string{"logs/2024-03-04/"}))
+
+					By("returning the token for the next page")
+					Expect(resp.NextPageToken).To(Equal("page-2"))
+				})
+
+				It("should read the attributes of each listed file when requested", func() {
+					By("the bucket existing")
+					mockProvider.EXPECT().GetResources(gomock.Any(), resource.AwsResource_Bucket).Return(map[string]resource.ResolvedResource{
+						"test-bucket": {ARN: "arn:aws:s3:::test-bucket-aaa111"},
+					}, nil)
+
+					By("s3 returning files")
+					mockStorageClient.EXPECT().ListObjectsV2(gomock.Any(), gomock.Any()).Return(&s3.ListObjectsV2Output{
+						Contents: []types.Object{
+							{Key: aws.String("test/style.css")},
+							{Key: aws.String("test/deleted")},
+						},
+					}, nil)
+
+					By("the first file existing")
+					mockStorageClient.EXPECT().HeadObject(gomock.Any(), &s3.HeadObjectInput{
+						Bucket: aws.String("test-bucket-aaa111"),
+						Key:    aws.String("test/style.css"),
+					}).Return(&s3.HeadObjectOutput{
+						ContentLength: aws.Int64(12),
+						ContentType:   aws.String("text/css"),
+						CacheControl:  aws.String("max-age=60"),
+						Metadata:      map[string]string{"owner": "me"},
+					}, nil)
+
+					By("the second file having been deleted since it was listed")
+					mockStorageClient.EXPECT().HeadObject(gomock.Any(), &s3.HeadObjectInput{
+						Bucket: aws.String("test-bucket-aaa111"),
+						Key:    aws.String("test/deleted"),
+					}).Return(nil, &types.NotFound{})
+
+					resp, err := storagePlugin.ListBlobs(context.TODO(), &storagepb.StorageListBlobsRequest{
+						BucketName:        "test-bucket",
+						Prefix:            "test/",
+						IncludeAttributes: true,
+					})
+
+					By("not returning an error")
+					Expect(err).ShouldNot(HaveOccurred())
+
+					By("returning the attributes of the remaining file")
+					Expect(resp.Blobs).To(HaveLen(1))
+					Expect(resp.Blobs[0].Key).To(Equal("test/style.css"))
+					Expect(resp.Blobs[0].Size).To(Equal(int64(12)))
+					Expect(resp.Blobs[0].ContentType).To(Equal("text/css"))
+					Expect(resp.Blobs[0].CacheControl).To(Equal("max-age=60"))
+					Expect(resp.Blobs[0].Metadata).To(Equal(map[string]string{"owner": "me"}))
 				})
 			})
 		})
@@ -416,4 +1007,258 @@ var _ = Describe("S3", func() {
 			})
 		})
 	})
+	When("Stat", func() {
+		When("The bucket exists", func() {
+			ctrl := gomock.NewController(GinkgoT())
+			mockStorageClient := mock_s3iface.NewMockS3API(ctrl)
+			mockPSStorageClient := mock_s3iface.NewMockPreSignAPI(ctrl)
+			mockProvider := mock_provider.NewMockAwsResourceResolver(ctrl)
+			storagePlugin, _ := s3_service.NewWithClient(mockProvider, mockStorageClient, mockPSStorageClient)
+
+			When("the file exists", func() {
+				It("should return its attributes and metadata", func() {
+					By("the bucket existing")
+					mockProvider.EXPECT().GetResources(gomock.Any(), resource.AwsResource_Bucket).Return(map[string]resource.ResolvedResource{
+						"test-bucket": {ARN: "arn:aws:s3:::test-bucket-aaa111"},
+					}, nil)
+
+					By("the file existing")
+					mockStorageClient.EXPECT().HeadObject(gomock.Any(), &s3.HeadObjectInput{
+						Bucket: aws.String("test-bucket-aaa111"),
+						Key:    aws.String("test-file"),
+					}).Return(&s3.HeadObjectOutput{
+						ContentLength: aws.Int64(12),
+						ContentType:   aws.String("text/css"),
+						CacheControl:  aws.String("max-age=60"),
+						ETag:          aws.String("\"etag\""),
+						LastModified:  aws.Time(time.Date(2024, time.March, 4, 10, 0, 0, 0, time.UTC)),
+						Metadata:      map[string]string{"owner": "me"},
+					}, nil)
+
+					resp, err := storagePlugin.Stat(context.TODO(), &storagepb.StorageStatRequest{
+						BucketName: "test-bucket",
+						Key:        "test-file",
+					})
+
+					By("not returning an error")
+					Expect(err).ShouldNot(HaveOccurred())
+
+					By("returning the attributes of the file")
+					Expect(resp.Blob.Key).To(Equal("test-file"))
+					Expect(resp.Blob.Size).To(Equal(int64(12)))
+					Expect(resp.Blob.ContentType).To(Equal("text/css"))
+					Expect(resp.Blob.CacheControl).To(Equal("max-age=60"))
+					Expect(resp.Blob.Etag).To(Equal("\"etag\""))
+					Expect(resp.Blob.LastModified.AsTime()).To(Equal(time.Date(2024, time.March, 4, 10, 0, 0, 0, time.UTC)))
+					Expect(resp.Blob.Metadata).To(Equal(map[string]string{"owner": "me"}))
+				})
+			})
+
+			When("the file does not exist", func() {
+				It("should return a not found error", func() {
+					By("the bucket existing")
+					mockProvider.EXPECT().GetResources(gomock.Any(), resource.AwsResource_Bucket).Return(map[string]resource.ResolvedResource{
+						"test-bucket": {ARN: "arn:aws:s3:::test-bucket-aaa111"},
+					}, nil)
+
+					By("the file not existing")
+					mockStorageClient.EXPECT().HeadObject(gomock.Any(), gomock.Any()).Return(nil, &types.NotFound{})
+
+					_, err := storagePlugin.Stat(context.TODO(), &storagepb.StorageStatRequest{
+						BucketName: "test-bucket",
+						Key:        "test-file",
+					})
+
+					By("returning a not found error")
+					Expect(err).Should(HaveOccurred())
+					Expect(err.Error()).To(ContainSubstring("NotFound"))
+				})
+			})
+		})
+	})
+
+	When("ReadStream", func() {
+		ctrl := gomock.NewController(GinkgoT())
+		mockStorageClient := mock_s3iface.NewMockS3API(ctrl)
+		mockPSStorageClient := mock_s3iface.NewMockPreSignAPI(ctrl)
+		mockProvider := mock_provider.NewMockAwsResourceResolver(ctrl)
+		storagePlugin, _ := s3_service.NewWithClient(mockProvider, mockStorageClient, mockPSStorageClient)
+
+		When("a byte range is requested", func() {
+			It("should stream the range of the object", func() {
+				By("the bucket existing")
+				mockProvider.EXPECT().GetResources(gomock.Any(), resource.AwsResource_Bucket).Return(map[string]resource.ResolvedResource{
+					"test-bucket": {ARN: "arn:aws:s3:::test-bucket-aaa111"},
+				}, nil)
+
+				By("reading the range of the object")
+				mockStorageClient.EXPECT().GetObject(gomock.Any(), &s3.GetObjectInput{
+					Bucket: aws.String("test-bucket-aaa111"),
+					Key:    aws.String("test-file"),
+					Range:  aws.String("bytes=10-14"),
+				}).Return(&s3.GetObjectOutput{
+					Body: io.NopCloser(bytes.NewReader([]byte("range"))),
+				}, nil)
+
+				stream := &mockReadStream{}
+				err := storagePlugin.ReadStream(&storagepb.StorageReadStreamRequest{
+					BucketName: "test-bucket",
+					Key:        "test-file",
+					Offset:     10,
+					Length:     5,
+				}, stream)
+
+				By("not returning an error")
+				Expect(err).ShouldNot(HaveOccurred())
+
+				By("sending the range")
+				Expect(stream.chunks).To(Equal([][]byte{[]byte("range")}))
+			})
+		})
+
+		When("the range is outside of the object", func() {
+			It("should return an out of range error", func() {
+				By("the bucket existing")
+				mockProvider.EXPECT().GetResources(gomock.Any(), resource.AwsResource_Bucket).Return(map[string]resource.ResolvedResource{
+					"test-bucket": {ARN: "arn:aws:s3:::test-bucket-aaa111"},
+				}, nil)
+
+				mockStorageClient.EXPECT().GetObject(gomock.Any(), gomock.Any()).Return(nil, &smithy.GenericAPIError{Code: "InvalidRange"})
+
+				err := storagePlugin.ReadStream(&storagepb.StorageReadStreamRequest{
+					BucketName: "test-bucket",
+					Key:        "test-file",
+					Offset:     100,
+				}, &mockReadStream{})
+
+				Expect(err).Should(HaveOccurred())
+				Expect(err.Error()).To(ContainSubstring("requested range is outside of the file"))
+			})
+		})
+	})
+
+	When("WriteStream", func() {
+		ctrl := gomock.NewController(GinkgoT())
+		mockStorageClient := mock_s3iface.NewMockS3API(ctrl)
+		mockPSStorageClient := mock_s3iface.NewMockPreSignAPI(ctrl)
+		mockProvider := mock_provider.NewMockAwsResourceResolver(ctrl)
+		storagePlugin, _ := s3_service.NewWithClient(mockProvider, mockStorageClient, mockPSStorageClient)
+
+		When("the file fits in a single part", func() {
+			It("should put the object", func() {
+				By("the bucket existing")
+				mockProvider.EXPECT().GetResources(gomock.Any(), resource.AwsResource_Bucket).Return(map[string]resource.ResolvedResource{
+					"test-bucket": {ARN: "arn:aws:s3:::test-bucket-aaa111"},
+				}, nil)
+
+				By("putting the object")
+				mockStorageClient.EXPECT().PutObject(gomock.Any(), gomock.Any()).DoAndReturn(func(ctx context.Context, input *s3.PutObjectInput, opts ...func(*s3.Options)) (*s3.PutObjectOutput, error) {
+					body, _ := io.ReadAll(input.Body)
+					Expect(string(body)).To(Equal("hello"))
+					Expect(*input.ContentType).To(HavePrefix("text/plain"))
+					return &s3.PutObjectOutput{}, nil
+				})
+
+				stream := newMockWriteStream("test-bucket", "test-file.txt", []byte("hello"))
+				err := storagePlugin.WriteStream(stream)
+
+				Expect(err).ShouldNot(HaveOccurred())
+				Expect(stream.response.Size).To(Equal(int64(5)))
+			})
+		})
+
+		When("the file is larger than a part", func() {
+			It("should upload the object in parts", func() {
+				payload := bytes.Repeat([]byte("a"), 9*1024*1024)
+
+				By("the bucket existing")
+				mockProvider.EXPECT().GetResources(gomock.Any(), resource.AwsResource_Bucket).Return(map[string]resource.ResolvedResource{
+					"test-bucket": {ARN: "arn:aws:s3:::test-bucket-aaa111"},
+				}, nil)
+
+				By("starting a multipart upload")
+				mockStorageClient.EXPECT().CreateMultipartUpload(gomock.Any(), gomock.Any()).Return(&s3.CreateMultipartUploadOutput{
+					UploadId: aws.String("upload-id"),
+				}, nil)
+
+				By("uploading each part")
+				mockStorageClient.EXPECT().UploadPart(gomock.Any(), gomock.Any()).Times(2).DoAndReturn(func(ctx context.Context, input *s3.UploadPartInput, opts ...func(*s3.Options)) (*s3.UploadPartOutput, error) {
+					return &s3.UploadPartOutput{ETag: aws.String(fmt.Sprintf("etag-%d", *input.PartNumber))}, nil
+				})
+
+				By("completing the upload")
+				mockStorageClient.EXPECT().CompleteMultipartUpload(gomock.Any(), &s3.CompleteMultipartUploadInput{
+					Bucket:   aws.String("test-bucket-aaa111"),
+					Key:      aws.String("test-file"),
+					UploadId: aws.String("upload-id"),
+					MultipartUpload: &types.CompletedMultipartUpload{
+						Parts: []types.CompletedPart{
+							{ETag: aws.String("etag-1"), PartNumber: aws.Int32(1)},
+							{ETag: aws.String("etag-2"), PartNumber: aws.Int32(2)},
+						},
+					},
+				}).Return(&s3.CompleteMultipartUploadOutput{}, nil)
+
+				stream := newMockWriteStream("test-bucket", "test-file", payload)
+				err := storagePlugin.WriteStream(stream)
+
+				Expect(err).ShouldNot(HaveOccurred())
+				Expect(stream.response.Size).To(Equal(int64(len(payload))))
+			})
+
+			It("should abort the upload when a part fails", func() {
+				By("the bucket existing")
+				mockProvider.EXPECT().GetResources(gomock.Any(), resource.AwsResource_Bucket).Return(map[string]resource.ResolvedResource{
+					"test-bucket": {ARN: "arn:aws:s3:::test-bucket-aaa111"},
+				}, nil)
+
+				mockStorageClient.EXPECT().CreateMultipartUpload(gomock.Any(), gomock.Any()).Return(&s3.CreateMultipartUploadOutput{
+					UploadId: aws.String("upload-id"),
+				}, nil)
+
+				By("failing to upload a part")
+				mockStorageClient.EXPECT().UploadPart(gomock.Any(), gomock.Any()).Return(nil, fmt.Errorf("mock-error"))
+
+				By("aborting the upload")
+				mockStorageClient.EXPECT().AbortMultipartUpload(gomock.Any(), &s3.AbortMultipartUploadInput{
+					Bucket:   aws.String("test-bucket-aaa111"),
+					Key:      aws.String("test-file"),
+					UploadId: aws.String("upload-id"),
+				}).Return(&s3.AbortMultipartUploadOutput{}, nil)
+
+				err := storagePlugin.WriteStream(newMockWriteStream("test-bucket", "test-file", bytes.Repeat([]byte("a"), 9*1024*1024)))
+
+				Expect(err).Should(HaveOccurred())
+			})
+		})
+
+		When("the stream doesn't start with a header", func() {
+			It("should return an invalid argument error", func() {
+				stream := newMockWriteStream("test-bucket", "test-file", []byte("hello"))
+				stream.requests = stream.requests[1:]
+
+				err := storagePlugin.WriteStream(stream)
+
+				Expect(err).Should(HaveOccurred())
+				Expect(err.Error()).To(ContainSubstring("invalid write stream"))
+			})
+		})
+
+		When("a header follows the chunks", func() {
+			It("should return an invalid argument error", func() {
+				By("the bucket existing")
+				mockProvider.EXPECT().GetResources(gomock.Any(), resource.AwsResource_Bucket).Return(map[string]resource.ResolvedResource{
+					"test-bucket": {ARN: "arn:aws:s3:::test-bucket-aaa111"},
+				}, nil)
+
+				stream := newMockWriteStream("test-bucket", "test-file", []byte("hello"))
+				stream.requests = append(stream.requests, stream.requests[0])
+
+				err := storagePlugin.WriteStream(stream)
+
+				Expect(err).Should(HaveOccurred())
+				Expect(status.Code(err)).To(Equal(codes.InvalidArgument))
+			})
+		})
+	})
 })
@@ -29,6 +29,7 @@ import (
 	"github.com/aws/aws-sdk-go-v2/service/sns"
 	"github.com/aws/aws-sdk-go-v2/service/sns/types"
 	"github.com/aws/smithy-go"
+	"github.com/google/uuid"
 	"go.opentelemetry.io/contrib/instrumentation/github.com/aws/aws-sdk-go-v2/otelaws"
 	"go.opentelemetry.io/contrib/propagators/aws/xray"
 	"go.opentelemetry.io/otel/propagation"
@@ -42,6 +43,8 @@ import (
 	grpc_errors "github.com/nitrictech/nitric/core/pkg/grpc/errors"
 	"github.com/nitrictech/nitric/core/pkg/help"
 	topicpb "github.com/nitrictech/nitric/core/pkg/proto/topics/v1"
+	"github.com/nitrictech/nitric/core/pkg/tracing"
+	"github.com/nitrictech/nitric/core/pkg/workers/topics"
 )
 
 type SnsEventService struct {
@@ -68,33 +71,81 @@ func (s *SnsEventService) getStateMachines(ctx context.Context) (map[string]reso
 	return s.resolver.GetResources(ctx, resource.AwsResource_StateMachine)
 }
 
-func (s *SnsEventService) publish(ctx context.Context, topic string, message string) error {
-	topics, err := s.getTopics(ctx)
+// errUnorderedTopic is returned when publishing a message with an ordering key to a topic that isn't ordered
+var errUnorderedTopic = errors.New("messages with an ordering key can only be published to ordered topics")
+
+func (s *SnsEventService) getTopic(ctx context.Context, topicName string) (resource.ResolvedResource, error) {
+	snsTopics, err := s.getTopics(ctx)
 	if err != nil {
-		return fmt.Errorf("error finding topics: %w", err)
+		return resource.ResolvedResource{}, fmt.Errorf("error finding topics: %w", err)
 	}
 
-	snsTopic, ok := topics[topic]
-
+	snsTopic, ok := snsTopics[topicName]
 	if !ok {
-		return fmt.Errorf("could not resolve topic ARN from topic name")
+		return resource.ResolvedResource{}, fmt.Errorf("could not resolve topic ARN from topic name")
+	}
+
+	return snsTopic, nil
+}
+
+// messageGroup returns the message group and deduplication ids required to publish to FIFO topics, which are deployed for ordered topics.
+// Messages without an ordering key are published to a group of their own, so they aren't held up by other messages.
+func messageGroup(req *topicpb.TopicPublishRequest, topicArn string) (groupId *string, deduplicationId *string, err error) {
+	if !strings.HasSuffix(topicArn, ".fifo") {
+		if req.OrderingKey != "" {
+			return nil, nil, errUnorderedTopic
+		}
+
+		return nil, nil, nil
+	}
+
+	groupId = aws.String(req.OrderingKey)
+	if req.OrderingKey == "" {
+		groupId = aws.String(uuid.NewString())
+	}
+
+	// FIFO topics discard messages with the same deduplication id published within 5 minutes
+	deduplicationId = aws.String(req.DeduplicationId)
+	if req.DeduplicationId == "" {
+		deduplicationId = aws.String(uuid.NewString())
+	}
+
+	return groupId, deduplicationId, nil
+}
+
+func (s *SnsEventService) publish(ctx context.Context, req *topicpb.TopicPublishRequest, message string) error {
+	snsTopic, err := s.getTopic(ctx, req.TopicName)
+	if err != nil {
+		return err
+	}
+
+	groupId, deduplicationId, err := messageGroup(req, snsTopic.ARN)
+	if err != nil {
+		return err
 	}
 
 	mc := propagation.MapCarrier{}
 	xray.Propagator{}.Inject(ctx, mc)
+	tracing.Propagator.Inject(ctx, mc)
 
 	attrs := map[string]types.MessageAttributeValue{}
 	for k, v := range mc {
 		attrs[k] = types.MessageAttributeValue{DataType: aws.String("String"), StringValue: aws.String(v)}
 	}
 
+	for k, v := range topics.PublishAttributes(req) {
+		attrs[k] = types.MessageAttributeValue{DataType: aws.String("String"), StringValue: aws.String(v)}
+	}
+
 	publishInput := &sns.PublishInput{
 		TopicArn: aws.String(snsTopic.ARN),
 		Message:  &message,
 		// MessageStructure: json is for an AWS specific JSON format,
 		// which sends different messages to different subscription types. Don't use it.
 		// MessageStructure: aws.String("json"),
-		MessageAttributes: attrs,
+		MessageAttributes:      attrs,
+		MessageGroupId:         groupId,
+		MessageDeduplicationId: deduplicationId,
 	}
 
 	_, err = s.client.Publish(ctx, publishInput)
@@ -102,7 +153,20 @@ func (s *SnsEventService) publish(ctx context.Context, topic string, message str
 	return err
 }
 
-func (s *SnsEventService) publishDelayed(ctx context.Context, topic string, delay time.Duration, message string) error {
+func (s *SnsEventService) publishDelayed(ctx context.Context, req *topicpb.TopicPublishRequest, message string) error {
+	topic := req.TopicName
+	delay := req.Delay.AsDuration()
+
+	snsTopic, err := s.getTopic(ctx, topic)
+	if err != nil {
+		return err
+	}
+
+	groupId, deduplicationId, err := messageGroup(req, snsTopic.ARN)
+	if err != nil {
+		return err
+	}
+
 	stepFunctions, err := s.getStateMachines(ctx)
 	if err != nil {
 		return fmt.Errorf("error getting state machines: %w", err)
@@ -116,10 +180,35 @@ func (s *SnsEventService) publishDelayed(ctx context.Context, topic string, dela
 	mc := propagation.MapCarrier{}
 	xray.Propagator{}.Inject(ctx, mc)
 
-	input, err := json.Marshal(map[string]interface{}{
-		"seconds": int(delay / time.Second),
-		"message": message,
-	})
+	// attributes are passed to SNS by the state machine, in the format of SNS message attributes
+	attrs := map[string]interface{}{}
+	for k, v := range tracing.Inject(ctx) {
+		attrs[k] = map[string]string{
+			"DataType":    "String",
+			"StringValue": v,
+		}
+	}
+
+	for k, v := range topics.PublishAttributes(req) {
+		attrs[k] = map[string]string{
+			"DataType":    "String",
+			"StringValue": v,
+		}
+	}
+
+	executionInput := map[string]interface{}{
+		"seconds":    int(delay / time.Second),
+		"message":    message,
+		"attributes": attrs,
+	}
+
+	// the state machines of ordered topics publish with the message group and deduplication ids
+	if groupId != nil {
+		executionInput["groupId"] = *groupId
+		executionInput["deduplicationId"] = *deduplicationId
+	}
+
+	input, err := json.Marshal(executionInput)
 	if err != nil {
 		return err
 	}
@@ -148,12 +237,16 @@ func (s *SnsEventService) Publish(ctx context.Context, req *topicpb.TopicPublish
 	message := base64.StdEncoding.EncodeToString(messageBytes)
 
 	if req.Delay != nil && req.Delay.AsDuration() > 0 {
-		err = s.publishDelayed(ctx, req.TopicName, req.Delay.AsDuration(), message)
+		err = s.publishDelayed(ctx, req, message)
 	} else {
-		err = s.publish(ctx, req.TopicName, message)
+		err = s.publish(ctx, req, message)
 	}
 
 	if err != nil {
+		if errors.Is(err, errUnorderedTopic) {
+			return nil, newErr(codes.FailedPrecondition, fmt.Sprintf("topic %s isn't ordered", req.TopicName), err)
+		}
+
 		if isSNSAccessDeniedErr(err) {
 			return nil, newErr(
 				codes.PermissionDenied,
@@ -16,6 +16,7 @@ package topic_test
 
 import (
 	"context"
+	"encoding/base64"
 	"encoding/json"
 	"time"
 
@@ -28,6 +29,8 @@ import (
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
 	"github.com/pkg/errors"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/proto"
 	"google.golang.org/protobuf/types/known/durationpb"
 	"google.golang.org/protobuf/types/known/structpb"
@@ -82,6 +85,160 @@ var _ = Describe("Sns", func() {
 			})
 		})
 
+		When("Publishing with attributes and a deduplication id", func() {
+			ctrl := gomock.NewController(GinkgoT())
+			awsMock := provider_mocks.NewMockAwsResourceResolver(ctrl)
+			snsMock := sns_mock.NewMockSNSAPI(ctrl)
+
+			eventsClient, _ := sns_service.NewWithClient(awsMock, snsMock, nil)
+			payload, _ := structpb.NewStruct(map[string]interface{}{"Test": "test"})
+
+			message := &eventpb.TopicMessage{
+				Content: &eventpb.TopicMessage_StructPayload{
+					StructPayload: payload,
+				},
+			}
+
+			data, _ := proto.Marshal(message)
+			stringData := base64.StdEncoding.EncodeToString(data)
+
+			It("Should publish with the attributes and deduplication id as message attributes", func() {
+				By("Retrieving a list of topics")
+				awsMock.EXPECT().GetResources(gomock.Any(), resource.AwsResource_Topic).Return(map[string]resource.ResolvedResource{
+					"test": {ARN: "arn:test"},
+				}, nil)
+
+				By("Publishing the message to the topic")
+				snsMock.EXPECT().Publish(gomock.Any(), &sns.PublishInput{
+					MessageAttributes: map[string]types.MessageAttributeValue{
+						"kind":                      {DataType: aws.String("String"), StringValue: aws.String("created")},
+						"x-nitric-deduplication-id": {DataType: aws.String("String"), StringValue: aws.String("event-1")},
+					},
+					TopicArn: aws.String("arn:test"),
+					Message:  aws.String(stringData),
+				})
+
+				_, err := eventsClient.Publish(context.TODO(), &eventpb.TopicPublishRequest{
+					TopicName:       "test",
+					Message:         message,
+					Attributes:      map[string]string{"kind": "created"},
+					DeduplicationId: "event-1",
+				})
+
+				Expect(err).To(BeNil())
+			})
+		})
+
+		When("Publishing with an ordering key to a topic that isn't ordered", func() {
+			ctrl := gomock.NewController(GinkgoT())
+			awsMock := provider_mocks.NewMockAwsResourceResolver(ctrl)
+			snsMock := sns_mock.NewMockSNSAPI(ctrl)
+
+			eventsClient, _ := sns_service.NewWithClient(awsMock, snsMock, nil)
+			payload, _ := structpb.NewStruct(map[string]interface{}{"Test": "test"})
+
+			It("Should return a failed precondition error", func() {
+				By("Retrieving a list of topics")
+				awsMock.EXPECT().GetResources(gomock.Any(), resource.AwsResource_Topic).Return(map[string]resource.ResolvedResource{
+					"test": {ARN: "arn:test"},
+				}, nil)
+
+				_, err := eventsClient.Publish(context.TODO(), &eventpb.TopicPublishRequest{
+					TopicName: "test",
+					Message: &eventpb.TopicMessage{
+						Content: &eventpb.TopicMessage_StructPayload{
+							StructPayload: payload,
+						},
+					},
+					OrderingKey: "order-1",
+				})
+
+				Expect(err).Should(HaveOccurred())
+				Expect(status.Code(err)).To(Equal(codes.FailedPrecondition))
+			})
+		})
+
+		When("Publishing with an ordering key to an ordered topic", func() {
+			ctrl := gomock.NewController(GinkgoT())
+			awsMock := provider_mocks.NewMockAwsResourceResolver(ctrl)
+			snsMock := sns_mock.NewMockSNSAPI(ctrl)
+
+			eventsClient, _ := sns_service.NewWithClient(awsMock, snsMock, nil)
+			payload, _ := structpb.NewStruct(map[string]interface{}{"Test": "test"})
+
+			It("Should publish with the ordering key as the message group id", func() {
+				By("Retrieving a list of topics")
+				awsMock.EXPECT().GetResources(gomock.Any(), resource.AwsResource_Topic).Return(map[string]resource.ResolvedResource{
+					"test": {ARN: "arn:test.fifo"},
+				}, nil)
+
+				By("Publishing the message to the topic")
+				snsMock.EXPECT().Publish(gomock.Any(), gomock.Any()).DoAndReturn(func(ctx context.Context, input *sns.PublishInput, opts ...func(*sns.Options)) (*sns.PublishOutput, error) {
+					Expect(*input.TopicArn).To(Equal("arn:test.fifo"))
+					Expect(*input.MessageGroupId).To(Equal("order-1"))
+					Expect(*input.MessageDeduplicationId).To(Equal("event-1"))
+
+					return &sns.PublishOutput{}, nil
+				})
+
+				_, err := eventsClient.Publish(context.TODO(), &eventpb.TopicPublishRequest{
+					TopicName: "test",
+					Message: &eventpb.TopicMessage{
+						Content: &eventpb.TopicMessage_StructPayload{
+							StructPayload: payload,
+						},
+					},
+					OrderingKey:     "order-1",
+					DeduplicationId: "event-1",
+				})
+
+				Expect(err).To(BeNil())
+			})
+		})
+
+		When("Publishing without an ordering key to an ordered topic", func() {
+			ctrl := gomock.NewController(GinkgoT())
+			awsMock := provider_mocks.NewMockAwsResourceResolver(ctrl)
+			snsMock := sns_mock.NewMockSNSAPI(ctrl)
+
+			eventsClient, _ := sns_service.NewWithClient(awsMock, snsMock, nil)
+			payload, _ := structpb.NewStruct(map[string]interface{}{"Test": "test"})
+
+			It("Should publish each message to a message group of its own", func() {
+				By("Retrieving a list of topics")
+				awsMock.EXPECT().GetResources(gomock.Any(), resource.AwsResource_Topic).Return(map[string]resource.ResolvedResource{
+					"test": {ARN: "arn:test.fifo"},
+				}, nil).Times(2)
+
+				groupIds := []string{}
+
+				By("Publishing the messages to the topic")
+				snsMock.EXPECT().Publish(gomock.Any(), gomock.Any()).DoAndReturn(func(ctx context.Context, input *sns.PublishInput, opts ...func(*sns.Options)) (*sns.PublishOutput, error) {
+					Expect(*input.MessageGroupId).ToNot(BeEmpty())
+					Expect(*input.MessageDeduplicationId).ToNot(BeEmpty())
+
+					groupIds = append(groupIds, *input.MessageGroupId)
+
+					return &sns.PublishOutput{}, nil
+				}).Times(2)
+
+				for i := 0; i < 2; i++ {
+					_, err := eventsClient.Publish(context.TODO(), &eventpb.TopicPublishRequest{
+						TopicName: "test",
+						Message: &eventpb.TopicMessage{
+							Content: &eventpb.TopicMessage_StructPayload{
+								StructPayload: payload,
+							},
+						},
+					})
+
+					Expect(err).To(BeNil())
+				}
+
+				Expect(groupIds[0]).ToNot(Equal(groupIds[1]))
+			})
+		})
+
 		When("Publishing to a non-existent topic", func() {
 			ctrl := gomock.NewController(GinkgoT())
 			awsMock := provider_mocks.NewMockAwsResourceResolver(ctrl)
@@ -161,13 +318,19 @@ var _ = Describe("Sns", func() {
 
 			It("Should publish without error", func() {
 				By("Retrieving a list of topics")
+				awsMock.EXPECT().GetResources(gomock.Any(), resource.AwsResource_Topic).Return(map[string]resource.ResolvedResource{
+					"test": {ARN: "arn:test-topic"},
+				}, nil)
+
+				By("Retrieving a list of state machines")
 				awsMock.EXPECT().GetResources(gomock.Any(), resource.AwsResource_StateMachine).Return(map[string]resource.ResolvedResource{
 					"test": {ARN: "arn:test"},
 				}, nil)
 
 				input, _ := json.Marshal(map[string]interface{}{
-					"seconds": 1,
-					"message": stringData,
+					"seconds":    1,
+					"message":    stringData,
+					"attributes": map[string]interface{}{},
 				})
 
 				By("Publishing the message to the topic")
@@ -190,5 +353,57 @@ var _ = Describe("Sns", func() {
 				Expect(err).To(BeNil())
 			})
 		})
+
+		When("Publishing to an ordered topic", func() {
+			ctrl := gomock.NewController(GinkgoT())
+			awsMock := provider_mocks.NewMockAwsResourceResolver(ctrl)
+			sfnMock := sfn_mock.NewMockSFNAPI(ctrl)
+
+			eventsClient, _ := sns_service.NewWithClient(awsMock, nil, sfnMock)
+			payload, _ := structpb.NewStruct(map[string]interface{}{"Test": "test"})
+
+			message := &eventpb.TopicMessage{
+				Content: &eventpb.TopicMessage_StructPayload{
+					StructPayload: payload,
+				},
+			}
+
+			marshalledMessage, _ := proto.Marshal(message)
+			stringData := base64.StdEncoding.EncodeToString(marshalledMessage)
+
+			It("Should pass the message group and deduplication ids to the state machine", func() {
+				By("Retrieving a list of topics")
+				awsMock.EXPECT().GetResources(gomock.Any(), resource.AwsResource_Topic).Return(map[string]resource.ResolvedResource{
+					"test": {ARN: "arn:test-topic.fifo"},
+				}, nil)
+
+				By("Retrieving a list of state machines")
+				awsMock.EXPECT().GetResources(gomock.Any(), resource.AwsResource_StateMachine).Return(map[string]resource.ResolvedResource{
+					"test": {ARN: "arn:test"},
+				}, nil)
+
+				By("Starting the state machine")
+				sfnMock.EXPECT().StartExecution(gomock.Any(), gomock.Any()).DoAndReturn(func(ctx context.Context, input *sfn.StartExecutionInput, opts ...func(*sfn.Options)) (*sfn.StartExecutionOutput, error) {
+					executionInput := map[string]interface{}{}
+					Expect(json.Unmarshal([]byte(*input.Input), &executionInput)).To(Succeed())
+
+					Expect(executionInput).To(HaveKeyWithValue("message", stringData))
+					Expect(executionInput).To(HaveKeyWithValue("groupId", "order-1"))
+					Expect(executionInput).To(HaveKeyWithValue("deduplicationId", "event-1"))
+
+					return &sfn.StartExecutionOutput{}, nil
+				})
+
+				_, err := eventsClient.Publish(context.TODO(), &eventpb.TopicPublishRequest{
+					TopicName:       "test",
+					Delay:           durationpb.New(time.Second * 1),
+					Message:         message,
+					OrderingKey:     "order-1",
+					DeduplicationId: "event-1",
+				})
+
+				Expect(err).To(BeNil())
+			})
+		})
 	})
 })
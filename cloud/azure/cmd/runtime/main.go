@@ -22,6 +22,8 @@ import (
 )
 
 func main() {
+	logger.SetDefault()
+
 	resourceResolver, err := resource.New()
 	if err != nil {
 		logger.Fatalf("could not create core azure resource resolver: %v", err)
@@ -162,6 +162,12 @@ func (p *NitricAzurePulumiProvider) newContainerEnv(ctx *pulumi.Context, name st
 		return nil, err
 	}
 
+	// used by services to manage the dapr cron components of their schedules
+	res.Env = append(res.Env, app.EnvironmentVarArgs{
+		Name:  pulumi.String("AZURE_CONTAINER_ENVIRONMENT_ID"),
+		Value: res.ManagedEnv.ID(),
+	})
+
 	creds := pulumi.All(p.ResourceGroup.Name, res.Registry.Name).ApplyT(func(args []interface{}) (*containerregistry.ListRegistryCredentialsResult, error) {
 		rgName := args[0].(string)
 		regName := args[1].(string)
@@ -32,6 +32,7 @@ import (
 	resourcespb "github.com/nitrictech/nitric/core/pkg/proto/resources/v1"
 	"github.com/pkg/errors"
 	apimanagement "github.com/pulumi/pulumi-azure-native-sdk/apimanagement/v2"
+	"github.com/pulumi/pulumi-azure-native-sdk/app"
 	"github.com/pulumi/pulumi-azure-native-sdk/authorization"
 	cdn "github.com/pulumi/pulumi-azure-native-sdk/cdn/v2"
 	"github.com/pulumi/pulumi-azure-native-sdk/containerinstance/v2"
@@ -85,6 +86,7 @@ type NitricAzurePulumiProvider struct {
 
 	ContainerApps map[string]*ContainerApp
 	Topics        map[string]*eventgrid.Topic
+	Schedules     map[string]*app.DaprComponent
 
 	KeyValueStores map[string]*storage.Table
 
@@ -498,6 +500,7 @@ func NewNitricAzurePulumiProvider() *NitricAzurePulumiProvider {
 		Queues:                 make(map[string]*storage.Queue),
 		ContainerApps:          make(map[string]*ContainerApp),
 		Topics:                 make(map[string]*eventgrid.Topic),
+		Schedules:              make(map[string]*app.DaprComponent),
 		SqlMigrations:          make(map[string]*containerinstance.ContainerGroup),
 		Principals:             principalsMap,
 		KeyValueStores:         make(map[string]*storage.Table),
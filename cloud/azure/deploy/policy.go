@@ -130,6 +130,15 @@ func (p *NitricAzurePulumiProvider) scopeFromResource(resource *deploymentspb.Re
 			),
 			// condition: pulumi.Sprintf("@Resource[Microsoft.KeyVault/vaults/secrets].name equals %s'", resource.Name),
 		}, nil
+	case resourcespb.ResourceType_Schedule:
+		component, ok := p.Schedules[resource.Id.Name]
+		if !ok {
+			return nil, fmt.Errorf("schedule %s not found", resource.Id.Name)
+		}
+
+		return &resourceScope{
+			scope: component.ID().ToStringOutput(),
+		}, nil
 	default:
 		return nil, fmt.Errorf("unknown resource type %s", resource.Id.Type)
 	}
@@ -77,7 +77,8 @@ var roleDefinitions = map[resourcespb.Action]RoleDefinition{
 				DataActions: pulumi.StringArray{
 					pulumi.String("Microsoft.Storage/storageAccounts/queueServices/queues/messages/read"),
 					pulumi.String("Microsoft.Storage/storageAccounts/queueServices/queues/messages/delete"),
-					// pulumi.String("Microsoft.Storage/storageAccounts/queueServices/queues/messages/update"),
+					// Required to extend and release message leases
+					pulumi.String("Microsoft.Storage/storageAccounts/queueServices/queues/messages/write"),
 				},
 				NotActions: pulumi.StringArray{},
 			},
@@ -206,6 +207,19 @@ var roleDefinitions = map[resourcespb.Action]RoleDefinition{
 			},
 		},
 	},
+	resourcespb.Action_ScheduleManage: {
+		Description: pulumi.String("schedule management access"),
+		Permissions: authorization.PermissionArray{
+			authorization.PermissionArgs{
+				Actions: pulumi.StringArray{
+					pulumi.String("Microsoft.App/managedEnvironments/daprComponents/read"),
+					pulumi.String("Microsoft.App/managedEnvironments/daprComponents/write"),
+				},
+				DataActions: pulumi.StringArray{},
+				NotActions:  pulumi.StringArray{},
+			},
+		},
+	},
 }
 
 type Roles struct {
@@ -228,6 +242,7 @@ var actionNames = map[resourcespb.Action]string{
 	resourcespb.Action_KeyValueStoreWrite:  "KeyValueStoreWrite",
 	resourcespb.Action_QueueEnqueue:        "QueueEnqueue",
 	resourcespb.Action_QueueDequeue:        "QueueDequeue",
+	resourcespb.Action_ScheduleManage:      "ScheduleManage",
 }
 
 func (p *NitricAzurePulumiProvider) CreateRoles(ctx *pulumi.Context, stackId string, subscriptionId string, rgName pulumi.StringInput) (*Roles, error) {
@@ -51,7 +51,7 @@ func (p *NitricAzurePulumiProvider) Schedule(ctx *pulumi.Context, parent pulumi.
 		return err
 	}
 
-	_, err = app.NewDaprComponent(ctx, normalizedName, &app.DaprComponentArgs{
+	component, err := app.NewDaprComponent(ctx, normalizedName, &app.DaprComponentArgs{
 		ResourceGroupName: p.ResourceGroup.Name,
 		EnvironmentName:   p.ContainerEnv.ManagedEnv.Name,
 		ComponentName:     pulumi.String(normalizedName),
@@ -76,5 +76,7 @@ func (p *NitricAzurePulumiProvider) Schedule(ctx *pulumi.Context, parent pulumi.
 		return errors.WithMessage(err, fmt.Sprintf("unable to create nitric schedule %s: failed to create DaprComponent for app", name))
 	}
 
+	p.Schedules[name] = component
+
 	return nil
 }
@@ -240,6 +240,10 @@ func (p *NitricAzurePulumiProvider) Service(ctx *pulumi.Context, parent pulumi.R
 			Name:  pulumi.String(resource.NITRIC_STACK_ID),
 			Value: pulumi.String(p.StackId),
 		},
+		app.EnvironmentVarArgs{
+			Name:  pulumi.String("NITRIC_SERVICE_NAME"),
+			Value: pulumi.String(name),
+		},
 		app.EnvironmentVarArgs{
 			Name:  pulumi.String("MIN_WORKERS"),
 			Value: pulumi.String(fmt.Sprint(service.Workers)),
@@ -16,9 +16,12 @@ package deploy
 
 import (
 	"fmt"
+	"strings"
 
 	nitricresources "github.com/nitrictech/nitric/cloud/common/deploy/resources"
 	deploymentspb "github.com/nitrictech/nitric/core/pkg/proto/deployments/v1"
+	topicspb "github.com/nitrictech/nitric/core/pkg/proto/topics/v1"
+	"github.com/nitrictech/nitric/core/pkg/workers/topics"
 	eventgrid "github.com/pulumi/pulumi-azure-native-sdk/eventgrid/v2"
 	"github.com/pulumi/pulumi-azure-native-sdk/resources"
 	"github.com/pulumi/pulumi-random/sdk/v4/go/random"
@@ -71,13 +74,15 @@ func (p *NitricAzurePulumiProvider) newEventGridTopicSubscription(ctx *pulumi.Co
 		return err
 	}
 
-	_, err = eventgrid.NewEventSubscription(ctx, subName, &eventgrid.EventSubscriptionArgs{
+	retryPolicy, err := subscriptionRetryPolicy(ctx, subName, config.GetRetryPolicy())
+	if err != nil {
+		return err
+	}
+
+	subscriptionArgs := &eventgrid.EventSubscriptionArgs{
 		EventSubscriptionName: subscriptionId.Result,
 		Scope:                 topic.ID(),
-		RetryPolicy: eventgrid.RetryPolicyArgs{
-			MaxDeliveryAttempts:      pulumi.Int(30),
-			EventTimeToLiveInMinutes: pulumi.Int(5),
-		},
+		RetryPolicy:           retryPolicy,
 		Destination: &eventgrid.WebHookEventSubscriptionDestinationArgs{
 			EndpointType:                           pulumi.String("WebHook"),
 			EndpointUrl:                            pulumi.Sprintf("%s/%s/x-nitric-topic/%s", hostUrl, target.EventToken, topicName),
@@ -85,11 +90,124 @@ func (p *NitricAzurePulumiProvider) newEventGridTopicSubscription(ctx *pulumi.Co
 			AzureActiveDirectoryApplicationIdOrUri: target.Sp.ClientID,
 			AzureActiveDirectoryTenantId:           target.Sp.TenantID,
 		},
-	}, opts...)
+	}
+
+	if len(config.GetFilter().GetAttributes()) > 0 {
+		advancedFilters, err := subscriptionAdvancedFilters(config.GetFilter())
+		if err != nil {
+			return fmt.Errorf("invalid filter for subscription %s: %w", subName, err)
+		}
+
+		subscriptionArgs.Filter = &eventgrid.EventSubscriptionFilterArgs{
+			AdvancedFilters: advancedFilters,
+		}
+	}
+
+	switch deadLetter := config.GetDeadLetter().GetDestination().(type) {
+	case nil:
+	case *deploymentspb.DeadLetterDestination_Bucket:
+		bucket, ok := p.Buckets[deadLetter.Bucket]
+		if !ok {
+			return fmt.Errorf("Unable to find dead-letter bucket: %s", deadLetter.Bucket)
+		}
+
+		subscriptionArgs.DeadLetterDestination = eventgrid.StorageBlobDeadLetterDestinationArgs{
+			EndpointType:      pulumi.String("StorageBlob"),
+			ResourceId:        p.StorageAccount.ID().ToStringOutput(),
+			BlobContainerName: pulumi.String(deadLetter.Bucket),
+		}
+		opts = append(opts, pulumi.DependsOn([]pulumi.Resource{bucket}))
+	default:
+		return fmt.Errorf("Event Grid subscriptions can only dead-letter to buckets, subscription: %s", subName)
+	}
+
+	_, err = eventgrid.NewEventSubscription(ctx, subName, subscriptionArgs, opts...)
 
 	return err
 }
 
+// subscriptionAdvancedFilters converts a subscription filter to Event Grid advanced filters.
+// Message attributes are published in the event data, Event Grid compares strings case-insensitively
+// so the advanced filters only narrow deliveries, the runtime applies the case-sensitive filter of each handler.
+func subscriptionAdvancedFilters(filter *topicspb.SubscriptionFilter) (pulumi.Array, error) {
+	if err := topics.ValidateFilter(filter); err != nil {
+		return nil, err
+	}
+
+	advancedFilters := pulumi.Array{}
+
+	for _, attributeFilter := range filter.GetAttributes() {
+		if strings.Contains(attributeFilter.GetName(), ".") {
+			return nil, fmt.Errorf("Event Grid can't filter on attribute %s, attribute names can't contain '.'", attributeFilter.GetName())
+		}
+
+		key := pulumi.String("data.attributes." + attributeFilter.GetName())
+
+		switch condition := attributeFilter.GetCondition().(type) {
+		case *topicspb.AttributeFilter_AnyOf:
+			advancedFilters = append(advancedFilters, eventgrid.StringInAdvancedFilterArgs{
+				Key:          key,
+				OperatorType: pulumi.String("StringIn"),
+				Values:       pulumi.ToStringArray(condition.AnyOf.GetValues()),
+			})
+		case *topicspb.AttributeFilter_NoneOf:
+			// StringNotIn would also drop values differing only in case, the excluded values are left to the runtime
+			advancedFilters = append(advancedFilters, eventgrid.IsNotNullAdvancedFilterArgs{
+				Key:          key,
+				OperatorType: pulumi.String("IsNotNull"),
+			})
+		case *topicspb.AttributeFilter_Prefix:
+			advancedFilters = append(advancedFilters, eventgrid.StringBeginsWithAdvancedFilterArgs{
+				Key:          key,
+				OperatorType: pulumi.String("StringBeginsWith"),
+				Values:       pulumi.ToStringArray([]string{condition.Prefix}),
+			})
+		case *topicspb.AttributeFilter_Exists:
+			if condition.Exists {
+				advancedFilters = append(advancedFilters, eventgrid.IsNotNullAdvancedFilterArgs{
+					Key:          key,
+					OperatorType: pulumi.String("IsNotNull"),
+				})
+			} else {
+				advancedFilters = append(advancedFilters, eventgrid.IsNullOrUndefinedAdvancedFilterArgs{
+					Key:          key,
+					OperatorType: pulumi.String("IsNullOrUndefined"),
+				})
+			}
+		}
+	}
+
+	return advancedFilters, nil
+}
+
+const (
+	// Event Grid limits for subscription retry policies
+	defaultMaxDeliveryAttempts = 30
+	defaultEventTimeToLive     = 5
+)
+
+// subscriptionRetryPolicy converts a subscription retry policy to an Event Grid retry policy,
+// Event Grid retries with its own backoff schedule so backoff settings are ignored.
+func subscriptionRetryPolicy(ctx *pulumi.Context, subName string, policy *deploymentspb.SubscriptionRetryPolicy) (eventgrid.RetryPolicyArgs, error) {
+	maxAttempts := int32(defaultMaxDeliveryAttempts)
+	if policy.GetMaxAttempts() > 0 {
+		maxAttempts = policy.GetMaxAttempts()
+	}
+
+	if maxAttempts > defaultMaxDeliveryAttempts {
+		return eventgrid.RetryPolicyArgs{}, fmt.Errorf("max attempts for subscription %s exceeds the Event Grid limit of %d", subName, defaultMaxDeliveryAttempts)
+	}
+
+	if policy.GetMinBackoffSeconds() > 0 || policy.GetMaxBackoffSeconds() > 0 {
+		_ = ctx.Log.Warn("Event Grid doesn't support configurable backoff, the backoff for subscription "+subName+" will be ignored", nil)
+	}
+
+	return eventgrid.RetryPolicyArgs{
+		MaxDeliveryAttempts:      pulumi.Int(int(maxAttempts)),
+		EventTimeToLiveInMinutes: pulumi.Int(defaultEventTimeToLive),
+	}, nil
+}
+
 func (p *NitricAzurePulumiProvider) Topic(ctx *pulumi.Context, parent pulumi.Resource, name string, config *deploymentspb.Topic) error {
 	var err error
 	opts := []pulumi.ResourceOption{pulumi.Parent(parent)}
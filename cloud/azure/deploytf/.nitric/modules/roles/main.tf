@@ -78,7 +78,8 @@ resource "azurerm_role_definition" "nitric_role_queue_dequeue" {
     ]
     data_actions = [
       "Microsoft.Storage/storageAccounts/queueServices/queues/messages/read",
-      "Microsoft.Storage/storageAccounts/queueServices/queues/messages/delete"
+      "Microsoft.Storage/storageAccounts/queueServices/queues/messages/delete",
+      "Microsoft.Storage/storageAccounts/queueServices/queues/messages/write"
     ]
     not_actions = []
   }
@@ -102,6 +103,23 @@ resource "azurerm_role_definition" "nitric_role_allow_user_delegation_key_genera
   assignable_scopes = ["/subscriptions/${data.azurerm_subscription.current.subscription_id}/resourceGroups/${var.resource_group_name}"]
 }
 
+resource "azurerm_role_definition" "nitric_role_schedule_manage" {
+  description = "nitric schedule management access"
+  name        = "${var.stack_id}-ScheduleManage"
+  scope       = "/subscriptions/${data.azurerm_subscription.current.subscription_id}/resourceGroups/${var.resource_group_name}"
+
+  permissions {
+    actions = [
+      "Microsoft.App/managedEnvironments/daprComponents/read",
+      "Microsoft.App/managedEnvironments/daprComponents/write"
+    ]
+    data_actions = []
+    not_actions  = []
+  }
+
+  assignable_scopes = ["/subscriptions/${data.azurerm_subscription.current.subscription_id}/resourceGroups/${var.resource_group_name}"]
+}
+
 resource "azurerm_role_definition" "nitric_role_bucket_file_get" {
   description = "nitric bucket file get access"
   name        = "${var.stack_id}-BucketFileGet"
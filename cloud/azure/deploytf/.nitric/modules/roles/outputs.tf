@@ -13,6 +13,11 @@ output "allow_user_delegation_key_generation" {
   description = "The role ID for the Nitric allow user delegation key generation role"
 }
 
+output "schedule_manage" {
+  value       = azurerm_role_definition.nitric_role_schedule_manage.role_definition_resource_id
+  description = "The role ID for the Nitric schedule management role"
+}
+
 output "bucket_write" {
   value       = azurerm_role_definition.nitric_role_bucket_file_put.role_definition_resource_id
   description = "The role ID for the Nitric bucket write role"
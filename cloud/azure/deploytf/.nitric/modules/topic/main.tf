@@ -23,9 +23,61 @@ resource "azurerm_eventgrid_event_subscription" "subscription" {
   scope = azurerm_eventgrid_topic.topic.id
 
   retry_policy {
-    max_delivery_attempts = 10
+    max_delivery_attempts = each.value.max_delivery_attempts
     event_time_to_live    = 5
   }
+
+  dynamic "storage_blob_dead_letter_destination" {
+    for_each = each.value.dead_letter_container == null ? [] : [each.value]
+
+    content {
+      storage_account_id          = storage_blob_dead_letter_destination.value.dead_letter_storage_account_id
+      storage_blob_container_name = storage_blob_dead_letter_destination.value.dead_letter_container
+    }
+  }
+
+  # Event Grid compares strings case-insensitively, so the filters only narrow deliveries
+  # and the runtime applies the exact filter of each handler
+  dynamic "advanced_filter" {
+    for_each = length(each.value.string_in) + length(each.value.string_begins_with) + length(each.value.is_not_null) + length(each.value.is_null_or_undefined) == 0 ? [] : [each.value]
+
+    content {
+      dynamic "string_in" {
+        for_each = advanced_filter.value.string_in
+
+        content {
+          key    = string_in.value.key
+          values = string_in.value.values
+        }
+      }
+
+      dynamic "string_begins_with" {
+        for_each = advanced_filter.value.string_begins_with
+
+        content {
+          key    = string_begins_with.value.key
+          values = string_begins_with.value.values
+        }
+      }
+
+      dynamic "is_not_null" {
+        for_each = advanced_filter.value.is_not_null
+
+        content {
+          key = is_not_null.value
+        }
+      }
+
+      dynamic "is_null_or_undefined" {
+        for_each = advanced_filter.value.is_null_or_undefined
+
+        content {
+          key = is_null_or_undefined.value
+        }
+      }
+    }
+  }
+
   webhook_endpoint {
     max_events_per_batch           = 1
     active_directory_app_id_or_uri = each.value.active_directory_app_id_or_uri
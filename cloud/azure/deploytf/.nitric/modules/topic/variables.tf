@@ -23,6 +23,19 @@ variable "listeners" {
     active_directory_app_id_or_uri = string
     active_directory_tenant_id     = string
     event_token                    = string
+    max_delivery_attempts          = number
+    dead_letter_storage_account_id = optional(string)
+    dead_letter_container          = optional(string)
+    string_in = optional(list(object({
+      key    = string
+      values = list(string)
+    })), [])
+    string_begins_with = optional(list(object({
+      key    = string
+      values = list(string)
+    })), [])
+    is_not_null          = optional(list(string), [])
+    is_null_or_undefined = optional(list(string), [])
   }))
 }
 
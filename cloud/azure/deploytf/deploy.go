@@ -30,6 +30,7 @@ import (
 	"github.com/nitrictech/nitric/cloud/azure/deploytf/generated/keyvalue"
 	"github.com/nitrictech/nitric/cloud/azure/deploytf/generated/queue"
 	"github.com/nitrictech/nitric/cloud/azure/deploytf/generated/roles"
+	"github.com/nitrictech/nitric/cloud/azure/deploytf/generated/schedule"
 	"github.com/nitrictech/nitric/cloud/azure/deploytf/generated/service"
 	"github.com/nitrictech/nitric/cloud/azure/deploytf/generated/sql"
 	"github.com/nitrictech/nitric/cloud/azure/deploytf/generated/stack"
@@ -59,6 +60,7 @@ type NitricAzureTerraformProvider struct {
 	Queues    map[string]queue.Queue
 	KvStores  map[string]keyvalue.Keyvalue
 	Topics    map[string]topic.Topic
+	Schedules map[string]schedule.Schedule
 	Databases map[string]sql.Sql
 	Websites  map[string]website.Website
 
@@ -227,6 +229,7 @@ func NewNitricAzureProvider() *NitricAzureTerraformProvider {
 		Proxies:   make(map[string]http_proxy.HttpProxy),
 		Queues:    make(map[string]queue.Queue),
 		Topics:    make(map[string]topic.Topic),
+		Schedules: make(map[string]schedule.Schedule),
 		KvStores:  make(map[string]keyvalue.Keyvalue),
 		Databases: make(map[string]sql.Sql),
 		Websites:  make(map[string]website.Website),
@@ -53,6 +53,8 @@ func (p *NitricAzureTerraformProvider) actionsToAzureRoleDefinitions(actions []r
 			azureRoles[resourcespb.Action_SecretAccess.String()] = p.Roles.SecretAccessOutput()
 		case resourcespb.Action_SecretPut:
 			azureRoles[resourcespb.Action_SecretPut.String()] = p.Roles.SecretPutOutput()
+		case resourcespb.Action_ScheduleManage:
+			azureRoles[resourcespb.Action_ScheduleManage.String()] = cdktf.Token_AsString(p.Roles.InterpolationForOutput(jsii.String("schedule_manage")), nil)
 		}
 	}
 
@@ -144,6 +146,20 @@ func (p *NitricAzureTerraformProvider) scopeFromResource(resource *deploymentspb
 			),
 			Dependency: p.Stack,
 		}, nil
+	case resourcespb.ResourceType_Schedule:
+		sched, ok := p.Schedules[resource.Id.Name]
+		if !ok {
+			return nil, fmt.Errorf("schedule %s not found", resource.Id.Name)
+		}
+
+		return &ResourceScope{
+			Scope: jsii.Sprintf(
+				"%s/daprComponents/%s",
+				*p.Stack.ContainerAppEnvironmentIdOutput(),
+				resource.Id.Name,
+			),
+			Dependency: sched,
+		}, nil
 	default:
 		return nil, fmt.Errorf("unknown resource type %s", resource.Id.Type)
 	}
@@ -37,7 +37,7 @@ func (a *NitricAzureTerraformProvider) Schedule(stack cdktf.TerraformStack, name
 		return err
 	}
 
-	schedule.NewSchedule(stack, jsii.String(name), &schedule.ScheduleConfig{
+	a.Schedules[name] = schedule.NewSchedule(stack, jsii.String(name), &schedule.ScheduleConfig{
 		Name:                      jsii.String(name),
 		ContainerAppEnvironmentId: a.Stack.ContainerAppEnvironmentIdOutput(),
 		TargetEventToken:          targetService.EventTokenOutput(),
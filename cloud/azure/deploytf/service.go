@@ -52,6 +52,7 @@ func (a *NitricAzureTerraformProvider) Service(stack cdktf.TerraformStack, name
 		"AZURE_STORAGE_ACCOUNT_QUEUE_ENDPOINT": a.Stack.StorageAccountQueueEndpointOutput(),
 		"KVAULT_NAME":                          a.Stack.KeyvaultNameOutput(),
 		"NITRIC_HTTP_PROXY_PORT":               jsii.String(fmt.Sprint(3000)),
+		"AZURE_CONTAINER_ENVIRONMENT_ID":       a.Stack.ContainerAppEnvironmentIdOutput(),
 	}
 
 	for k, v := range config.GetEnv() {
@@ -15,20 +15,98 @@
 package deploytf
 
 import (
+	"fmt"
 	"strings"
 
 	"github.com/aws/jsii-runtime-go"
 	"github.com/hashicorp/terraform-cdk-go/cdktf"
 	"github.com/nitrictech/nitric/cloud/azure/deploytf/generated/topic"
 	"github.com/nitrictech/nitric/cloud/common/deploy/resources"
+	"github.com/nitrictech/nitric/core/pkg/logger"
 	deploymentspb "github.com/nitrictech/nitric/core/pkg/proto/deployments/v1"
+	topicspb "github.com/nitrictech/nitric/core/pkg/proto/topics/v1"
+	"github.com/nitrictech/nitric/core/pkg/workers/topics"
+)
+
+const (
+	// Event Grid limits for subscription retry policies
+	defaultMaxDeliveryAttempts = 10
+	maxDeliveryAttempts        = 30
 )
 
 type WebhookSubscriber struct {
-	ClientId            *string `json:"client_id"`
-	ClientSecret        *string `json:"client_secret"`
-	TenantId            *string `json:"tenant_id"`
-	EventGridSubscriber `json:",inline"`
+	ClientId                   *string                `json:"client_id"`
+	ClientSecret               *string                `json:"client_secret"`
+	TenantId                   *string                `json:"tenant_id"`
+	MaxDeliveryAttempts        int                    `json:"max_delivery_attempts"`
+	DeadLetterStorageAccountId *string                `json:"dead_letter_storage_account_id"`
+	DeadLetterContainer        *string                `json:"dead_letter_container"`
+	StringIn                   []StringAdvancedFilter `json:"string_in"`
+	StringBeginsWith           []StringAdvancedFilter `json:"string_begins_with"`
+	IsNotNull                  []string               `json:"is_not_null"`
+	IsNullOrUndefined          []string               `json:"is_null_or_undefined"`
+	EventGridSubscriber        `json:",inline"`
+}
+
+type StringAdvancedFilter struct {
+	Key    string   `json:"key"`
+	Values []string `json:"values"`
+}
+
+// withAdvancedFilters converts a subscription filter to Event Grid advanced filters on the event data attributes.
+// Event Grid compares strings case-insensitively so the advanced filters only narrow deliveries,
+// the runtime applies the case-sensitive filter of each handler.
+func withAdvancedFilters(subscriber *WebhookSubscriber, filter *topicspb.SubscriptionFilter) error {
+	if err := topics.ValidateFilter(filter); err != nil {
+		return err
+	}
+
+	for _, attributeFilter := range filter.GetAttributes() {
+		if strings.Contains(attributeFilter.GetName(), ".") {
+			return fmt.Errorf("Event Grid can't filter on attribute %s, attribute names can't contain '.'", attributeFilter.GetName())
+		}
+
+		key := "data.attributes." + attributeFilter.GetName()
+
+		switch condition := attributeFilter.GetCondition().(type) {
+		case *topicspb.AttributeFilter_AnyOf:
+			subscriber.StringIn = append(subscriber.StringIn, StringAdvancedFilter{Key: key, Values: condition.AnyOf.GetValues()})
+		case *topicspb.AttributeFilter_NoneOf:
+			// StringNotIn would also drop values differing only in case, the excluded values are left to the runtime
+			subscriber.IsNotNull = append(subscriber.IsNotNull, key)
+		case *topicspb.AttributeFilter_Prefix:
+			subscriber.StringBeginsWith = append(subscriber.StringBeginsWith, StringAdvancedFilter{Key: key, Values: []string{condition.Prefix}})
+		case *topicspb.AttributeFilter_Exists:
+			if condition.Exists {
+				subscriber.IsNotNull = append(subscriber.IsNotNull, key)
+			} else {
+				subscriber.IsNullOrUndefined = append(subscriber.IsNullOrUndefined, key)
+			}
+		}
+	}
+
+	return nil
+}
+
+// withRetryPolicy sets the Event Grid delivery attempts of a subscriber,
+// Event Grid retries with its own backoff schedule so backoff settings are ignored.
+func withRetryPolicy(subscriber *WebhookSubscriber, subName string, policy *deploymentspb.SubscriptionRetryPolicy) error {
+	maxAttempts := int32(defaultMaxDeliveryAttempts)
+	if policy.GetMaxAttempts() > 0 {
+		maxAttempts = policy.GetMaxAttempts()
+	}
+
+	if maxAttempts > maxDeliveryAttempts {
+		return fmt.Errorf("max attempts for subscription %s exceeds the Event Grid limit of %d", subName, maxDeliveryAttempts)
+	}
+
+	if policy.GetMinBackoffSeconds() > 0 || policy.GetMaxBackoffSeconds() > 0 {
+		logger.Warnf("Event Grid doesn't support configurable backoff, the backoff for subscription %s will be ignored", subName)
+	}
+
+	subscriber.MaxDeliveryAttempts = int(maxAttempts)
+
+	return nil
 }
 
 func (a *NitricAzureTerraformProvider) Topic(stack cdktf.TerraformStack, name string, config *deploymentspb.Topic) error {
@@ -39,8 +117,9 @@ func (a *NitricAzureTerraformProvider) Topic(stack cdktf.TerraformStack, name st
 		svc := a.Services[v.GetService()]
 
 		normalizedServiceName := strings.Replace(v.GetService(), "_", "-", -1)
+		subName := name + "-" + v.GetService()
 
-		listeners[normalizedServiceName] = WebhookSubscriber{
+		listener := WebhookSubscriber{
 			ClientId:     svc.ClientIdOutput(),
 			ClientSecret: svc.ClientSecretOutput(),
 			TenantId:     svc.TenantIdOutput(),
@@ -52,6 +131,33 @@ func (a *NitricAzureTerraformProvider) Topic(stack cdktf.TerraformStack, name st
 			},
 		}
 
+		if err := withRetryPolicy(&listener, subName, v.GetRetryPolicy()); err != nil {
+			return err
+		}
+
+		if len(v.GetFilter().GetAttributes()) > 0 {
+			if err := withAdvancedFilters(&listener, v.GetFilter()); err != nil {
+				return fmt.Errorf("invalid filter for subscription %s: %w", subName, err)
+			}
+		}
+
+		switch deadLetter := v.GetDeadLetter().GetDestination().(type) {
+		case nil:
+		case *deploymentspb.DeadLetterDestination_Bucket:
+			bucket, ok := a.Buckets[deadLetter.Bucket]
+			if !ok {
+				return fmt.Errorf("Unable to find dead-letter bucket: %s", deadLetter.Bucket)
+			}
+
+			listener.DeadLetterStorageAccountId = a.Stack.StorageAccountIdOutput()
+			listener.DeadLetterContainer = jsii.String(deadLetter.Bucket)
+			allDependants = append(allDependants, bucket)
+		default:
+			return fmt.Errorf("Event Grid subscriptions can only dead-letter to buckets, subscription: %s", subName)
+		}
+
+		listeners[normalizedServiceName] = listener
+
 		allDependants = append(allDependants, svc)
 	}
 
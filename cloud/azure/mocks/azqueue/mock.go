@@ -243,3 +243,18 @@ func (mr *MockAzqueueMessageIdUrlIfaceMockRecorder) Delete(arg0, arg1 interface{
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockAzqueueMessageIdUrlIface)(nil).Delete), arg0, arg1)
 }
+
+// UpdateVisibility mocks base method.
+func (m *MockAzqueueMessageIdUrlIface) UpdateVisibility(arg0 context.Context, arg1 azqueue.PopReceipt, arg2 time.Duration) (azqueue.PopReceipt, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateVisibility", arg0, arg1, arg2)
+	ret0, _ := ret[0].(azqueue.PopReceipt)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateVisibility indicates an expected call of UpdateVisibility.
+func (mr *MockAzqueueMessageIdUrlIfaceMockRecorder) UpdateVisibility(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateVisibility", reflect.TypeOf((*MockAzqueueMessageIdUrlIface)(nil).UpdateVisibility), arg0, arg1, arg2)
+}
@@ -24,6 +24,12 @@ var (
 
 var KVAULT_NAME = env.GetEnv("KVAULT_NAME", "")
 
+// AZURE_CONTAINER_ENVIRONMENT_ID - The resource ID of the container apps environment hosting the stack's dapr components
+var AZURE_CONTAINER_ENVIRONMENT_ID = env.GetEnv("AZURE_CONTAINER_ENVIRONMENT_ID", "")
+
+// DAPR_HTTP_PORT - The port of the dapr sidecar's HTTP API
+var DAPR_HTTP_PORT = env.GetEnv("DAPR_HTTP_PORT", "3500")
+
 var AZURE_STORAGE_ACCOUNT_NAME = env.GetEnv("AZURE_STORAGE_ACCOUNT_NAME", "")
 
 var (
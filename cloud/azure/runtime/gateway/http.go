@@ -20,21 +20,26 @@ import (
 	"errors"
 	"fmt"
 	"os"
+	"strconv"
 	"strings"
 
 	"github.com/Azure/azure-sdk-for-go/profiles/latest/eventgrid/eventgrid"
 	"github.com/fasthttp/router"
 	"github.com/mitchellh/mapstructure"
+	"github.com/samber/lo"
 	"github.com/valyala/fasthttp"
 	"google.golang.org/protobuf/proto"
 
 	"github.com/nitrictech/nitric/cloud/azure/runtime/resource"
+	"github.com/nitrictech/nitric/cloud/azure/runtime/topic"
 	base_http "github.com/nitrictech/nitric/cloud/common/runtime/gateway"
 	"github.com/nitrictech/nitric/core/pkg/gateway"
 	"github.com/nitrictech/nitric/core/pkg/logger"
 	schedulespb "github.com/nitrictech/nitric/core/pkg/proto/schedules/v1"
 	storagepb "github.com/nitrictech/nitric/core/pkg/proto/storage/v1"
 	topicspb "github.com/nitrictech/nitric/core/pkg/proto/topics/v1"
+	"github.com/nitrictech/nitric/core/pkg/tracing"
+	"github.com/nitrictech/nitric/core/pkg/workers/topics"
 )
 
 type azMiddleware struct {
@@ -51,28 +56,55 @@ func extractEvents(ctx *fasthttp.RequestCtx) ([]eventgrid.Event, error) {
 	return eventgridEvents, nil
 }
 
-func extractMessage(event eventgrid.Event) (*topicspb.TopicMessage, error) {
+// extractMessage returns the nitric message of an event and the attributes it was published with
+func extractMessage(event eventgrid.Event) (*topicspb.TopicMessage, map[string]string, error) {
 	var payloadBytes []byte
+	var attributes map[string]string
 	var err error
 	if stringData, ok := event.Data.(string); ok {
 		// byte payloads are automatically base64 encoded when publishing to event grid
 		payloadBytes, err = base64.StdEncoding.DecodeString(stringData)
 		if err != nil {
-			return nil, err
+			return nil, nil, err
 		}
 	} else if byteData, ok := event.Data.([]byte); ok {
 		payloadBytes = byteData
+	} else if objectData, ok := event.Data.(map[string]interface{}); ok {
+		// messages published with attributes are wrapped with their attributes
+		dataBytes, err := json.Marshal(objectData)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		var data topic.EventData
+		if err := json.Unmarshal(dataBytes, &data); err != nil {
+			return nil, nil, err
+		}
+
+		payloadBytes = data.Payload
+		attributes = data.Attributes
 	} else {
-		return nil, fmt.Errorf("invalid event data type: %T", event.Data)
+		return nil, nil, fmt.Errorf("invalid event data type: %T", event.Data)
 	}
 
 	var message topicspb.TopicMessage
 
 	if err = proto.Unmarshal(payloadBytes, &message); err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
-	return &message, nil
+	return &message, attributes, nil
+}
+
+// deliveryAttempt returns the delivery attempt of the events in the request, or 0 if it isn't provided.
+// Event Grid counts previous attempts in the aeg-delivery-count header, starting at 0 for the first delivery.
+func deliveryAttempt(ctx *fasthttp.RequestCtx) int32 {
+	deliveryCount, err := strconv.Atoi(string(ctx.Request.Header.Peek("aeg-delivery-count")))
+	if err != nil {
+		return 0
+	}
+
+	return int32(deliveryCount) + 1
 }
 
 func (a *azMiddleware) handleSubscriptionValidation(ctx *fasthttp.RequestCtx, events []eventgrid.Event) {
@@ -112,7 +144,7 @@ func (a *azMiddleware) handleSubscription(opts *gateway.GatewayStartOpts) fastht
 				return
 			}
 
-			message, err := extractMessage(event)
+			message, attributes, err := extractMessage(event)
 			if err != nil {
 				fmt.Println("error extracting message", err)
 				ctx.Error(err.Error(), 500)
@@ -124,13 +156,18 @@ func (a *azMiddleware) handleSubscription(opts *gateway.GatewayStartOpts) fastht
 			evt := &topicspb.ServerMessage{
 				Content: &topicspb.ServerMessage_MessageRequest{
 					MessageRequest: &topicspb.MessageRequest{
-						TopicName: topicName,
-						Message:   message,
+						TopicName:       topicName,
+						Message:         message,
+						DeliveryAttempt: deliveryAttempt(ctx),
+						MessageId:       lo.FromPtr(event.ID),
 					},
 				},
 			}
 
-			resp, err := opts.TopicsListenerPlugin.HandleRequest(evt)
+			topics.SetMessageAttributes(evt.GetMessageRequest(), attributes, tracing.Fields()...)
+
+			// continue the trace of the publisher
+			resp, err := opts.TopicsListenerPlugin.HandleRequest(tracing.Extract(ctx, attributes), evt)
 			if err != nil {
 				logger.Errorf("error handling event from topic %s: %s", topicName, err.Error())
 				ctx.Error("failed handling event, error returned from subscriber function", 500)
@@ -164,7 +201,7 @@ func (a *azMiddleware) handleSchedule(opts *gateway.GatewayStartOpts) fasthttp.R
 			},
 		}
 
-		_, err := opts.SchedulesPlugin.HandleRequest(evt)
+		_, err := opts.SchedulesPlugin.HandleRequest(ctx, evt)
 		if err != nil {
 			ctx.Error(fmt.Sprintf("failed handling schedule %s", scheduleName), 500)
 		}
@@ -249,7 +286,7 @@ func (a *azMiddleware) handleBucketNotification(opts *gateway.GatewayStartOpts)
 				},
 			}
 
-			resp, err := opts.StorageListenerPlugin.HandleRequest(evt)
+			resp, err := opts.StorageListenerPlugin.HandleRequest(ctx, evt)
 			if err != nil {
 				logger.Errorf("error handling event: %s", err)
 				ctx.Error("error handling event", 500)
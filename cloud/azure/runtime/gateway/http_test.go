@@ -33,6 +33,7 @@ import (
 
 	mock_provider "github.com/nitrictech/nitric/cloud/azure/mocks/provider"
 	"github.com/nitrictech/nitric/cloud/azure/runtime/resource"
+	"github.com/nitrictech/nitric/cloud/azure/runtime/topic"
 	mock_apis "github.com/nitrictech/nitric/core/mocks/workers/apis"
 	mock_http "github.com/nitrictech/nitric/core/mocks/workers/http"
 	mock_topics "github.com/nitrictech/nitric/core/mocks/workers/topics"
@@ -107,21 +108,15 @@ var _ = Describe("Http", func() {
 								"X-Forwarded-For": {Value: []string{"127.0.0.1:9001"}},
 								"Accept-Encoding": {Value: []string{"gzip"}},
 							},
-							Body: payload,
 						},
 					},
 				}
 
-				mockManager.EXPECT().HandleRequest("test", test.ProtoEq(mockRequest)).Return(&apispb.ClientMessage{
-					Id: "TODO",
-					Content: &apispb.ClientMessage_HttpResponse{
-						HttpResponse: &apispb.HttpResponse{
-							Status:  200,
-							Body:    []byte("Test"),
-							Headers: map[string]*apispb.HeaderValue{},
-						},
-					},
-				}, nil)
+				mockManager.EXPECT().HandleStreamingRequest(gomock.Any(), "test", test.ProtoEq(mockRequest), gomock.Any()).Return(&apispb.HttpResponse{
+					Status:  200,
+					Body:    []byte("Test"),
+					Headers: map[string]*apispb.HeaderValue{},
+				}, io.NopCloser(bytes.NewReader([]byte("Test"))), nil)
 
 				By("Generating a HTTP trigger")
 				request, _ := http.NewRequest("POST", fmt.Sprintf("%s/x-nitric-api/test/test/", gatewayUrl), bytes.NewReader(payload[:]))
@@ -206,14 +201,16 @@ var _ = Describe("Http", func() {
 				mockRequest := &topicspb.ServerMessage{
 					Content: &topicspb.ServerMessage_MessageRequest{
 						MessageRequest: &topicspb.MessageRequest{
-							TopicName: testTopic,
-							Message:   messagePayload,
+							TopicName:       testTopic,
+							Message:         messagePayload,
+							DeliveryAttempt: 2,
+							MessageId:       "1234",
 						},
 					},
 				}
 
 				By("Handling exactly 1 request")
-				mockManager.EXPECT().HandleRequest(test.ProtoEq(mockRequest)).Return(&topicspb.ClientMessage{
+				mockManager.EXPECT().HandleRequest(gomock.Any(), test.ProtoEq(mockRequest)).Return(&topicspb.ClientMessage{
 					Content: &topicspb.ClientMessage_MessageResponse{
 						MessageResponse: &topicspb.MessageResponse{
 							Success: true,
@@ -230,6 +227,66 @@ var _ = Describe("Http", func() {
 					},
 				}
 
+				requestBody, err := json.Marshal(evt)
+				Expect(err).To(BeNil())
+				request, err := http.NewRequest("POST", fmt.Sprintf("%s/%s/x-nitric-topic/test", gatewayUrl, testEvtToken), bytes.NewReader(requestBody))
+				Expect(err).To(BeNil())
+				request.Header.Add("aeg-event-type", "Notification")
+				request.Header.Add("aeg-delivery-count", "1")
+				_, _ = http.DefaultClient.Do(request)
+			})
+
+			It("Should pass the attributes of the notification to subscribers", func() {
+				structPayload, _ := structpb.NewStruct(map[string]interface{}{
+					"testing": "test",
+				})
+
+				messagePayload := &topicspb.TopicMessage{
+					Content: &topicspb.TopicMessage_StructPayload{
+						StructPayload: structPayload,
+					},
+				}
+
+				messagePayloadBytes, _ := proto.Marshal(messagePayload)
+
+				testTopic := "test"
+
+				mockRequest := &topicspb.ServerMessage{
+					Content: &topicspb.ServerMessage_MessageRequest{
+						MessageRequest: &topicspb.MessageRequest{
+							TopicName:   testTopic,
+							Message:     messagePayload,
+							MessageId:   "5678",
+							Attributes:  map[string]string{"kind": "created"},
+							OrderingKey: "order-1",
+						},
+					},
+				}
+
+				By("Handling exactly 1 request")
+				mockManager.EXPECT().HandleRequest(gomock.Any(), test.ProtoEq(mockRequest)).Return(&topicspb.ClientMessage{
+					Content: &topicspb.ClientMessage_MessageResponse{
+						MessageResponse: &topicspb.MessageResponse{
+							Success: true,
+						},
+					},
+				}, nil)
+
+				testID := "5678"
+				evt := []eventgrid.Event{
+					{
+						ID:    &testID,
+						Topic: &testTopic,
+						Data: topic.EventData{
+							Payload: messagePayloadBytes,
+							Attributes: map[string]string{
+								"kind":                  "created",
+								"x-nitric-ordering-key": "order-1",
+							},
+						},
+					},
+				}
+
 				requestBody, err := json.Marshal(evt)
 				Expect(err).To(BeNil())
 				request, err := http.NewRequest("POST", fmt.Sprintf("%s/%s/x-nitric-topic/test", gatewayUrl, testEvtToken), bytes.NewReader(requestBody))
@@ -23,9 +23,11 @@ import (
 	"time"
 
 	"github.com/Azure/azure-sdk-for-go/sdk/azcore"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/policy"
 	"github.com/Azure/azure-sdk-for-go/sdk/azidentity"
 	"github.com/Azure/azure-sdk-for-go/sdk/data/aztables"
 	"github.com/nitrictech/nitric/cloud/azure/runtime/env"
+	azureutils "github.com/nitrictech/nitric/cloud/azure/runtime/utils"
 	document "github.com/nitrictech/nitric/core/pkg/decorators/keyvalue"
 	grpc_errors "github.com/nitrictech/nitric/core/pkg/grpc/errors"
 	kvstorepb "github.com/nitrictech/nitric/core/pkg/proto/kvstore/v1"
@@ -343,7 +345,9 @@ func newStorageTablesClientFactory(creds *azidentity.DefaultAzureCredential, sto
 		normalizedTableName := strings.Replace(tableName, "-", "", -1)
 
 		serviceURL := fmt.Sprintf("https://%s.table.core.windows.net/%s", storageAccountName, normalizedTableName)
-		return aztables.NewClient(serviceURL, creds, nil)
+		return aztables.NewClient(serviceURL, creds, &aztables.ClientOptions{
+			ClientOptions: policy.ClientOptions{Transport: azureutils.TracedHttpClient()},
+		})
 	}
 }
 
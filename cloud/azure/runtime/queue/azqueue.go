@@ -28,6 +28,7 @@ import (
 	"github.com/Azure/go-autorest/autorest/azure"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/durationpb"
 
 	"github.com/nitrictech/nitric/cloud/azure/runtime/env"
 	azqueueserviceiface "github.com/nitrictech/nitric/cloud/azure/runtime/queue/iface"
@@ -41,6 +42,19 @@ import (
 // Set to 30 seconds,
 const defaultVisibilityTimeout = 30 * time.Second
 
+// The maximum visibility timeout of an Azure Storage Queues message, 7 days
+const maxVisibilityTimeout = 7 * 24 * time.Hour
+
+// visibilityTimeout returns the lease duration capped at the maximum visibility timeout,
+// or the default visibility timeout if no lease duration is provided
+func visibilityTimeout(leaseDuration *durationpb.Duration) time.Duration {
+	if leaseDuration == nil {
+		return defaultVisibilityTimeout
+	}
+
+	return min(leaseDuration.AsDuration(), maxVisibilityTimeout)
+}
+
 type AzqueueQueueService struct {
 	client azqueueserviceiface.AzqueueServiceUrlIface
 }
@@ -119,7 +133,7 @@ type AzureQueueItemLease struct {
 	// The ID of the queue item
 	// note: this is an id generated by Azure, it's not the user provided unique id.
 	ID string
-	// lease id, a new popReceipt is generated each time an item is dequeued.
+	// lease id, a new popReceipt is generated each time an item is dequeued or updated.
 	PopReceipt string
 }
 
@@ -145,7 +159,7 @@ func (s *AzqueueQueueService) Dequeue(ctx context.Context, req *queuespb.QueueDe
 
 	messages := s.getMessagesUrl(req.QueueName)
 
-	dequeueResp, err := messages.Dequeue(ctx, req.Depth, defaultVisibilityTimeout)
+	dequeueResp, err := messages.Dequeue(ctx, req.Depth, visibilityTimeout(req.LeaseDuration))
 	if err != nil {
 		return nil, newErr(
 			codes.Internal,
@@ -239,6 +253,70 @@ func (s *AzqueueQueueService) Complete(ctx context.Context, req *queuespb.QueueC
 	return &queuespb.QueueCompleteResponse{}, nil
 }
 
+// ExtendLease - Extends the lease of a previously popped queue item by updating its visibility timeout
+func (s *AzqueueQueueService) ExtendLease(ctx context.Context, req *queuespb.QueueExtendLeaseRequest) (*queuespb.QueueExtendLeaseResponse, error) {
+	newErr := grpc_errors.ErrorsWithScope("AzqueueQueueService.ExtendLease")
+
+	lease, err := s.updateVisibility(ctx, newErr, req.QueueName, req.LeaseId, visibilityTimeout(req.LeaseDuration))
+	if err != nil {
+		return nil, err
+	}
+
+	// Updating the item issues a new pop receipt, so the lease id changes
+	leaseID, err := lease.String()
+	if err != nil {
+		return nil, newErr(
+			codes.Internal,
+			"failed to construct queue item lease id",
+			err,
+		)
+	}
+
+	return &queuespb.QueueExtendLeaseResponse{
+		LeaseId: leaseID,
+	}, nil
+}
+
+// Release - Releases a previously popped queue item, making it immediately visible to other consumers
+func (s *AzqueueQueueService) Release(ctx context.Context, req *queuespb.QueueReleaseRequest) (*queuespb.QueueReleaseResponse, error) {
+	newErr := grpc_errors.ErrorsWithScope("AzqueueQueueService.Release")
+
+	_, err := s.updateVisibility(ctx, newErr, req.QueueName, req.LeaseId, 0)
+	if err != nil {
+		return nil, err
+	}
+
+	return &queuespb.QueueReleaseResponse{}, nil
+}
+
+// updateVisibility - Updates the visibility timeout of a previously popped queue item, returning its new lease
+func (s *AzqueueQueueService) updateVisibility(ctx context.Context, newErr grpc_errors.ScopedErrorFactory, queueName string, leaseId string, visibilityTimeout time.Duration) (*AzureQueueItemLease, error) {
+	lease, err := leaseFromString(leaseId)
+	if err != nil {
+		return nil, newErr(
+			codes.InvalidArgument,
+			"failed to unmarshal lease id value",
+			err,
+		)
+	}
+
+	// Client for the specific message referenced by the lease
+	task := s.getMessageIdUrl(queueName, azqueue.MessageID(lease.ID))
+	popReceipt, err := task.UpdateVisibility(ctx, azqueue.PopReceipt(lease.PopReceipt), visibilityTimeout)
+	if err != nil {
+		return nil, newErr(
+			codes.Internal,
+			"failed to update task visibility",
+			err,
+		)
+	}
+
+	return &AzureQueueItemLease{
+		ID:         lease.ID,
+		PopReceipt: popReceipt.String(),
+	}, nil
+}
+
 const expiryBuffer = 2 * time.Minute
 
 func tokenRefresherFromSpt(spt *adal.ServicePrincipalToken) azqueue.TokenRefresher {
@@ -276,11 +354,11 @@ func New() (*AzqueueQueueService, error) {
 		return nil, err
 	}
 
-	pipeline := azqueue.NewPipeline(cTkn, azqueue.PipelineOptions{})
+	pipeline := azqueue.NewPipeline(cTkn, azqueue.PipelineOptions{HTTPSender: azureutils.TracedPipelineSender()})
 	client := azqueue.NewServiceURL(*accountURL, pipeline)
 
 	return &AzqueueQueueService{
-		client: azqueueserviceiface.AdaptServiceUrl(client),
+		client: azqueueserviceiface.AdaptServiceUrl(client, pipeline),
 	}, nil
 }
 
@@ -23,6 +23,7 @@ import (
 	azqueue "github.com/Azure/azure-storage-queue-go/azqueue"
 	"github.com/golang/mock/gomock"
 	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/durationpb"
 	"google.golang.org/protobuf/types/known/structpb"
 
 	. "github.com/onsi/ginkgo"
@@ -315,4 +316,133 @@ var _ = Describe("Azqueue", func() {
 			})
 		})
 	})
+
+	Context("ExtendLease", func() {
+		When("Azure returns a successfully response", func() {
+			crtl := gomock.NewController(GinkgoT())
+			mockAzqueue := mock_azqueue.NewMockAzqueueServiceUrlIface(crtl)
+			mockQueue := mock_azqueue.NewMockAzqueueQueueUrlIface(crtl)
+			mockMessages := mock_azqueue.NewMockAzqueueMessageUrlIface(crtl)
+			mockMessageId := mock_azqueue.NewMockAzqueueMessageIdUrlIface(crtl)
+
+			queuePlugin := &AzqueueQueueService{
+				client: mockAzqueue,
+			}
+
+			It("should update the visibility timeout and return the new lease id", func() {
+				By("Retrieving the Queue URL for the requested queue")
+				mockAzqueue.EXPECT().NewQueueURL("test-queue").Times(1).Return(mockQueue)
+
+				By("Retrieving the Message URL of the requested queue")
+				mockQueue.EXPECT().NewMessageURL().Times(1).Return(mockMessages)
+
+				lease := AzureQueueItemLease{
+					ID:         "testid",
+					PopReceipt: "testreceipt",
+				}
+				leaseStr, _ := lease.String()
+
+				By("Updating the visibility timeout of the task")
+				mockMessages.EXPECT().NewMessageIDURL(azqueue.MessageID("testid")).Times(1).Return(mockMessageId)
+				mockMessageId.EXPECT().UpdateVisibility(gomock.Any(), azqueue.PopReceipt("testreceipt"), 5*time.Minute).Times(1).Return(azqueue.PopReceipt("newreceipt"), nil)
+
+				resp, err := queuePlugin.ExtendLease(context.TODO(), &queuepb.QueueExtendLeaseRequest{
+					QueueName:     "test-queue",
+					LeaseId:       leaseStr,
+					LeaseDuration: durationpb.New(5 * time.Minute),
+				})
+
+				By("Not returning an error")
+				Expect(err).ToNot(HaveOccurred())
+
+				By("Returning a lease id with the new pop receipt")
+				newLease, err := leaseFromString(resp.LeaseId)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(newLease.ID).To(Equal("testid"))
+				Expect(newLease.PopReceipt).To(Equal("newreceipt"))
+
+				By("Not including the task contents in the lease id")
+				Expect(resp.LeaseId).ToNot(ContainSubstring(testB64Payload))
+
+				crtl.Finish()
+			})
+		})
+
+		When("The lease was issued with the task contents", func() {
+			crtl := gomock.NewController(GinkgoT())
+			mockAzqueue := mock_azqueue.NewMockAzqueueServiceUrlIface(crtl)
+			mockQueue := mock_azqueue.NewMockAzqueueQueueUrlIface(crtl)
+			mockMessages := mock_azqueue.NewMockAzqueueMessageUrlIface(crtl)
+			mockMessageId := mock_azqueue.NewMockAzqueueMessageIdUrlIface(crtl)
+
+			queuePlugin := &AzqueueQueueService{
+				client: mockAzqueue,
+			}
+
+			It("should still update the visibility timeout", func() {
+				mockAzqueue.EXPECT().NewQueueURL("test-queue").Times(1).Return(mockQueue)
+				mockQueue.EXPECT().NewMessageURL().Times(1).Return(mockMessages)
+
+				By("Updating the visibility timeout of the task")
+				mockMessages.EXPECT().NewMessageIDURL(azqueue.MessageID("testid")).Times(1).Return(mockMessageId)
+				mockMessageId.EXPECT().UpdateVisibility(gomock.Any(), azqueue.PopReceipt("testreceipt"), 5*time.Minute).Times(1).Return(azqueue.PopReceipt("newreceipt"), nil)
+
+				resp, err := queuePlugin.ExtendLease(context.TODO(), &queuepb.QueueExtendLeaseRequest{
+					QueueName:     "test-queue",
+					LeaseId:       fmt.Sprintf(`{"ID":"testid","PopReceipt":"testreceipt","Text":"%s"}`, testB64Payload),
+					LeaseDuration: durationpb.New(5 * time.Minute),
+				})
+
+				By("Not returning an error")
+				Expect(err).ToNot(HaveOccurred())
+
+				By("Returning a lease id without the task contents")
+				Expect(resp.LeaseId).To(Equal(`{"ID":"testid","PopReceipt":"newreceipt"}`))
+
+				crtl.Finish()
+			})
+		})
+	})
+
+	Context("Release", func() {
+		When("Azure returns a successfully response", func() {
+			crtl := gomock.NewController(GinkgoT())
+			mockAzqueue := mock_azqueue.NewMockAzqueueServiceUrlIface(crtl)
+			mockQueue := mock_azqueue.NewMockAzqueueQueueUrlIface(crtl)
+			mockMessages := mock_azqueue.NewMockAzqueueMessageUrlIface(crtl)
+			mockMessageId := mock_azqueue.NewMockAzqueueMessageIdUrlIface(crtl)
+
+			queuePlugin := &AzqueueQueueService{
+				client: mockAzqueue,
+			}
+
+			It("should make the task visible immediately", func() {
+				By("Retrieving the Queue URL for the requested queue")
+				mockAzqueue.EXPECT().NewQueueURL("test-queue").Times(1).Return(mockQueue)
+
+				By("Retrieving the Message URL of the requested queue")
+				mockQueue.EXPECT().NewMessageURL().Times(1).Return(mockMessages)
+
+				lease := AzureQueueItemLease{
+					ID:         "testid",
+					PopReceipt: "testreceipt",
+				}
+				leaseStr, _ := lease.String()
+
+				By("Updating the task with a zero visibility timeout")
+				mockMessages.EXPECT().NewMessageIDURL(azqueue.MessageID("testid")).Times(1).Return(mockMessageId)
+				mockMessageId.EXPECT().UpdateVisibility(gomock.Any(), azqueue.PopReceipt("testreceipt"), time.Duration(0)).Times(1).Return(azqueue.PopReceipt("newreceipt"), nil)
+
+				_, err := queuePlugin.Release(context.TODO(), &queuepb.QueueReleaseRequest{
+					QueueName: "test-queue",
+					LeaseId:   leaseStr,
+				})
+
+				By("Not returning an error")
+				Expect(err).ToNot(HaveOccurred())
+
+				crtl.Finish()
+			})
+		})
+	})
 })
@@ -16,25 +16,30 @@ package iface
 
 import (
 	"context"
+	"fmt"
+	"net/http"
+	"strconv"
 	"time"
 
+	"github.com/Azure/azure-pipeline-go/pipeline"
 	"github.com/Azure/azure-storage-queue-go/azqueue"
 )
 
-func AdaptServiceUrl(c azqueue.ServiceURL) AzqueueServiceUrlIface {
-	return serviceUrl{c}
+// AdaptServiceUrl - adapts a service url, p must be the pipeline the url was created with
+func AdaptServiceUrl(c azqueue.ServiceURL, p pipeline.Pipeline) AzqueueServiceUrlIface {
+	return serviceUrl{c, p}
 }
 
-func AdaptQueueUrl(c azqueue.QueueURL) AzqueueQueueUrlIface {
-	return queueUrl{c}
+func AdaptQueueUrl(c azqueue.QueueURL, p pipeline.Pipeline) AzqueueQueueUrlIface {
+	return queueUrl{c, p}
 }
 
-func AdaptMessageUrl(c azqueue.MessagesURL) AzqueueMessageUrlIface {
-	return messageUrl{c}
+func AdaptMessageUrl(c azqueue.MessagesURL, p pipeline.Pipeline) AzqueueMessageUrlIface {
+	return messageUrl{c, p}
 }
 
-func AdaptMessageIdUrl(c azqueue.MessageIDURL) AzqueueMessageIdUrlIface {
-	return messageIdUrl{c}
+func AdaptMessageIdUrl(c azqueue.MessageIDURL, p pipeline.Pipeline) AzqueueMessageIdUrlIface {
+	return messageIdUrl{c, p}
 }
 
 func AdaptDequeueMessagesResponse(c azqueue.DequeuedMessagesResponse) DequeueMessagesResponseIface {
@@ -42,21 +47,33 @@ func AdaptDequeueMessagesResponse(c azqueue.DequeuedMessagesResponse) DequeueMes
 }
 
 type (
-	serviceUrl              struct{ c azqueue.ServiceURL }
-	queueUrl                struct{ c azqueue.QueueURL }
-	messageUrl              struct{ c azqueue.MessagesURL }
-	messageIdUrl            struct{ c azqueue.MessageIDURL }
+	serviceUrl struct {
+		c azqueue.ServiceURL
+		p pipeline.Pipeline
+	}
+	queueUrl struct {
+		c azqueue.QueueURL
+		p pipeline.Pipeline
+	}
+	messageUrl struct {
+		c azqueue.MessagesURL
+		p pipeline.Pipeline
+	}
+	messageIdUrl struct {
+		c azqueue.MessageIDURL
+		p pipeline.Pipeline
+	}
 	dequeueMessagesResponse struct {
 		c azqueue.DequeuedMessagesResponse
 	}
 )
 
 func (c serviceUrl) NewQueueURL(queueName string) AzqueueQueueUrlIface {
-	return AdaptQueueUrl(c.c.NewQueueURL(queueName))
+	return AdaptQueueUrl(c.c.NewQueueURL(queueName), c.p)
 }
 
 func (c queueUrl) NewMessageURL() AzqueueMessageUrlIface {
-	return AdaptMessageUrl(c.c.NewMessagesURL())
+	return AdaptMessageUrl(c.c.NewMessagesURL(), c.p)
 }
 
 func (c messageUrl) Enqueue(ctx context.Context, messageText string, visibilityTimeout time.Duration, timeToLive time.Duration) (*azqueue.EnqueueMessageResponse, error) {
@@ -72,13 +89,42 @@ func (c messageUrl) Dequeue(ctx context.Context, maxMessages int32, visibilityTi
 }
 
 func (c messageUrl) NewMessageIDURL(messageId azqueue.MessageID) AzqueueMessageIdUrlIface {
-	return AdaptMessageIdUrl(c.c.NewMessageIDURL(messageId))
+	return AdaptMessageIdUrl(c.c.NewMessageIDURL(messageId), c.p)
 }
 
 func (c messageIdUrl) Delete(ctx context.Context, popReceipt azqueue.PopReceipt) (*azqueue.MessageIDDeleteResponse, error) {
 	return c.c.Delete(ctx, popReceipt)
 }
 
+// UpdateVisibility - MessageIDURL.Update always replaces the message contents, so the update is sent without a body
+// through the same pipeline, which the service treats as a visibility timeout only update
+func (c messageIdUrl) UpdateVisibility(ctx context.Context, popReceipt azqueue.PopReceipt, visibilityTimeout time.Duration) (azqueue.PopReceipt, error) {
+	u := c.c.URL()
+	query := u.Query()
+	query.Set("popreceipt", string(popReceipt))
+	query.Set("visibilitytimeout", strconv.Itoa(int(visibilityTimeout.Seconds())))
+	u.RawQuery = query.Encode()
+
+	req, err := pipeline.NewRequest(http.MethodPut, u, nil)
+	if err != nil {
+		return "", err
+	}
+
+	req.Header.Set("x-ms-version", azqueue.ServiceVersion)
+
+	resp, err := c.p.Do(ctx, nil, req)
+	if err != nil {
+		return "", err
+	}
+	defer resp.Response().Body.Close()
+
+	if resp.Response().StatusCode != http.StatusNoContent {
+		return "", fmt.Errorf("failed to update message visibility: %s", resp.Response().Status)
+	}
+
+	return azqueue.PopReceipt(resp.Response().Header.Get("x-ms-popreceipt")), nil
+}
+
 func (c dequeueMessagesResponse) NumMessages() int32 {
 	return c.c.NumMessages()
 }
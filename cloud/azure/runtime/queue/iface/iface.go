@@ -37,6 +37,8 @@ type AzqueueMessageUrlIface interface {
 
 type AzqueueMessageIdUrlIface interface {
 	Delete(ctx context.Context, popReceipt azqueue.PopReceipt) (*azqueue.MessageIDDeleteResponse, error)
+	// UpdateVisibility updates the visibility timeout of a message, leaving its contents unchanged, and returns its new pop receipt
+	UpdateVisibility(ctx context.Context, popReceipt azqueue.PopReceipt, visibilityTimeout time.Duration) (azqueue.PopReceipt, error)
 }
 
 type DequeueMessagesResponseIface interface {
@@ -25,6 +25,7 @@ import (
 	"github.com/Azure/go-autorest/autorest/adal"
 	"github.com/Azure/go-autorest/autorest/azure/auth"
 
+	azureutils "github.com/nitrictech/nitric/cloud/azure/runtime/utils"
 	"github.com/nitrictech/nitric/cloud/common/deploy/tags"
 )
 
@@ -197,9 +198,11 @@ func New() (*AzureResourceService, error) {
 
 	sClient := apimanagement.NewServiceClient(subId)
 	sClient.Authorizer = autorest.NewBearerAuthorizer(spt)
+	sClient.Sender = azureutils.TracedHttpClient()
 
 	rclient := resources.NewClient(subId)
 	rclient.Authorizer = autorest.NewBearerAuthorizer(spt)
+	rclient.Sender = azureutils.TracedHttpClient()
 	prov.rclient = rclient
 	prov.srvClient = sClient
 
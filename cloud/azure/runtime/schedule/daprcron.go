@@ -0,0 +1,287 @@
+// Copyright 2021 Nitric Technologies Pty Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package schedule
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/arm"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/policy"
+	"github.com/Azure/azure-sdk-for-go/sdk/azidentity"
+	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/appcontainers/armappcontainers/v2"
+	"github.com/robfig/cron/v3"
+	"github.com/samber/lo"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/nitrictech/nitric/cloud/azure/runtime/env"
+	azureutils "github.com/nitrictech/nitric/cloud/azure/runtime/utils"
+	grpc_errors "github.com/nitrictech/nitric/core/pkg/grpc/errors"
+	schedulespb "github.com/nitrictech/nitric/core/pkg/proto/schedules/v1"
+)
+
+// pausedScopeSuffix is appended to the app id a schedule's cron component is scoped to while it's paused,
+// so no app loads the component. Redeploying the stack resumes paused schedules.
+const pausedScopeSuffix = "-nitric-paused"
+
+// Dapr cron bindings accept standard cron expressions with optional seconds and descriptors e.g. @every 5m
+var cronParser = cron.NewParser(cron.SecondOptional | cron.Minute | cron.Hour | cron.Dom | cron.Month | cron.Dow | cron.Descriptor)
+
+// DaprComponentsClient is the subset of the container apps dapr components client used by the service
+type DaprComponentsClient interface {
+	Get(ctx context.Context, resourceGroupName string, environmentName string, componentName string, options *armappcontainers.DaprComponentsClientGetOptions) (armappcontainers.DaprComponentsClientGetResponse, error)
+	CreateOrUpdate(ctx context.Context, resourceGroupName string, environmentName string, componentName string, daprComponentEnvelope armappcontainers.DaprComponent, options *armappcontainers.DaprComponentsClientCreateOrUpdateOptions) (armappcontainers.DaprComponentsClientCreateOrUpdateResponse, error)
+}
+
+// DaprCronScheduleService manages the schedules of a stack, each deployed as a dapr cron binding component scoped to its target app
+type DaprCronScheduleService struct {
+	schedulespb.UnimplementedScheduleManagerServer
+
+	resourceGroup   string
+	environmentName string
+	daprHttpPort    string
+	components      DaprComponentsClient
+	client          *http.Client
+}
+
+var _ schedulespb.ScheduleManagerServer = &DaprCronScheduleService{}
+
+// componentMetadata returns the value of a metadata item of a dapr component
+func componentMetadata(component *armappcontainers.DaprComponent, name string) string {
+	for _, m := range component.Properties.Metadata {
+		if lo.FromPtr(m.Name) == name {
+			return lo.FromPtr(m.Value)
+		}
+	}
+
+	return ""
+}
+
+// componentPaused returns true if a dapr component is scoped away from its target app
+func componentPaused(component *armappcontainers.DaprComponent) bool {
+	return lo.SomeBy(component.Properties.Scopes, func(scope *string) bool {
+		return strings.HasSuffix(lo.FromPtr(scope), pausedScopeSuffix)
+	})
+}
+
+// errorCode returns the gRPC code for an error managing a dapr component
+func errorCode(err error) codes.Code {
+	var respErr *azcore.ResponseError
+	if !errors.As(err, &respErr) {
+		return codes.Internal
+	}
+
+	switch respErr.StatusCode {
+	case http.StatusNotFound:
+		return codes.NotFound
+	case http.StatusForbidden:
+		return codes.PermissionDenied
+	default:
+		return codes.Internal
+	}
+}
+
+// componentName returns the name of the dapr component of a schedule, named the same way they're deployed
+func componentName(scheduleName string) string {
+	return strings.ToLower(strings.ReplaceAll(scheduleName, " ", "-"))
+}
+
+func (s *DaprCronScheduleService) getComponent(ctx context.Context, scheduleName string) (*armappcontainers.DaprComponent, error) {
+	resp, err := s.components.Get(ctx, s.resourceGroup, s.environmentName, componentName(scheduleName), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if resp.Properties == nil {
+		resp.Properties = &armappcontainers.DaprComponentProperties{}
+	}
+
+	return &resp.DaprComponent, nil
+}
+
+// setPaused scopes the schedule's component away from, or back to, its target app
+func (s *DaprCronScheduleService) setPaused(ctx context.Context, scheduleName string, paused bool) error {
+	component, err := s.getComponent(ctx, scheduleName)
+	if err != nil {
+		return err
+	}
+
+	if componentPaused(component) == paused {
+		return nil
+	}
+
+	component.Properties.Scopes = lo.Map(component.Properties.Scopes, func(scope *string, _ int) *string {
+		if paused {
+			return lo.ToPtr(lo.FromPtr(scope) + pausedScopeSuffix)
+		}
+		return lo.ToPtr(strings.TrimSuffix(lo.FromPtr(scope), pausedScopeSuffix))
+	})
+
+	// the component is replaced on update, so its current properties are sent back with the new scopes
+	_, err = s.components.CreateOrUpdate(ctx, s.resourceGroup, s.environmentName, componentName(scheduleName), armappcontainers.DaprComponent{
+		Properties: component.Properties,
+	}, nil)
+
+	return err
+}
+
+func (s *DaprCronScheduleService) Trigger(ctx context.Context, req *schedulespb.ScheduleTriggerRequest) (*schedulespb.ScheduleTriggerResponse, error) {
+	newErr := grpc_errors.ErrorsWithScope("DaprCronScheduleService.Trigger")
+
+	component, err := s.getComponent(ctx, req.ScheduleName)
+	if err != nil {
+		return nil, newErr(errorCode(err), "error getting schedule component", err)
+	}
+
+	if len(component.Properties.Scopes) == 0 {
+		return nil, newErr(codes.FailedPrecondition, "schedule component has no target app", nil)
+	}
+
+	appId := strings.TrimSuffix(lo.FromPtr(component.Properties.Scopes[0]), pausedScopeSuffix)
+
+	// invoke the route the cron binding calls on the target app through the dapr sidecar
+	invokeUrl := fmt.Sprintf("http://localhost:%s/v1.0/invoke/%s/method/%s", s.daprHttpPort, url.PathEscape(appId), componentMetadata(component, "route"))
+
+	invokeReq, err := http.NewRequestWithContext(ctx, http.MethodPost, invokeUrl, nil)
+	if err != nil {
+		return nil, newErr(codes.Internal, "error creating schedule invocation", err)
+	}
+
+	resp, err := s.client.Do(invokeReq)
+	if err != nil {
+		return nil, newErr(codes.Unavailable, "error invoking schedule target", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode >= http.StatusMultipleChoices {
+		return nil, newErr(codes.Internal, "error invoking schedule target", fmt.Errorf("target returned status %d", resp.StatusCode))
+	}
+
+	return &schedulespb.ScheduleTriggerResponse{}, nil
+}
+
+func (s *DaprCronScheduleService) Pause(ctx context.Context, req *schedulespb.SchedulePauseRequest) (*schedulespb.SchedulePauseResponse, error) {
+	newErr := grpc_errors.ErrorsWithScope("DaprCronScheduleService.Pause")
+
+	if err := s.setPaused(ctx, req.ScheduleName, true); err != nil {
+		return nil, newErr(errorCode(err), "error pausing schedule", err)
+	}
+
+	return &schedulespb.SchedulePauseResponse{}, nil
+}
+
+func (s *DaprCronScheduleService) Resume(ctx context.Context, req *schedulespb.ScheduleResumeRequest) (*schedulespb.ScheduleResumeResponse, error) {
+	newErr := grpc_errors.ErrorsWithScope("DaprCronScheduleService.Resume")
+
+	if err := s.setPaused(ctx, req.ScheduleName, false); err != nil {
+		return nil, newErr(errorCode(err), "error resuming schedule", err)
+	}
+
+	return &schedulespb.ScheduleResumeResponse{}, nil
+}
+
+func (s *DaprCronScheduleService) Describe(ctx context.Context, req *schedulespb.ScheduleDescribeRequest) (*schedulespb.ScheduleDescribeResponse, error) {
+	newErr := grpc_errors.ErrorsWithScope("DaprCronScheduleService.Describe")
+
+	component, err := s.getComponent(ctx, req.ScheduleName)
+	if err != nil {
+		return nil, newErr(errorCode(err), "error getting schedule component", err)
+	}
+
+	if componentPaused(component) {
+		return &schedulespb.ScheduleDescribeResponse{Paused: true}, nil
+	}
+
+	times, err := nextFireTimes(componentMetadata(component, "schedule"), time.Now(), max(1, int(req.NextFireTimeCount)))
+	if err != nil {
+		return nil, newErr(codes.FailedPrecondition, "error calculating next fire times", err)
+	}
+
+	return &schedulespb.ScheduleDescribeResponse{
+		NextFireTimes: lo.Map(times, func(t time.Time, _ int) *timestamppb.Timestamp {
+			return timestamppb.New(t)
+		}),
+	}, nil
+}
+
+// nextFireTimes returns up to count times after from that a dapr cron binding schedule fires.
+//
+//	Interval schedules (@every) are counted from when the binding was loaded, which isn't known, so they're estimated from now.
+func nextFireTimes(schedule string, from time.Time, count int) ([]time.Time, error) {
+	cronSchedule, err := cronParser.Parse(strings.TrimSpace(schedule))
+	if err != nil {
+		return nil, fmt.Errorf("invalid schedule %s: %w", schedule, err)
+	}
+
+	// dapr evaluates cron expressions in the local time of the sidecar, which is UTC in container apps
+	times := make([]time.Time, 0, count)
+	for t := cronSchedule.Next(from.UTC()); len(times) < count && !t.IsZero(); t = cronSchedule.Next(t) {
+		times = append(times, t)
+	}
+
+	return times, nil
+}
+
+// New creates a schedule manager for the dapr cron components of the stack
+func New() (*DaprCronScheduleService, error) {
+	environmentId := env.AZURE_CONTAINER_ENVIRONMENT_ID.String()
+	if environmentId == "" {
+		return nil, fmt.Errorf("AZURE_CONTAINER_ENVIRONMENT_ID not configured")
+	}
+
+	environment, err := arm.ParseResourceID(environmentId)
+	if err != nil {
+		return nil, fmt.Errorf("invalid AZURE_CONTAINER_ENVIRONMENT_ID: %w", err)
+	}
+
+	cred, err := azidentity.NewDefaultAzureCredential(nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to locate default azure credential: %w", err)
+	}
+
+	components, err := armappcontainers.NewDaprComponentsClient(environment.SubscriptionID, cred, &arm.ClientOptions{
+		ClientOptions: policy.ClientOptions{Transport: azureutils.TracedHttpClient()},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return NewWithClient(environmentId, components)
+}
+
+// NewWithClient creates a schedule manager for the dapr cron components of the container apps environment with the given id
+//
+//	Primarily used for testing
+func NewWithClient(environmentId string, components DaprComponentsClient) (*DaprCronScheduleService, error) {
+	environment, err := arm.ParseResourceID(environmentId)
+	if err != nil {
+		return nil, fmt.Errorf("invalid container apps environment id: %w", err)
+	}
+
+	return &DaprCronScheduleService{
+		resourceGroup:   environment.ResourceGroupName,
+		environmentName: environment.Name,
+		daprHttpPort:    env.DAPR_HTTP_PORT.String(),
+		components:      components,
+		client:          http.DefaultClient,
+	}, nil
+}
@@ -0,0 +1,102 @@
+// Copyright 2021 Nitric Technologies Pty Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package schedule
+
+import (
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/appcontainers/armappcontainers/v2"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/samber/lo"
+)
+
+var _ = Describe("nextFireTimes", func() {
+	from := time.Date(2024, time.March, 4, 10, 17, 30, 0, time.UTC)
+
+	When("the schedule is a cron expression", func() {
+		It("should return the requested number of fire times", func() {
+			times, err := nextFireTimes("0 9 * * 1", from, 2)
+
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(times).To(Equal([]time.Time{
+				time.Date(2024, time.March, 11, 9, 0, 0, 0, time.UTC),
+				time.Date(2024, time.March, 18, 9, 0, 0, 0, time.UTC),
+			}))
+		})
+
+		It("should support a seconds field", func() {
+			times, err := nextFireTimes("15 */30 * * * *", from, 1)
+
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(times).To(Equal([]time.Time{time.Date(2024, time.March, 4, 10, 30, 15, 0, time.UTC)}))
+		})
+
+		It("should evaluate the expression in UTC", func() {
+			local := from.In(time.FixedZone("AEST", 10*60*60))
+
+			times, err := nextFireTimes("0 12 * * *", local, 1)
+
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(times).To(Equal([]time.Time{time.Date(2024, time.March, 4, 12, 0, 0, 0, time.UTC)}))
+		})
+	})
+
+	When("the schedule is an interval", func() {
+		It("should estimate the fire times from now", func() {
+			times, err := nextFireTimes("@every 5m", from, 2)
+
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(times).To(Equal([]time.Time{
+				time.Date(2024, time.March, 4, 10, 22, 30, 0, time.UTC),
+				time.Date(2024, time.March, 4, 10, 27, 30, 0, time.UTC),
+			}))
+		})
+	})
+
+	When("the schedule is invalid", func() {
+		It("should return an error", func() {
+			_, err := nextFireTimes("not a schedule", from, 1)
+
+			Expect(err).Should(HaveOccurred())
+		})
+	})
+})
+
+var _ = Describe("componentPaused", func() {
+	When("a scope is suffixed as paused", func() {
+		It("should be paused", func() {
+			component := &armappcontainers.DaprComponent{
+				Properties: &armappcontainers.DaprComponentProperties{
+					Scopes: []*string{lo.ToPtr("my-service" + pausedScopeSuffix)},
+				},
+			}
+
+			Expect(componentPaused(component)).To(BeTrue())
+		})
+	})
+
+	When("no scope is suffixed as paused", func() {
+		It("should not be paused", func() {
+			component := &armappcontainers.DaprComponent{
+				Properties: &armappcontainers.DaprComponentProperties{
+					Scopes: []*string{lo.ToPtr("my-service")},
+				},
+			}
+
+			Expect(componentPaused(component)).To(BeFalse())
+		})
+	})
+})
@@ -132,6 +132,7 @@ func New() (*KeyVaultSecretService, error) {
 
 	client := keyvault.New()
 	client.Authorizer = autorest.NewBearerAuthorizer(spt)
+	client.Sender = azureutils.TracedHttpClient()
 
 	return &KeyVaultSecretService{
 		client:    client,
@@ -20,6 +20,7 @@ import (
 	"github.com/nitrictech/nitric/cloud/azure/runtime/keyvalue"
 	"github.com/nitrictech/nitric/cloud/azure/runtime/queue"
 	"github.com/nitrictech/nitric/cloud/azure/runtime/resource"
+	"github.com/nitrictech/nitric/cloud/azure/runtime/schedule"
 	"github.com/nitrictech/nitric/cloud/azure/runtime/secret"
 	sql_service "github.com/nitrictech/nitric/cloud/azure/runtime/sql"
 	az_storage "github.com/nitrictech/nitric/cloud/azure/runtime/storage"
@@ -37,8 +38,10 @@ func NewAzureRuntimeServer(resourcesPlugin resource.AzResourceResolver, opts ...
 	apiPlugin := api.NewAzureApiGatewayProvider(resourcesPlugin)
 
 	sqlPlugin, _ := sql_service.New()
+	schedulePlugin, _ := schedule.New()
 
 	defaultAzureOpts := []server.ServerOption{
+		server.WithProvider("azure"),
 		server.WithKeyValuePlugin(keyValuePlugin),
 		server.WithSecretManagerPlugin(secretPlugin),
 		server.WithGatewayPlugin(gatewayPlugin),
@@ -47,6 +50,7 @@ func NewAzureRuntimeServer(resourcesPlugin resource.AzResourceResolver, opts ...
 		server.WithQueuesPlugin(queuesPlugin),
 		server.WithApiPlugin(apiPlugin),
 		server.WithSqlPlugin(sqlPlugin),
+		server.WithScheduleManagerPlugin(schedulePlugin),
 	}
 
 	// append overrides
@@ -17,6 +17,8 @@ package storage
 import (
 	"bytes"
 	"context"
+	"encoding/base64"
+	"errors"
 	"fmt"
 	"io"
 	"net/url"
@@ -26,6 +28,7 @@ import (
 	"github.com/Azure/go-autorest/autorest/adal"
 	"github.com/Azure/go-autorest/autorest/azure"
 	"google.golang.org/grpc/codes"
+	"google.golang.org/protobuf/types/known/timestamppb"
 
 	"github.com/nitrictech/nitric/cloud/azure/runtime/env"
 	azblob_service_iface "github.com/nitrictech/nitric/cloud/azure/runtime/storage/iface"
@@ -36,6 +39,12 @@ import (
 	storagepb "github.com/nitrictech/nitric/core/pkg/proto/storage/v1"
 )
 
+// blockSize is the size of the blocks streamed writes are staged in
+const blockSize = 4 * 1024 * 1024
+
+// copyPollInterval is how often the status of a pending server-side copy is checked
+const copyPollInterval = 500 * time.Millisecond
+
 // AzblobStorageService - Nitric storage plugin implementation for Azure Storage
 type AzblobStorageService struct {
 	client azblob_service_iface.AzblobServiceUrlIface
@@ -88,10 +97,37 @@ func (a *AzblobStorageService) Read(ctx context.Context, req *storagepb.StorageR
 	}, nil
 }
 
+// accessConditions returns the blob access conditions for the preconditions of a request
+func accessConditions(ifMatch string, ifNoneMatch string) azblob.BlobAccessConditions {
+	conditions := azblob.BlobAccessConditions{}
+
+	if ifMatch != "" {
+		conditions.ModifiedAccessConditions.IfMatch = azblob.ETag(ifMatch)
+	}
+
+	if ifNoneMatch != "" {
+		conditions.ModifiedAccessConditions.IfNoneMatch = azblob.ETagAny
+	}
+
+	return conditions
+}
+
+// isPreconditionFailed returns true if the given error is a storage error for an unmet access condition
+func isPreconditionFailed(err error) bool {
+	//nolint:all
+	if storageErr, ok := err.(azblob.StorageError); ok {
+		switch storageErr.ServiceCode() {
+		case azblob.ServiceCodeConditionNotMet, azblob.ServiceCodeBlobAlreadyExists:
+			return true
+		}
+	}
+	return false
+}
+
 func (a *AzblobStorageService) Write(ctx context.Context, req *storagepb.StorageWriteRequest) (*storagepb.StorageWriteResponse, error) {
 	newErr := grpc_errors.ErrorsWithScope("AzblobStorageService.Write")
 
-	contentType := content.DetectContentType(req.Key, req.Body)
+	contentType := content.ContentType(req.ContentType, req.Key, req.Body)
 
 	blob := a.getBlobUrl(req.BucketName, req.Key)
 
@@ -99,14 +135,23 @@ func (a *AzblobStorageService) Write(ctx context.Context, req *storagepb.Storage
 		ctx,
 		bytes.NewReader(req.Body),
 		azblob.BlobHTTPHeaders{
-			ContentType: contentType,
+			ContentType:  contentType,
+			CacheControl: req.CacheControl,
 		},
-		azblob.Metadata{},
-		azblob.BlobAccessConditions{},
+		toMetadata(req.Metadata),
+		accessConditions(req.IfMatch, req.IfNoneMatch),
 		azblob.DefaultAccessTier,
 		nil,
 		azblob.ClientProvidedKeyOptions{},
 	); err != nil {
+		if isPreconditionFailed(err) {
+			return nil, newErr(
+				codes.FailedPrecondition,
+				"blob was not written, its precondition was not met",
+				err,
+			)
+		}
+
 		return nil, newErr(
 			codes.Internal,
 			"Unable to write blob data",
@@ -117,6 +162,168 @@ func (a *AzblobStorageService) Write(ctx context.Context, req *storagepb.Storage
 	return &storagepb.StorageWriteResponse{}, nil
 }
 
+// ReadStream streams a blob, or a byte range of it, in chunks
+func (a *AzblobStorageService) ReadStream(req *storagepb.StorageReadStreamRequest, stream storagepb.Storage_ReadStreamServer) error {
+	newErr := grpc_errors.ErrorsWithScope("AzblobStorageService.ReadStream")
+
+	count := req.Length
+	if count == 0 {
+		count = azblob.CountToEnd
+	}
+
+	blob := a.getBlobUrl(req.BucketName, req.Key)
+	r, err := blob.Download(
+		stream.Context(),
+		req.Offset,
+		count,
+		azblob.BlobAccessConditions{},
+		false,
+		azblob.ClientProvidedKeyOptions{},
+	)
+	if err != nil {
+		//nolint:all
+		if storageErr, ok := err.(azblob.StorageError); ok && storageErr.ServiceCode() == azblob.ServiceCodeInvalidRange {
+			return newErr(
+				codes.OutOfRange,
+				"requested range is outside of the blob",
+				err,
+			)
+		}
+
+		return newErr(
+			codes.Internal,
+			"Unable to download blob",
+			err,
+		)
+	}
+
+	data := r.Body(azblob.RetryReaderOptions{MaxRetryRequests: 20})
+	defer data.Close()
+
+	if err := content.SendChunks(data, stream); err != nil {
+		return newErr(
+			codes.Internal,
+			"Error streaming blob response",
+			err,
+		)
+	}
+
+	return nil
+}
+
+// blockId returns the id of the nth block of a blob, ids must be base64 encoded and the same length for every block of a blob
+func blockId(n int) string {
+	return base64.StdEncoding.EncodeToString([]byte(fmt.Sprintf("%08d", n)))
+}
+
+// WriteStream stores a blob streamed in chunks, blobs larger than a block are staged as blocks then committed together
+func (a *AzblobStorageService) WriteStream(stream storagepb.Storage_WriteStreamServer) error {
+	newErr := grpc_errors.ErrorsWithScope("AzblobStorageService.WriteStream")
+	ctx := stream.Context()
+
+	writeStream, err := content.ReceiveWriteStream(stream)
+	if err != nil {
+		return newErr(
+			codes.InvalidArgument,
+			"invalid write stream",
+			err,
+		)
+	}
+
+	headers := azblob.BlobHTTPHeaders{
+		ContentType:  writeStream.ContentType(),
+		CacheControl: writeStream.Header.CacheControl,
+	}
+	metadata := toMetadata(writeStream.Header.Metadata)
+
+	blob := a.getBlobUrl(writeStream.Header.BucketName, writeStream.Header.Key)
+	block := make([]byte, blockSize)
+
+	n, err := readBlock(writeStream, block)
+	if err != nil {
+		return newErr(
+			content.ErrorCode(err, codes.Internal),
+			"Error receiving blob data",
+			err,
+		)
+	}
+
+	// blobs that fit in a single block are uploaded in one request
+	if n < blockSize {
+		if _, err := blob.Upload(
+			ctx,
+			bytes.NewReader(block[:n]),
+			headers,
+			metadata,
+			azblob.BlobAccessConditions{},
+			azblob.DefaultAccessTier,
+			nil,
+			azblob.ClientProvidedKeyOptions{},
+		); err != nil {
+			return newErr(
+				codes.Internal,
+				"Unable to write blob data",
+				err,
+			)
+		}
+
+		return stream.SendAndClose(&storagepb.StorageWriteStreamResponse{Size: int64(n)})
+	}
+
+	// blocks that are staged but never committed are discarded by Azure Storage
+	blockIds := []string{}
+	for ; n > 0 && err == nil; n, err = readBlock(writeStream, block) {
+		id := blockId(len(blockIds))
+
+		if _, err := blob.StageBlock(ctx, id, bytes.NewReader(block[:n]), azblob.LeaseAccessConditions{}, nil, azblob.ClientProvidedKeyOptions{}); err != nil {
+			return newErr(
+				codes.Internal,
+				"Unable to stage blob block",
+				err,
+			)
+		}
+
+		blockIds = append(blockIds, id)
+	}
+
+	if err != nil {
+		return newErr(
+			content.ErrorCode(err, codes.Internal),
+			"Error receiving blob data",
+			err,
+		)
+	}
+
+	if _, err := blob.CommitBlockList(
+		ctx,
+		blockIds,
+		headers,
+		metadata,
+		azblob.BlobAccessConditions{},
+		azblob.DefaultAccessTier,
+		nil,
+		azblob.ClientProvidedKeyOptions{},
+	); err != nil {
+		return newErr(
+			codes.Internal,
+			"Unable to commit blob blocks",
+			err,
+		)
+	}
+
+	return stream.SendAndClose(&storagepb.StorageWriteStreamResponse{Size: writeStream.Size()})
+}
+
+// readBlock fills block from r, returning fewer bytes than its size only at the end of r
+func readBlock(r io.Reader, block []byte) (int, error) {
+	n, err := io.ReadFull(r, block)
+	if errors.Is(err, io.EOF) || errors.Is(err, io.ErrUnexpectedEOF) {
+		return n, nil
+	}
+
+	return n, err
+}
+
 func (a *AzblobStorageService) Delete(ctx context.Context, req *storagepb.StorageDeleteRequest) (*storagepb.StorageDeleteResponse, error) {
 	newErr := grpc_errors.ErrorsWithScope("AzblobStorageService.Delete")
 
@@ -126,8 +333,16 @@ func (a *AzblobStorageService) Delete(ctx context.Context, req *storagepb.Storag
 	if _, err := blob.Delete(
 		context.TODO(),
 		azblob.DeleteSnapshotsOptionInclude,
-		azblob.BlobAccessConditions{},
+		accessConditions(req.IfMatch, ""),
 	); err != nil {
+		if isPreconditionFailed(err) {
+			return nil, newErr(
+				codes.FailedPrecondition,
+				"blob was not deleted, its precondition was not met",
+				err,
+			)
+		}
+
 		return nil, newErr(
 			codes.Internal,
 			"Unable to delete blob",
@@ -185,31 +400,119 @@ func (s *AzblobStorageService) ListBlobs(ctx context.Context, req *storagepb.Sto
 	newErr := grpc_errors.ErrorsWithScope("AzblobStorageService.ListFiles")
 
 	cUrl := s.getContainerUrl(req.BucketName)
-	files := make([]*storagepb.Blob, 0)
 
-	// List the blob(s) in our container; since a container may hold millions of blobs, this is done 1 segment at a time.
-	for marker := (azblob.Marker{}); marker.NotDone(); { // The parens around Marker{} are required to avoid compiler error.
-		// Get a result segment starting with the blob indicated by the current Marker.
-		listBlob, err := cUrl.ListBlobsFlatSegment(ctx, marker, azblob.ListBlobsSegmentOptions{
-			Prefix: req.Prefix,
-		})
-		if err != nil {
-			return nil, newErr(codes.Internal, "error listing files", err)
+	// The page token is the marker of the segment to start from, an empty marker starts from the first segment
+	marker := azblob.Marker{}
+	if req.PageToken != "" {
+		marker.Val = &req.PageToken
+	}
+
+	options := azblob.ListBlobsSegmentOptions{
+		Prefix:     req.Prefix,
+		MaxResults: req.PageSize,
+		Details: azblob.BlobListingDetails{
+			Metadata: true,
+		},
+	}
+
+	// without a page size or token every segment is listed, callers that don't page expect the complete listing,
+	// otherwise only a single segment is listed and the next marker is returned so callers can retrieve the next page
+	listAll := req.PageSize <= 0 && req.PageToken == ""
+
+	var blobItems []azblob.BlobItemInternal
+	prefixes := make([]string, 0)
+
+	for {
+		if req.Delimiter != "" {
+			listBlob, err := cUrl.ListBlobsHierarchySegment(ctx, marker, req.Delimiter, options)
+			if err != nil {
+				return nil, newErr(codes.Internal, "error listing files", err)
+			}
+
+			blobItems = append(blobItems, listBlob.Segment.BlobItems...)
+			marker = listBlob.NextMarker
+
+			for _, blobPrefix := range listBlob.Segment.BlobPrefixes {
+				prefixes = append(prefixes, blobPrefix.Name)
+			}
+		} else {
+			listBlob, err := cUrl.ListBlobsFlatSegment(ctx, marker, options)
+			if err != nil {
+				return nil, newErr(codes.Internal, "error listing files", err)
+			}
+
+			blobItems = append(blobItems, listBlob.Segment.BlobItems...)
+			marker = listBlob.NextMarker
 		}
-		// IMPORTANT: ListBlobs returns the start of the next segment; you MUST use this to get
-		// the next segment (after processing the current result segment).
-		marker = listBlob.NextMarker
-
-		// Process the blobs returned in this result segment (if the segment is empty, the loop body won't execute)
-		for _, blobInfo := range listBlob.Segment.BlobItems {
-			files = append(files, &storagepb.Blob{
-				Key: blobInfo.Name,
-			})
+
+		if !listAll || !marker.NotDone() {
+			break
 		}
 	}
 
+	files := make([]*storagepb.Blob, 0, len(blobItems))
+	for _, blobInfo := range blobItems {
+		files = append(files, &storagepb.Blob{
+			Key:          blobInfo.Name,
+			Size:         valueOf(blobInfo.Properties.ContentLength),
+			Etag:         string(blobInfo.Properties.Etag),
+			LastModified: timestamppb.New(blobInfo.Properties.LastModified),
+			ContentType:  valueOf(blobInfo.Properties.ContentType),
+			CacheControl: valueOf(blobInfo.Properties.CacheControl),
+			Metadata:     blobInfo.Metadata,
+		})
+	}
+
 	return &storagepb.StorageListBlobsResponse{
-		Blobs: files,
+		Blobs:          files,
+		CommonPrefixes: prefixes,
+		NextPageToken:  valueOf(marker.Val),
+	}, nil
+}
+
+// toMetadata returns the azure metadata of a blob from its nitric metadata
+func toMetadata(metadata map[string]string) azblob.Metadata {
+	md := azblob.Metadata{}
+	for k, v := range metadata {
+		md[k] = v
+	}
+	return md
+}
+
+// valueOf returns the value of an optional blob property, or its zero value when it's not set
+func valueOf[T any](v *T) T {
+	if v == nil {
+		var zero T
+		return zero
+	}
+	return *v
+}
+
+func (s *AzblobStorageService) Stat(ctx context.Context, req *storagepb.StorageStatRequest) (*storagepb.StorageStatResponse, error) {
+	newErr := grpc_errors.ErrorsWithScope("AzblobStorageService.Stat")
+
+	bUrl := s.getBlobUrl(req.BucketName, req.Key)
+
+	props, err := bUrl.GetProperties(ctx, azblob.BlobAccessConditions{}, azblob.ClientProvidedKeyOptions{})
+	if err != nil {
+		//nolint:all
+		if storageErr, ok := err.(azblob.StorageError); ok && storageErr.ServiceCode() == azblob.ServiceCodeBlobNotFound {
+			return nil, newErr(codes.NotFound, "blob does not exist", err)
+		}
+
+		return nil, newErr(codes.Internal, "error getting blob properties", err)
+	}
+
+	return &storagepb.StorageStatResponse{
+		Blob: &storagepb.Blob{
+			Key:          req.Key,
+			Size:         props.ContentLength(),
+			Etag:         string(props.ETag()),
+			LastModified: timestamppb.New(props.LastModified()),
+			ContentType:  props.ContentType(),
+			CacheControl: props.CacheControl(),
+			Metadata:     props.NewMetadata(),
+		},
 	}, nil
 }
 
@@ -239,6 +542,77 @@ func (s *AzblobStorageService) Exists(ctx context.Context, req *storagepb.Storag
 	}, nil
 }
 
+// copyBlob copies a blob server-side, waiting for copies the service completes asynchronously to finish
+func (s *AzblobStorageService) copyBlob(ctx context.Context, newErr grpc_errors.ScopedErrorFactory, sourceBucket string, sourceKey string, destinationBucket string, destinationKey string) error {
+	src := s.getBlobUrl(sourceBucket, sourceKey)
+	dst := s.getBlobUrl(destinationBucket, destinationKey)
+
+	// without metadata the service copies the source blob's metadata
+	resp, err := dst.StartCopyFromURL(ctx, src.Url(), nil, azblob.ModifiedAccessConditions{}, azblob.BlobAccessConditions{}, azblob.DefaultAccessTier, nil)
+	if err != nil {
+		//nolint:all
+		if storageErr, ok := err.(azblob.StorageError); ok {
+			switch storageErr.ServiceCode() {
+			case azblob.ServiceCodeBlobNotFound, azblob.ServiceCodeCannotVerifyCopySource:
+				return newErr(codes.NotFound, "source blob does not exist", err)
+			}
+		}
+
+		return newErr(codes.Internal, "error copying blob", err)
+	}
+
+	copyStatus := resp.CopyStatus()
+	for copyStatus == azblob.CopyStatusPending {
+		select {
+		case <-ctx.Done():
+			return newErr(codes.DeadlineExceeded, "blob copy did not complete", ctx.Err())
+		case <-time.After(copyPollInterval):
+		}
+
+		props, err := dst.GetProperties(ctx, azblob.BlobAccessConditions{}, azblob.ClientProvidedKeyOptions{})
+		if err != nil {
+			return newErr(codes.Internal, "error getting blob copy status", err)
+		}
+
+		copyStatus = props.CopyStatus()
+	}
+
+	if copyStatus != azblob.CopyStatusSuccess {
+		return newErr(codes.Internal, "blob copy did not succeed", fmt.Errorf("copy status %s", copyStatus))
+	}
+
+	return nil
+}
+
+func (s *AzblobStorageService) Copy(ctx context.Context, req *storagepb.StorageCopyRequest) (*storagepb.StorageCopyResponse, error) {
+	newErr := grpc_errors.ErrorsWithScope("AzblobStorageService.Copy")
+
+	if err := s.copyBlob(ctx, newErr, req.SourceBucketName, req.SourceKey, req.DestinationBucketName, req.DestinationKey); err != nil {
+		return nil, err
+	}
+
+	return &storagepb.StorageCopyResponse{}, nil
+}
+
+// Move copies a blob then deletes the source, azure blob storage has no rename for blobs
+func (s *AzblobStorageService) Move(ctx context.Context, req *storagepb.StorageMoveRequest) (*storagepb.StorageMoveResponse, error) {
+	newErr := grpc_errors.ErrorsWithScope("AzblobStorageService.Move")
+
+	if err := s.copyBlob(ctx, newErr, req.SourceBucketName, req.SourceKey, req.DestinationBucketName, req.DestinationKey); err != nil {
+		return nil, err
+	}
+
+	if _, err := s.getBlobUrl(req.SourceBucketName, req.SourceKey).Delete(
+		ctx,
+		azblob.DeleteSnapshotsOptionInclude,
+		azblob.BlobAccessConditions{},
+	); err != nil {
+		return nil, newErr(codes.Internal, "unable to delete source blob", err)
+	}
+
+	return &storagepb.StorageMoveResponse{}, nil
+}
+
 const expiryBuffer = 2 * time.Minute
 
 func tokenRefresherFromSpt(spt *adal.ServicePrincipalToken) azblob.TokenRefresher {
@@ -276,7 +650,7 @@ func New() (*AzblobStorageService, error) {
 		return nil, err
 	}
 
-	pipeline := azblob.NewPipeline(cTkn, azblob.PipelineOptions{})
+	pipeline := azblob.NewPipeline(cTkn, azblob.PipelineOptions{HTTPSender: azureutils.TracedPipelineSender()})
 	client := azblob.NewServiceURL(*accountURL, pipeline)
 
 	return &AzblobStorageService{
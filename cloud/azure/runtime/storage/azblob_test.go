@@ -25,6 +25,7 @@ import (
 
 	"github.com/Azure/azure-storage-blob-go/azblob"
 	"github.com/golang/mock/gomock"
+	"google.golang.org/grpc"
 	"google.golang.org/protobuf/types/known/durationpb"
 
 	. "github.com/onsi/ginkgo"
@@ -34,6 +35,55 @@ import (
 	storagepb "github.com/nitrictech/nitric/core/pkg/proto/storage/v1"
 )
 
+type mockReadStream struct {
+	grpc.ServerStream
+	chunks [][]byte
+}
+
+func (m *mockReadStream) Context() context.Context {
+	return context.TODO()
+}
+
+func (m *mockReadStream) Send(resp *storagepb.StorageReadStreamResponse) error {
+	m.chunks = append(m.chunks, resp.Chunk)
+	return nil
+}
+
+type mockWriteStream struct {
+	grpc.ServerStream
+	requests []*storagepb.StorageWriteStreamRequest
+	response *storagepb.StorageWriteStreamResponse
+}
+
+func newMockWriteStream(bucket string, key string, body []byte) *mockWriteStream {
+	return &mockWriteStream{
+		requests: []*storagepb.StorageWriteStreamRequest{
+			{Content: &storagepb.StorageWriteStreamRequest_Header{Header: &storagepb.StorageWriteStreamHeader{BucketName: bucket, Key: key}}},
+			{Content: &storagepb.StorageWriteStreamRequest_Chunk{Chunk: body}},
+		},
+	}
+}
+
+func (m *mockWriteStream) Context() context.Context {
+	return context.TODO()
+}
+
+func (m *mockWriteStream) Recv() (*storagepb.StorageWriteStreamRequest, error) {
+	if len(m.requests) == 0 {
+		return nil, io.EOF
+	}
+
+	req := m.requests[0]
+	m.requests = m.requests[1:]
+
+	return req, nil
+}
+
+func (m *mockWriteStream) SendAndClose(resp *storagepb.StorageWriteStreamResponse) error {
+	m.response = resp
+	return nil
+}
+
 var _ = Describe("Azblob", func() {
 	// Context("New", func() {
 	//	When("", func() {
@@ -171,6 +221,61 @@ var _ = Describe("Azblob", func() {
 			})
 		})
 
+		When("The blob already exists", func() {
+			crtl := gomock.NewController(GinkgoT())
+			mockAzblob := mock_azblob.NewMockAzblobServiceUrlIface(crtl)
+			mockContainer := mock_azblob.NewMockAzblobContainerUrlIface(crtl)
+			mockBlob := mock_azblob.NewMockAzblobBlockBlobUrlIface(crtl)
+			mockError := mock_azblob.NewMockStorageError(crtl)
+
+			storagePlugin := &AzblobStorageService{
+				client: mockAzblob,
+			}
+
+			It("should not overwrite the blob when writing only if it doesn't exist", func() {
+				By("Retrieving the Container URL for the requested bucket")
+				mockAzblob.EXPECT().NewContainerURL("my-bucket").Times(1).Return(mockContainer)
+
+				By("Retrieving the blob url of the requested object")
+				mockContainer.EXPECT().NewBlockBlobURL("my-blob").Times(1).Return(mockBlob)
+
+				By("Producing a service code of azblob.ServiceCodeBlobAlreadyExists")
+				mockError.EXPECT().ServiceCode().Times(1).Return(azblob.ServiceCodeBlobAlreadyExists)
+				mockError.EXPECT().Error().AnyTimes()
+
+				By("Calling Upload once on the blob with an If-None-Match condition")
+				mockBlob.EXPECT().Upload(
+					gomock.Any(),
+					bytes.NewReader([]byte("test")),
+					azblob.BlobHTTPHeaders{
+						ContentType: "text/plain; charset=utf-8",
+					},
+					azblob.Metadata{},
+					azblob.BlobAccessConditions{
+						ModifiedAccessConditions: azblob.ModifiedAccessConditions{
+							IfNoneMatch: azblob.ETagAny,
+						},
+					},
+					azblob.DefaultAccessTier,
+					nil,
+					azblob.ClientProvidedKeyOptions{},
+				).Times(1).Return(nil, mockError)
+
+				_, err := storagePlugin.Write(context.TODO(), &storagepb.StorageWriteRequest{
+					BucketName:  "my-bucket",
+					Key:         "my-blob",
+					Body:        []byte("test"),
+					IfNoneMatch: "*",
+				})
+
+				By("returning a failed precondition error")
+				Expect(err).To(HaveOccurred())
+				Expect(err.Error()).To(ContainSubstring("FailedPrecondition"))
+
+				crtl.Finish()
+			})
+		})
+
 		When("Azure returns an error", func() {
 			crtl := gomock.NewController(GinkgoT())
 			mockAzblob := mock_azblob.NewMockAzblobServiceUrlIface(crtl)
@@ -253,6 +358,53 @@ var _ = Describe("Azblob", func() {
 			})
 		})
 
+		When("The blob's etag doesn't match", func() {
+			crtl := gomock.NewController(GinkgoT())
+			mockAzblob := mock_azblob.NewMockAzblobServiceUrlIface(crtl)
+			mockContainer := mock_azblob.NewMockAzblobContainerUrlIface(crtl)
+			mockBlob := mock_azblob.NewMockAzblobBlockBlobUrlIface(crtl)
+			mockError := mock_azblob.NewMockStorageError(crtl)
+
+			storagePlugin := &AzblobStorageService{
+				client: mockAzblob,
+			}
+
+			It("should not delete the blob", func() {
+				By("Retrieving the Container URL for the requested bucket")
+				mockAzblob.EXPECT().NewContainerURL("my-bucket").Times(1).Return(mockContainer)
+
+				By("Retrieving the blob url of the requested object")
+				mockContainer.EXPECT().NewBlockBlobURL("my-blob").Times(1).Return(mockBlob)
+
+				By("Producing a service code of azblob.ServiceCodeConditionNotMet")
+				mockError.EXPECT().ServiceCode().Times(1).Return(azblob.ServiceCodeConditionNotMet)
+				mockError.EXPECT().Error().AnyTimes()
+
+				By("Calling Delete once on the blob with an If-Match condition")
+				mockBlob.EXPECT().Delete(
+					gomock.Any(),
+					azblob.DeleteSnapshotsOptionInclude,
+					azblob.BlobAccessConditions{
+						ModifiedAccessConditions: azblob.ModifiedAccessConditions{
+							IfMatch: azblob.ETag("\"0x8DC3C4A4E1D5F00\""),
+						},
+					},
+				).Times(1).Return(nil, mockError)
+
+				_, err := storagePlugin.Delete(context.TODO(), &storagepb.StorageDeleteRequest{
+					BucketName: "my-bucket",
+					Key:        "my-blob",
+					IfMatch:    "\"0x8DC3C4A4E1D5F00\"",
+				})
+
+				By("returning a failed precondition error")
+				Expect(err).To(HaveOccurred())
+				Expect(err.Error()).To(ContainSubstring("FailedPrecondition"))
+
+				crtl.Finish()
+			})
+		})
+
 		When("Azure returns an error", func() {
 			crtl := gomock.NewController(GinkgoT())
 			mockAzblob := mock_azblob.NewMockAzblobServiceUrlIface(crtl)
@@ -294,6 +446,8 @@ var _ = Describe("Azblob", func() {
 		When("Azure returns a successful response", func() {
 			ctrl := gomock.NewController(GinkgoT())
 			doneMarker := ""
+			contentLength := int64(12)
+			contentType := "image/png"
 			mockAzblob := mock_azblob.NewMockAzblobServiceUrlIface(ctrl)
 			mockContainer := mock_azblob.NewMockAzblobContainerUrlIface(ctrl)
 
@@ -314,6 +468,13 @@ var _ = Describe("Azblob", func() {
 						BlobItems: []azblob.BlobItemInternal{
 							{
 								Name: "/test/test.png",
+								Properties: azblob.BlobPropertiesInternal{
+									ContentLength: &contentLength,
+									ContentType:   &contentType,
+									Etag:          azblob.ETag("\"etag\""),
+									LastModified:  time.Date(2024, time.March, 4, 10, 0, 0, 0, time.UTC),
+								},
+								Metadata: azblob.Metadata{"owner": "me"},
 							},
 						},
 					},
@@ -333,6 +494,122 @@ var _ = Describe("Azblob", func() {
 				By("Having the returned key")
 				Expect(resp.Blobs[0].Key).To(Equal("/test/test.png"))
 
+				By("Having the returned attributes")
+				Expect(resp.Blobs[0].Size).To(Equal(int64(12)))
+				Expect(resp.Blobs[0].ContentType).To(Equal("image/png"))
+				Expect(resp.Blobs[0].Etag).To(Equal("\"etag\""))
+				Expect(resp.Blobs[0].LastModified.AsTime()).To(Equal(time.Date(2024, time.March, 4, 10, 0, 0, 0, time.UTC)))
+				Expect(resp.Blobs[0].Metadata).To(Equal(map[string]string{"owner": "me"}))
+
+				By("Not returning a next page token")
+				Expect(resp.NextPageToken).To(BeEmpty())
+
+				ctrl.Finish()
+			})
+		})
+
+		When("Listing every segment of a container", func() {
+			ctrl := gomock.NewController(GinkgoT())
+			nextMarker := "segment-2"
+			doneMarker := ""
+			mockAzblob := mock_azblob.NewMockAzblobServiceUrlIface(ctrl)
+			mockContainer := mock_azblob.NewMockAzblobContainerUrlIface(ctrl)
+
+			storagePlugin := &AzblobStorageService{
+				client: mockAzblob,
+			}
+
+			It("should return the files from every segment when no page is requested", func() {
+				By("Retrieving the Container URL for the requested bucket")
+				mockAzblob.EXPECT().NewContainerURL("my-bucket").Times(1).Return(mockContainer)
+
+				By("The container returning the files in two segments")
+				gomock.InOrder(
+					mockContainer.EXPECT().ListBlobsFlatSegment(gomock.Any(), azblob.Marker{}, gomock.Any()).Times(1).Return(&azblob.ListBlobsFlatSegmentResponse{
+						NextMarker: azblob.Marker{Val: &nextMarker},
+						Segment: azblob.BlobFlatListSegment{
+							BlobItems: []azblob.BlobItemInternal{{Name: "test/first.png"}},
+						},
+					}, nil),
+					mockContainer.EXPECT().ListBlobsFlatSegment(gomock.Any(), azblob.Marker{Val: &nextMarker}, gomock.Any()).Times(1).Return(&azblob.ListBlobsFlatSegmentResponse{
+						NextMarker: azblob.Marker{Val: &doneMarker},
+						Segment: azblob.BlobFlatListSegment{
+							BlobItems: []azblob.BlobItemInternal{{Name: "test/second.png"}},
+						},
+					}, nil),
+				)
+
+				resp, err := storagePlugin.ListBlobs(context.TODO(), &storagepb.StorageListBlobsRequest{
+					BucketName: "my-bucket",
+					Prefix:     "test/",
+				})
+
+				By("Not returning an error")
+				Expect(err).ShouldNot(HaveOccurred())
+
+				By("Returning the files from both segments")
+				Expect(resp.Blobs).To(HaveLen(2))
+				Expect(resp.Blobs[0].Key).To(Equal("test/first.png"))
+				Expect(resp.Blobs[1].Key).To(Equal("test/second.png"))
+
+				By("Not returning a next page token")
+				Expect(resp.NextPageToken).To(BeEmpty())
+
+				ctrl.Finish()
+			})
+		})
+
+		When("Listing a page of a directory", func() {
+			ctrl := gomock.NewController(GinkgoT())
+			nextMarker := "page-2"
+			mockAzblob := mock_azblob.NewMockAzblobServiceUrlIface(ctrl)
+			mockContainer := mock_azblob.NewMockAzblobContainerUrlIface(ctrl)
+
+			storagePlugin := &AzblobStorageService{
+				client: mockAzblob,
+			}
+
+			It("should return the files, common prefixes and next page token", func() {
+				By("Retrieving the Container URL for the requested bucket")
+				mockAzblob.EXPECT().NewContainerURL("my-bucket").Times(1).Return(mockContainer)
+
+				By("The container returning a segment of the hierarchy")
+				pageToken := "page-1"
+				mockContainer.EXPECT().ListBlobsHierarchySegment(gomock.Any(), azblob.Marker{Val: &pageToken}, "/", azblob.ListBlobsSegmentOptions{
+					Prefix:     "logs/",
+					MaxResults: 2,
+					Details: azblob.BlobListingDetails{
+						Metadata: true,
+					},
+				}).Times(1).Return(&azblob.ListBlobsHierarchySegmentResponse{
+					NextMarker: azblob.Marker{
+						Val: &nextMarker,
+					},
+					Segment: azblob.BlobHierarchyListSegment{
+						BlobPrefixes: []azblob.BlobPrefix{{Name: "logs/2024-03-04/"}},
+						BlobItems:    []azblob.BlobItemInternal{{Name: "logs/index"}},
+					},
+				}, nil)
+
+				resp, err := storagePlugin.ListBlobs(context.TODO(), &storagepb.StorageListBlobsRequest{
+					BucketName: "my-bucket",
+					Prefix:     "logs/",
+					PageSize:   2,
+					PageToken:  "page-1",
+					Delimiter:  "/",
+				})
+
+				By("Not returning an error")
+				Expect(err).ShouldNot(HaveOccurred())
+
+				By("Returning the files and common prefixes")
+				Expect(resp.Blobs).To(HaveLen(1))
+				Expect(resp.Blobs[0].Key).To(Equal("logs/index"))
+				Expect(resp.CommonPrefixes).To(Equal([]string{"logs/2024-03-04/"}))
+
+				By("Returning the next marker as the next page token")
+				Expect(resp.NextPageToken).To(Equal("page-2"))
+
 				ctrl.Finish()
 			})
 		})
@@ -574,6 +851,330 @@ var _ = Describe("Azblob", func() {
 				By("Returning nil")
 				Expect(resp).To(BeNil())
 
+				ctrl.Finish()
+			})
+		})
+	})
+	Context("Copy", func() {
+		When("The copy succeeds", func() {
+			ctrl := gomock.NewController(GinkgoT())
+			mockAzblob := mock_azblob.NewMockAzblobServiceUrlIface(ctrl)
+			mockSourceContainer := mock_azblob.NewMockAzblobContainerUrlIface(ctrl)
+			mockDestinationContainer := mock_azblob.NewMockAzblobContainerUrlIface(ctrl)
+			mockSource := mock_azblob.NewMockAzblobBlockBlobUrlIface(ctrl)
+			mockDestination := mock_azblob.NewMockAzblobBlockBlobUrlIface(ctrl)
+			mockCopy := mock_azblob.NewMockAzblobCopyResponse(ctrl)
+
+			storagePlugin := &AzblobStorageService{
+				client: mockAzblob,
+			}
+
+			It("should copy the blob from the source url", func() {
+				By("Retrieving the blob urls of the source and destination")
+				mockAzblob.EXPECT().NewContainerURL("incoming").Times(1).Return(mockSourceContainer)
+				mockAzblob.EXPECT().NewContainerURL("processed").Times(1).Return(mockDestinationContainer)
+				mockSourceContainer.EXPECT().NewBlockBlobURL("test-key").Times(1).Return(mockSource)
+				mockDestinationContainer.EXPECT().NewBlockBlobURL("done/test-key").Times(1).Return(mockDestination)
+
+				u, _ := url.Parse("https://fake-account.com/incoming/test-key")
+				mockSource.EXPECT().Url().Return(*u)
+
+				By("Copying the blob server-side")
+				mockDestination.EXPECT().StartCopyFromURL(gomock.Any(), *u, gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Times(1).Return(mockCopy, nil)
+				mockCopy.EXPECT().CopyStatus().Return(azblob.CopyStatusSuccess)
+
+				_, err := storagePlugin.Copy(context.TODO(), &storagepb.StorageCopyRequest{
+					SourceBucketName:      "incoming",
+					SourceKey:             "test-key",
+					DestinationBucketName: "processed",
+					DestinationKey:        "done/test-key",
+				})
+
+				By("Not returning an error")
+				Expect(err).ShouldNot(HaveOccurred())
+
+				ctrl.Finish()
+			})
+		})
+
+		When("The source blob does not exist", func() {
+			ctrl := gomock.NewController(GinkgoT())
+			mockAzblob := mock_azblob.NewMockAzblobServiceUrlIface(ctrl)
+			mockContainer := mock_azblob.NewMockAzblobContainerUrlIface(ctrl)
+			mockSource := mock_azblob.NewMockAzblobBlockBlobUrlIface(ctrl)
+			mockDestination := mock_azblob.NewMockAzblobBlockBlobUrlIface(ctrl)
+			mockError := mock_azblob.NewMockStorageError(ctrl)
+
+			storagePlugin := &AzblobStorageService{
+				client: mockAzblob,
+			}
+
+			It("should return a not found error", func() {
+				By("Retrieving the blob urls of the source and destination")
+				mockAzblob.EXPECT().NewContainerURL("my-bucket").Times(2).Return(mockContainer)
+				mockContainer.EXPECT().NewBlockBlobURL("missing").Times(1).Return(mockSource)
+				mockContainer.EXPECT().NewBlockBlobURL("copy").Times(1).Return(mockDestination)
+				mockSource.EXPECT().Url().Return(url.URL{})
+
+				By("Producing a service code of azblob.ServiceCodeCannotVerifyCopySource")
+				mockError.EXPECT().ServiceCode().Times(1).Return(azblob.ServiceCodeCannotVerifyCopySource)
+				mockError.EXPECT().Error().AnyTimes()
+				mockDestination.EXPECT().StartCopyFromURL(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Times(1).Return(nil, mockError)
+
+				_, err := storagePlugin.Copy(context.TODO(), &storagepb.StorageCopyRequest{
+					SourceBucketName:      "my-bucket",
+					SourceKey:             "missing",
+					DestinationBucketName: "my-bucket",
+					DestinationKey:        "copy",
+				})
+
+				By("Returning a not found error")
+				Expect(err).Should(HaveOccurred())
+				Expect(err.Error()).To(ContainSubstring("NotFound"))
+
+				ctrl.Finish()
+			})
+		})
+	})
+
+	Context("Move", func() {
+		When("The copy succeeds", func() {
+			ctrl := gomock.NewController(GinkgoT())
+			mockAzblob := mock_azblob.NewMockAzblobServiceUrlIface(ctrl)
+			mockContainer := mock_azblob.NewMockAzblobContainerUrlIface(ctrl)
+			mockSource := mock_azblob.NewMockAzblobBlockBlobUrlIface(ctrl)
+			mockDestination := mock_azblob.NewMockAzblobBlockBlobUrlIface(ctrl)
+			mockCopy := mock_azblob.NewMockAzblobCopyResponse(ctrl)
+
+			storagePlugin := &AzblobStorageService{
+				client: mockAzblob,
+			}
+
+			It("should delete the source blob", func() {
+				By("Retrieving the blob urls of the source and destination")
+				mockAzblob.EXPECT().NewContainerURL("my-bucket").Times(3).Return(mockContainer)
+				mockContainer.EXPECT().NewBlockBlobURL("old-key").Times(2).Return(mockSource)
+				mockContainer.EXPECT().NewBlockBlobURL("new-key").Times(1).Return(mockDestination)
+				mockSource.EXPECT().Url().Return(url.URL{})
+
+				By("Copying the blob then deleting the source")
+				gomock.InOrder(
+					mockDestination.EXPECT().StartCopyFromURL(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Times(1).Return(mockCopy, nil),
+					mockCopy.EXPECT().CopyStatus().Return(azblob.CopyStatusSuccess),
+					mockSource.EXPECT().Delete(gomock.Any(), azblob.DeleteSnapshotsOptionInclude, gomock.Any()).Times(1).Return(&azblob.BlobDeleteResponse{}, nil),
+				)
+
+				_, err := storagePlugin.Move(context.TODO(), &storagepb.StorageMoveRequest{
+					SourceBucketName:      "my-bucket",
+					SourceKey:             "old-key",
+					DestinationBucketName: "my-bucket",
+					DestinationKey:        "new-key",
+				})
+
+				By("Not returning an error")
+				Expect(err).ShouldNot(HaveOccurred())
+
+				ctrl.Finish()
+			})
+		})
+
+		When("The copy fails", func() {
+			ctrl := gomock.NewController(GinkgoT())
+			mockAzblob := mock_azblob.NewMockAzblobServiceUrlIface(ctrl)
+			mockContainer := mock_azblob.NewMockAzblobContainerUrlIface(ctrl)
+			mockSource := mock_azblob.NewMockAzblobBlockBlobUrlIface(ctrl)
+			mockDestination := mock_azblob.NewMockAzblobBlockBlobUrlIface(ctrl)
+			mockCopy := mock_azblob.NewMockAzblobCopyResponse(ctrl)
+
+			storagePlugin := &AzblobStorageService{
+				client: mockAzblob,
+			}
+
+			It("should keep the source blob", func() {
+				By("Retrieving the blob urls of the source and destination")
+				mockAzblob.EXPECT().NewContainerURL("my-bucket").Times(2).Return(mockContainer)
+				mockContainer.EXPECT().NewBlockBlobURL("old-key").Times(1).Return(mockSource)
+				mockContainer.EXPECT().NewBlockBlobURL("new-key").Times(1).Return(mockDestination)
+				mockSource.EXPECT().Url().Return(url.URL{})
+
+				By("The copy failing")
+				mockDestination.EXPECT().StartCopyFromURL(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Times(1).Return(mockCopy, nil)
+				mockCopy.EXPECT().CopyStatus().Return(azblob.CopyStatusFailed)
+
+				_, err := storagePlugin.Move(context.TODO(), &storagepb.StorageMoveRequest{
+					SourceBucketName:      "my-bucket",
+					SourceKey:             "old-key",
+					DestinationBucketName: "my-bucket",
+					DestinationKey:        "new-key",
+				})
+
+				By("Returning an error")
+				Expect(err).Should(HaveOccurred())
+
+				ctrl.Finish()
+			})
+		})
+	})
+
+	Context("Stat", func() {
+		When("The blob does not exist", func() {
+			ctrl := gomock.NewController(GinkgoT())
+			mockAzblob := mock_azblob.NewMockAzblobServiceUrlIface(ctrl)
+			mockContainer := mock_azblob.NewMockAzblobContainerUrlIface(ctrl)
+			mockBlob := mock_azblob.NewMockAzblobBlockBlobUrlIface(ctrl)
+			mockError := mock_azblob.NewMockStorageError(ctrl)
+
+			storagePlugin := &AzblobStorageService{
+				client: mockAzblob,
+			}
+
+			It("should return a not found error", func() {
+				By("Retrieving the Blob URL for the requested file")
+				mockAzblob.EXPECT().NewContainerURL("my-bucket").Times(1).Return(mockContainer)
+				mockContainer.EXPECT().NewBlockBlobURL("test-file").Times(1).Return(mockBlob)
+
+				By("Producing a service code of azblob.ServiceCodeBlobNotFound")
+				mockError.EXPECT().ServiceCode().Times(1).Return(azblob.ServiceCodeBlobNotFound)
+				mockError.EXPECT().Error().AnyTimes()
+
+				By("Calling GetProperties on the file")
+				mockBlob.EXPECT().GetProperties(gomock.Any(), gomock.Any(), gomock.Any()).Times(1).Return(nil, mockError)
+
+				_, err := storagePlugin.Stat(context.TODO(), &storagepb.StorageStatRequest{
+					BucketName: "my-bucket",
+					Key:        "test-file",
+				})
+
+				By("Returning a not found error")
+				Expect(err).Should(HaveOccurred())
+				Expect(err.Error()).To(ContainSubstring("NotFound"))
+
+				ctrl.Finish()
+			})
+		})
+	})
+
+	Context("ReadStream", func() {
+		When("A byte range is requested", func() {
+			ctrl := gomock.NewController(GinkgoT())
+			mockAzblob := mock_azblob.NewMockAzblobServiceUrlIface(ctrl)
+			mockContainer := mock_azblob.NewMockAzblobContainerUrlIface(ctrl)
+			mockBlob := mock_azblob.NewMockAzblobBlockBlobUrlIface(ctrl)
+			mockDown := mock_azblob.NewMockAzblobDownloadResponse(ctrl)
+
+			storagePlugin := &AzblobStorageService{
+				client: mockAzblob,
+			}
+
+			It("should stream the range of the blob", func() {
+				By("Retrieving the Container URL for the requested bucket")
+				mockAzblob.EXPECT().NewContainerURL("my-bucket").Times(1).Return(mockContainer)
+
+				By("Retrieving the blob url of the requested object")
+				mockContainer.EXPECT().NewBlockBlobURL("test-file").Times(1).Return(mockBlob)
+
+				By("Downloading the requested range")
+				mockBlob.EXPECT().Download(gomock.Any(), int64(10), int64(5), gomock.Any(), false, gomock.Any()).Times(1).Return(mockDown, nil)
+				mockDown.EXPECT().Body(gomock.Any()).Times(1).Return(io.NopCloser(bytes.NewReader([]byte("range"))))
+
+				stream := &mockReadStream{}
+				err := storagePlugin.ReadStream(&storagepb.StorageReadStreamRequest{
+					BucketName: "my-bucket",
+					Key:        "test-file",
+					Offset:     10,
+					Length:     5,
+				}, stream)
+
+				By("Not returning an error")
+				Expect(err).ShouldNot(HaveOccurred())
+
+				By("Sending the range")
+				Expect(stream.chunks).To(Equal([][]byte{[]byte("range")}))
+
+				ctrl.Finish()
+			})
+		})
+	})
+
+	Context("WriteStream", func() {
+		When("The blob fits in a single block", func() {
+			ctrl := gomock.NewController(GinkgoT())
+			mockAzblob := mock_azblob.NewMockAzblobServiceUrlIface(ctrl)
+			mockContainer := mock_azblob.NewMockAzblobContainerUrlIface(ctrl)
+			mockBlob := mock_azblob.NewMockAzblobBlockBlobUrlIface(ctrl)
+
+			storagePlugin := &AzblobStorageService{
+				client: mockAzblob,
+			}
+
+			It("should upload the blob", func() {
+				By("Retrieving the blob url of the requested object")
+				mockAzblob.EXPECT().NewContainerURL("my-bucket").Times(1).Return(mockContainer)
+				mockContainer.EXPECT().NewBlockBlobURL("test-file.txt").Times(1).Return(mockBlob)
+
+				By("Uploading the blob with its content type")
+				mockBlob.EXPECT().Upload(
+					gomock.Any(),
+					bytes.NewReader([]byte("hello")),
+					azblob.BlobHTTPHeaders{ContentType: "text/plain; charset=utf-8"},
+					gomock.Any(),
+					gomock.Any(),
+					gomock.Any(),
+					gomock.Any(),
+					gomock.Any(),
+				).Times(1).Return(&azblob.BlockBlobUploadResponse{}, nil)
+
+				stream := newMockWriteStream("my-bucket", "test-file.txt", []byte("hello"))
+				err := storagePlugin.WriteStream(stream)
+
+				By("Not returning an error")
+				Expect(err).ShouldNot(HaveOccurred())
+				Expect(stream.response.Size).To(Equal(int64(5)))
+
+				ctrl.Finish()
+			})
+		})
+
+		When("The blob is larger than a block", func() {
+			ctrl := gomock.NewController(GinkgoT())
+			mockAzblob := mock_azblob.NewMockAzblobServiceUrlIface(ctrl)
+			mockContainer := mock_azblob.NewMockAzblobContainerUrlIface(ctrl)
+			mockBlob := mock_azblob.NewMockAzblobBlockBlobUrlIface(ctrl)
+
+			storagePlugin := &AzblobStorageService{
+				client: mockAzblob,
+			}
+
+			It("should stage and commit the blocks of the blob", func() {
+				payload := bytes.Repeat([]byte("a"), blockSize+10)
+
+				By("Retrieving the blob url of the requested object")
+				mockAzblob.EXPECT().NewContainerURL("my-bucket").Times(1).Return(mockContainer)
+				mockContainer.EXPECT().NewBlockBlobURL("test-file").Times(1).Return(mockBlob)
+
+				By("Staging each block")
+				mockBlob.EXPECT().StageBlock(gomock.Any(), blockId(0), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Times(1).Return(&azblob.BlockBlobStageBlockResponse{}, nil)
+				mockBlob.EXPECT().StageBlock(gomock.Any(), blockId(1), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Times(1).Return(&azblob.BlockBlobStageBlockResponse{}, nil)
+
+				By("Committing the staged blocks in order")
+				mockBlob.EXPECT().CommitBlockList(
+					gomock.Any(),
+					[]string{blockId(0), blockId(1)},
+					gomock.Any(),
+					gomock.Any(),
+					gomock.Any(),
+					gomock.Any(),
+					gomock.Any(),
+					gomock.Any(),
+				).Times(1).Return(&azblob.BlockBlobCommitBlockListResponse{}, nil)
+
+				stream := newMockWriteStream("my-bucket", "test-file", payload)
+				err := storagePlugin.WriteStream(stream)
+
+				By("Not returning an error")
+				Expect(err).ShouldNot(HaveOccurred())
+				Expect(stream.response.Size).To(Equal(int64(len(payload))))
+
 				ctrl.Finish()
 			})
 		})
@@ -33,6 +33,7 @@ type AzblobServiceUrlIface interface {
 // for azblob.ContainerUrl
 type AzblobContainerUrlIface interface {
 	ListBlobsFlatSegment(ctx context.Context, marker azblob.Marker, o azblob.ListBlobsSegmentOptions) (*azblob.ListBlobsFlatSegmentResponse, error)
+	ListBlobsHierarchySegment(ctx context.Context, marker azblob.Marker, delimiter string, o azblob.ListBlobsSegmentOptions) (*azblob.ListBlobsHierarchySegmentResponse, error)
 	NewBlockBlobURL(string) AzblobBlockBlobUrlIface
 }
 
@@ -42,8 +43,11 @@ type AzblobBlockBlobUrlIface interface {
 	Url() url.URL
 	Download(context.Context, int64, int64, azblob.BlobAccessConditions, bool, azblob.ClientProvidedKeyOptions) (AzblobDownloadResponse, error)
 	Upload(context.Context, io.ReadSeeker, azblob.BlobHTTPHeaders, azblob.Metadata, azblob.BlobAccessConditions, azblob.AccessTierType, azblob.BlobTagsMap, azblob.ClientProvidedKeyOptions) (*azblob.BlockBlobUploadResponse, error)
+	StageBlock(context.Context, string, io.ReadSeeker, azblob.LeaseAccessConditions, []byte, azblob.ClientProvidedKeyOptions) (*azblob.BlockBlobStageBlockResponse, error)
+	CommitBlockList(context.Context, []string, azblob.BlobHTTPHeaders, azblob.Metadata, azblob.BlobAccessConditions, azblob.AccessTierType, azblob.BlobTagsMap, azblob.ClientProvidedKeyOptions) (*azblob.BlockBlobCommitBlockListResponse, error)
 	Delete(context.Context, azblob.DeleteSnapshotsOptionType, azblob.BlobAccessConditions) (*azblob.BlobDeleteResponse, error)
 	GetProperties(context.Context, azblob.BlobAccessConditions, azblob.ClientProvidedKeyOptions) (*azblob.BlobGetPropertiesResponse, error)
+	StartCopyFromURL(context.Context, url.URL, azblob.Metadata, azblob.ModifiedAccessConditions, azblob.BlobAccessConditions, azblob.AccessTierType, azblob.BlobTagsMap) (AzblobCopyResponse, error)
 }
 
 // AzblobDownloadResponse - Mockable client interface
@@ -51,3 +55,9 @@ type AzblobBlockBlobUrlIface interface {
 type AzblobDownloadResponse interface {
 	Body(azblob.RetryReaderOptions) io.ReadCloser
 }
+
+// AzblobCopyResponse - Mockable client interface
+// for azblob.BlobStartCopyFromURLResponse
+type AzblobCopyResponse interface {
+	CopyStatus() azblob.CopyStatusType
+}
@@ -18,6 +18,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"maps"
 	"time"
 
 	"github.com/Azure/azure-sdk-for-go/services/eventgrid/2018-01-01/eventgrid"
@@ -29,8 +30,11 @@ import (
 	"google.golang.org/protobuf/proto"
 
 	"github.com/nitrictech/nitric/cloud/azure/runtime/resource"
+	azureutils "github.com/nitrictech/nitric/cloud/azure/runtime/utils"
 	grpc_errors "github.com/nitrictech/nitric/core/pkg/grpc/errors"
 	topicpb "github.com/nitrictech/nitric/core/pkg/proto/topics/v1"
+	"github.com/nitrictech/nitric/core/pkg/tracing"
+	"github.com/nitrictech/nitric/core/pkg/workers/topics"
 )
 
 type EventGridEventService struct {
@@ -40,21 +44,42 @@ type EventGridEventService struct {
 
 var _ topicpb.TopicsServer = &EventGridEventService{}
 
-func (s *EventGridEventService) nitricEventToAzureEvent(topic string, payload *topicpb.TopicMessage) (*eventgrid.Event, error) {
+// EventData is the data of events published with attributes,
+// Event Grid schema events can't have properties outside of their data
+type EventData struct {
+	// Payload is the encoded nitric message
+	Payload    []byte            `json:"payload"`
+	Attributes map[string]string `json:"attributes"`
+}
+
+func (s *EventGridEventService) nitricEventToAzureEvent(ctx context.Context, topic string, req *topicpb.TopicPublishRequest) (*eventgrid.Event, error) {
 	dataVersion := "1.0"
 	eventType := "nitric"
 
 	uid := uuid.New()
 	id := uid.String()
 
-	msgBytes, err := proto.Marshal(payload)
+	msgBytes, err := proto.Marshal(req.Message)
 	if err != nil {
 		return nil, fmt.Errorf("error marshalling event payload, this is a bug in nitric. %w", err)
 	}
 
+	var data interface{} = msgBytes
+
+	// the trace context of the publisher is sent with the attributes, so subscribers continue its trace
+	attributes := topics.PublishAttributes(req)
+	maps.Copy(attributes, tracing.Inject(ctx))
+
+	if len(attributes) > 0 {
+		data = EventData{
+			Payload:    msgBytes,
+			Attributes: attributes,
+		}
+	}
+
 	return &eventgrid.Event{
 		ID:          &id,
-		Data:        msgBytes,
+		Data:        data,
 		EventType:   &eventType,
 		Subject:     &topic,
 		EventTime:   &date.Time{Time: time.Now()},
@@ -69,6 +94,10 @@ func (s *EventGridEventService) Publish(ctx context.Context, req *topicpb.TopicP
 		return nil, newErr(codes.Unimplemented, "delayed messages with eventgrid are unsupported", nil)
 	}
 
+	if req.OrderingKey != "" {
+		return nil, newErr(codes.Unimplemented, "ordered messages with eventgrid are unsupported", nil)
+	}
+
 	topics, err := s.provider.GetResources(ctx, resource.AzResource_Topic)
 	if err != nil {
 		return nil, newErr(
@@ -89,7 +118,7 @@ func (s *EventGridEventService) Publish(ctx context.Context, req *topicpb.TopicP
 
 	topicHostName := fmt.Sprintf("%s.%s-1.eventgrid.azure.net", t.Name, t.Location)
 
-	eventToPublish, err := s.nitricEventToAzureEvent(topicHostName, req.Message)
+	eventToPublish, err := s.nitricEventToAzureEvent(ctx, topicHostName, req)
 	if err != nil {
 		return nil, newErr(
 			codes.Internal,
@@ -127,6 +156,7 @@ func New(provider resource.AzResourceResolver) (*EventGridEventService, error) {
 
 	client := eventgrid.New()
 	client.Authorizer = autorest.NewBearerAuthorizer(spt)
+	client.Sender = azureutils.TracedHttpClient()
 
 	return &EventGridEventService{
 		provider: provider,
@@ -68,6 +68,25 @@ var _ = Describe("Event Grid Plugin", func() {
 			})
 		})
 
+		When("publishing with an ordering key", func() {
+			ctrl := gomock.NewController(GinkgoT())
+			eventgridClient := mock_eventgrid.NewMockBaseClientAPI(ctrl)
+			mockProvider := mock_provider.NewMockAzResourceResolver(ctrl)
+			eventgridPlugin, _ := eventgrid_service.NewWithClient(mockProvider, eventgridClient)
+
+			It("should return an unimplemented error", func() {
+				_, err := eventgridPlugin.Publish(context.TODO(), &topicpb.TopicPublishRequest{
+					TopicName:   "Test",
+					Message:     eventPayload,
+					OrderingKey: "order-1",
+				})
+				Expect(err).Should(HaveOccurred())
+				Expect(err.Error()).To(ContainSubstring("Unimplemented"))
+
+				ctrl.Finish()
+			})
+		})
+
 		When("publishing to a topic that is unauthorised", func() {
 			ctrl := gomock.NewController(GinkgoT())
 			eventgridClient := mock_eventgrid.NewMockBaseClientAPI(ctrl)
@@ -90,9 +90,10 @@ func (*NitricDefaultOrder) Order(resources []*deploymentspb.Resource) []*deploym
 		resourcespb.ResourceType_Batch,
 		resourcespb.ResourceType_Service,
 		resourcespb.ResourceType_Secret,
+		// queues and buckets may be dead-letter destinations for topic subscriptions
 		resourcespb.ResourceType_Queue,
-		resourcespb.ResourceType_Topic,
 		resourcespb.ResourceType_Bucket,
+		resourcespb.ResourceType_Topic,
 		resourcespb.ResourceType_KeyValueStore,
 		resourcespb.ResourceType_Api,
 		resourcespb.ResourceType_Websocket,
@@ -104,8 +105,58 @@ func (*NitricDefaultOrder) Order(resources []*deploymentspb.Resource) []*deploym
 
 	sorted := []*deploymentspb.Resource{}
 	for _, resourceType := range typeOrder {
+		if resourceType == resourcespb.ResourceType_Topic {
+			sorted = append(sorted, orderTopics(just(resources, resourceType))...)
+			continue
+		}
+
 		sorted = append(sorted, just(resources, resourceType)...)
 	}
 
 	return sorted
 }
+
+// orderTopics orders topics so that topics used as dead-letter destinations are deployed before the topics that reference them
+func orderTopics(topics []*deploymentspb.Resource) []*deploymentspb.Resource {
+	pending := map[string]bool{}
+	for _, topic := range topics {
+		pending[topic.Id.Name] = true
+	}
+
+	// ready returns true once every topic the given topic dead-letters to has been ordered
+	ready := func(topic *deploymentspb.Resource) bool {
+		for _, sub := range topic.GetTopic().GetSubscriptions() {
+			deadLetterTopic := sub.GetDeadLetter().GetTopic()
+			if deadLetterTopic != topic.Id.Name && pending[deadLetterTopic] {
+				return false
+			}
+		}
+
+		return true
+	}
+
+	sorted := make([]*deploymentspb.Resource, 0, len(topics))
+	for len(sorted) < len(topics) {
+		progressed := false
+
+		for _, topic := range topics {
+			if !pending[topic.Id.Name] || !ready(topic) {
+				continue
+			}
+
+			sorted = append(sorted, topic)
+			delete(pending, topic.Id.Name)
+			progressed = true
+		}
+
+		// dead-letter cycles can't be ordered, deploy the remaining topics in their original order
+		if !progressed {
+			sorted = append(sorted, lo.Filter(topics, func(topic *deploymentspb.Resource, _ int) bool {
+				return pending[topic.Id.Name]
+			})...)
+			break
+		}
+	}
+
+	return sorted
+}
@@ -0,0 +1,58 @@
+// Copyright 2021 Nitric Technologies Pty Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package batch
+
+import (
+	"encoding/base64"
+
+	batchpb "github.com/nitrictech/nitric/core/pkg/proto/batch/v1"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+)
+
+// EncodeJobData encodes job data for the NITRIC_JOB_DATA environment variable of a submitted job.
+// The data is encoded as base64 protobuf so raw payloads are passed to the job as is.
+func EncodeJobData(data *batchpb.JobData) (string, error) {
+	dataBytes, err := proto.Marshal(data)
+	if err != nil {
+		return "", err
+	}
+
+	return base64.StdEncoding.EncodeToString(dataBytes), nil
+}
+
+// DecodeJobData decodes job data from the NITRIC_JOB_DATA environment variable,
+// job data encoded as JSON by earlier versions is also accepted.
+func DecodeJobData(encoded string) (*batchpb.JobData, error) {
+	data := &batchpb.JobData{}
+
+	dataBytes, err := base64.StdEncoding.DecodeString(encoded)
+	if err != nil {
+		// not base64, so the job was submitted with JSON job data
+		err = protojson.Unmarshal([]byte(encoded), data)
+		if err != nil {
+			return nil, err
+		}
+
+		return data, nil
+	}
+
+	err = proto.Unmarshal(dataBytes, data)
+	if err != nil {
+		return nil, err
+	}
+
+	return data, nil
+}
@@ -28,5 +28,5 @@ var GATEWAY_ADDRESS = env.GetEnv("GATEWAY_ADDRESS", ":9001")
 // Job execution mode
 var NITRIC_JOB_NAME = env.GetEnv("NITRIC_JOB_NAME", "")
 
-// Job data as JSON
+// Job data as base64 encoded protobuf
 var NITRIC_JOB_DATA = env.GetEnv("NITRIC_JOB_DATA", "{}")
@@ -15,8 +15,12 @@
 package base_http
 
 import (
+	"bytes"
 	"context"
+	"errors"
 	"fmt"
+	"io"
+	"net"
 	"strings"
 	"time"
 
@@ -24,11 +28,15 @@ import (
 	"github.com/valyala/fasthttp"
 	"go.opentelemetry.io/otel"
 	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 
 	"github.com/nitrictech/nitric/cloud/common/runtime/env"
 	"github.com/nitrictech/nitric/core/pkg/gateway"
 	"github.com/nitrictech/nitric/core/pkg/logger"
 	apispb "github.com/nitrictech/nitric/core/pkg/proto/apis/v1"
+	"github.com/nitrictech/nitric/core/pkg/tracing"
+	"github.com/nitrictech/nitric/core/pkg/workers/apis"
 )
 
 type (
@@ -45,6 +53,38 @@ const (
 	DefaultBucketNotificationRoute = "/x-nitric-notification/bucket/{name}"
 )
 
+const (
+	apiRoutePrefix = "/x-nitric-api/"
+	// maxBufferedBodySize is the size limit of request bodies that are buffered in full, rather than streamed to workers
+	maxBufferedBodySize = fasthttp.DefaultMaxRequestBodySize
+)
+
+// bufferRequestBodies reads streamed request bodies in full for every route except API routes,
+// so only API workers that accept streamed bodies receive bodies larger than maxBufferedBodySize.
+func bufferRequestBodies(next fasthttp.RequestHandler) fasthttp.RequestHandler {
+	return func(rc *fasthttp.RequestCtx) {
+		stream := rc.RequestBodyStream()
+		if stream == nil || strings.HasPrefix(string(rc.Path()), apiRoutePrefix) {
+			next(rc)
+			return
+		}
+
+		body, err := io.ReadAll(io.LimitReader(stream, maxBufferedBodySize+1))
+		if err != nil {
+			rc.Error("Bad Request", 400)
+			return
+		}
+
+		if len(body) > maxBufferedBodySize {
+			rc.Error("Request Entity Too Large", 413)
+			return
+		}
+
+		rc.Request.SetBody(body)
+		next(rc)
+	}
+}
+
 type HttpGatewayOptions struct {
 	RouteRegistrationHook RouterRegistrationCallback
 }
@@ -119,34 +159,68 @@ func (s *HttpGateway) newApiHandler(opts *gateway.GatewayStartOpts, apiNameParam
 					Path:        originalPath,
 					Headers:     headers,
 					QueryParams: query,
-					Body:        rc.Request.Body(),
 				},
 			},
 		}
 
-		resp, err := opts.ApiPlugin.HandleRequest(apiName, httpTrigger)
+		// large request bodies are streamed to workers that accept streamed bodies, rather than buffered in full
+		var body io.Reader = bytes.NewReader(rc.Request.Body())
+		if stream := rc.RequestBodyStream(); stream != nil {
+			body = &apis.LimitedBody{Reader: stream, MaxBufferedSize: maxBufferedBodySize}
+		}
+
+		// continue the trace of the incoming request, if it has one
+		ctx := tracing.ExtractHeaders(rc, headerMap)
+
+		http, responseBody, err := opts.ApiPlugin.HandleStreamingRequest(ctx, apiName, httpTrigger, body)
 		if err != nil {
+			if status.Code(err) == codes.DeadlineExceeded {
+				rc.Error("Gateway Timeout", 504)
+				return
+			}
+
+			var methodNotAllowed *apis.MethodNotAllowedError
+			if errors.As(err, &methodNotAllowed) {
+				rc.Response.Header.Set("Allow", strings.Join(methodNotAllowed.AllowedMethods, ", "))
+				rc.Error("Method Not Allowed", 405)
+				return
+			}
+
+			var bodyTooLarge *apis.RequestBodyTooLargeError
+			if errors.As(err, &bodyTooLarge) {
+				rc.Error("Request Entity Too Large", 413)
+				return
+			}
+
+			var routeNotFound *apis.RouteNotFoundError
+			if errors.As(err, &routeNotFound) {
+				rc.Error("Not Found", 404)
+				return
+			}
+
 			rc.Error("Unable to get worker to handle request", 500)
 			return
 		}
 
-		if http := resp.GetHttpResponse(); http != nil {
-			// Copy headers across
-			for k, v := range http.Headers {
-				for _, val := range v.Value {
-					rc.Response.Header.Add(k, val)
-				}
+		// Copy headers across
+		for k, v := range http.Headers {
+			for _, val := range v.Value {
+				rc.Response.Header.Add(k, val)
 			}
+		}
 
-			// Avoid content length header duplication
-			rc.Response.Header.Del("Content-Length")
-			rc.Response.SetStatusCode(int(http.Status))
-			rc.Response.SetBody(resp.GetHttpResponse().Body)
+		// Avoid content length header duplication
+		rc.Response.Header.Del("Content-Length")
+		rc.Response.SetStatusCode(int(http.Status))
 
+		if !http.StreamedBody {
+			_ = responseBody.Close()
+			rc.Response.SetBody(http.Body)
 			return
 		}
 
-		rc.Error("received invalid response type from worker", 500)
+		// the response body is closed by fasthttp once it has been written
+		rc.Response.SetBodyStream(responseBody, -1)
 	}
 }
 
@@ -202,7 +276,7 @@ func (s *HttpGateway) Start(opts *gateway.GatewayStartOpts) error {
 	// also capture the original path so it can be passed to the worker without the name prefix.
 	const apiNameParam = "apiName"
 	const originalPathParam = "path"
-	r.ANY(fmt.Sprintf("/x-nitric-api/{%s}/{%s?:*}", apiNameParam, originalPathParam), s.newApiHandler(opts, apiNameParam, originalPathParam))
+	r.ANY(fmt.Sprintf("%s{%s}/{%s?:*}", apiRoutePrefix, apiNameParam, originalPathParam), s.newApiHandler(opts, apiNameParam, originalPathParam))
 	// }
 
 	// proxy to http if available
@@ -215,25 +289,41 @@ func (s *HttpGateway) Start(opts *gateway.GatewayStartOpts) error {
 	s.server = &fasthttp.Server{
 		IdleTimeout:     time.Second * 1,
 		CloseOnShutdown: true,
-		Handler:         r.Handler,
+		Handler:         bufferRequestBodies(r.Handler),
 		ReadBufferSize:  8192,
+		// fasthttp only supports streaming request bodies for the whole server,
+		// bodies are buffered for every route except API routes, see bufferRequestBodies
+		StreamRequestBody:  true,
+		MaxRequestBodySize: maxBufferedBodySize,
 	}
 
-	return s.server.ListenAndServe(s.address)
+	// Listen before serving so the gateway is only reported as started once it can accept requests
+	ln, err := net.Listen("tcp4", s.address)
+	if err != nil {
+		return err
+	}
+
+	opts.Started()
+
+	return s.server.Serve(ln)
 }
 
 // Stop the HTTP gateway server
 func (s *HttpGateway) Stop() error {
+	var err error
+
+	// Shutdown waits for the requests being handled to complete, so their traces are flushed after it returns
+	if s.server != nil {
+		err = s.server.Shutdown()
+	}
+
 	tp, ok := otel.GetTracerProvider().(*sdktrace.TracerProvider)
 	if ok {
 		_ = tp.ForceFlush(context.TODO())
 		_ = tp.Shutdown(context.TODO())
 	}
 
-	if s.server != nil {
-		return s.server.Shutdown()
-	}
-	return nil
+	return err
 }
 
 // Create new HTTP gateway
@@ -15,13 +15,14 @@
 package jobs
 
 import (
+	"context"
 	"fmt"
 	"log"
 
+	"github.com/nitrictech/nitric/cloud/common/runtime/batch"
 	"github.com/nitrictech/nitric/cloud/common/runtime/env"
 	"github.com/nitrictech/nitric/core/pkg/gateway"
 	batchpb "github.com/nitrictech/nitric/core/pkg/proto/batch/v1"
-	"google.golang.org/protobuf/encoding/protojson"
 )
 
 type DefaultBatchGateway struct {
@@ -30,19 +31,18 @@ type DefaultBatchGateway struct {
 
 func (s *DefaultBatchGateway) Start(opts *gateway.GatewayStartOpts) error {
 	// all of our workers should be available now to process jobs
+	opts.Started()
 
 	jobName := env.NITRIC_JOB_NAME.String()
 	jobData := env.NITRIC_JOB_DATA.String()
 
-	jobDataProto := &batchpb.JobData{}
-
-	err := protojson.Unmarshal([]byte(jobData), jobDataProto)
+	jobDataProto, err := batch.DecodeJobData(jobData)
 	if err != nil {
 		return fmt.Errorf("unable to unmarshal job data: %w", err)
 	}
 
 	// construct the job event
-	response, err := opts.JobHandlerPlugin.HandleJobRequest(&batchpb.ServerMessage{
+	response, err := opts.JobHandlerPlugin.HandleJobRequest(context.Background(), &batchpb.ServerMessage{
 		Content: &batchpb.ServerMessage_JobRequest{
 			JobRequest: &batchpb.JobRequest{
 				JobName: jobName,
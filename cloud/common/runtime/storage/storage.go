@@ -31,3 +31,12 @@ func DetectContentType(filename string, content []byte) string {
 
 	return http.DetectContentType(content)
 }
+
+// ContentType returns the requested content type of an item, or detects it from the item's filename and content when none was requested
+func ContentType(requested string, filename string, content []byte) string {
+	if requested != "" {
+		return requested
+	}
+
+	return DetectContentType(filename, content)
+}
@@ -0,0 +1,177 @@
+// Copyright 2021 Nitric Technologies Pty Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package storage
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	storagepb "github.com/nitrictech/nitric/core/pkg/proto/storage/v1"
+)
+
+// ChunkSize is the maximum size of the chunks streamed reads are sent in, well under the default gRPC message size limit
+const ChunkSize = 1024 * 1024
+
+// SendChunks reads r to its end, sending its contents to a streamed read in chunks of up to ChunkSize
+func SendChunks(r io.Reader, stream storagepb.Storage_ReadStreamServer) error {
+	buf := make([]byte, ChunkSize)
+
+	for {
+		n, err := io.ReadFull(r, buf)
+		if n > 0 {
+			if sendErr := stream.Send(&storagepb.StorageReadStreamResponse{Chunk: buf[:n]}); sendErr != nil {
+				return sendErr
+			}
+		}
+
+		if errors.Is(err, io.EOF) || errors.Is(err, io.ErrUnexpectedEOF) {
+			return nil
+		}
+
+		if err != nil {
+			return err
+		}
+	}
+}
+
+// ByteRange returns the HTTP range header value for a streamed read, it's empty when the whole item is read
+func ByteRange(offset int64, length int64) string {
+	if length > 0 {
+		return fmt.Sprintf("bytes=%d-%d", offset, offset+length-1)
+	}
+
+	if offset > 0 {
+		return fmt.Sprintf("bytes=%d-", offset)
+	}
+
+	return ""
+}
+
+// chunkReader reads the chunks that follow the header of a streamed write
+type chunkReader struct {
+	stream storagepb.Storage_WriteStreamServer
+	chunk  []byte
+	size   int64
+	done   bool
+	// the error receiving the stream, if it failed
+	err error
+}
+
+func (c *chunkReader) Read(p []byte) (int, error) {
+	for len(c.chunk) == 0 {
+		// errors are sticky, the buffered reader only reports an error once, so one hit by a peek would otherwise be lost
+		if c.err != nil {
+			return 0, c.err
+		}
+
+		if c.done {
+			return 0, io.EOF
+		}
+
+		msg, err := c.stream.Recv()
+		if errors.Is(err, io.EOF) {
+			c.done = true
+			return 0, io.EOF
+		}
+
+		if err != nil {
+			c.err = err
+			return 0, err
+		}
+
+		if msg.GetHeader() != nil {
+			c.err = status.Error(codes.InvalidArgument, "write stream header can only be sent as the first message")
+			return 0, c.err
+		}
+
+		c.chunk = msg.GetChunk()
+	}
+
+	n := copy(p, c.chunk)
+	c.chunk = c.chunk[n:]
+	c.size += int64(n)
+
+	return n, nil
+}
+
+// WriteStream is a streamed write, read the item to store from it after its header
+type WriteStream struct {
+	Header *storagepb.StorageWriteStreamHeader
+
+	chunks *chunkReader
+	reader *bufio.Reader
+}
+
+func (w *WriteStream) Read(p []byte) (int, error) {
+	return w.reader.Read(p)
+}
+
+// Err returns the error receiving the stream, nil unless reading it failed because of the stream itself,
+// e.g. an InvalidArgument status when a header follows the chunks. Use status.Code for its gRPC code.
+func (w *WriteStream) Err() error {
+	return w.chunks.err
+}
+
+// ErrorCode returns the gRPC status code of an error reading a WriteStream, or fallback when it has none
+func ErrorCode(err error, fallback codes.Code) codes.Code {
+	if s, ok := status.FromError(err); ok {
+		return s.Code()
+	}
+
+	return fallback
+}
+
+// Size returns the number of bytes read from the stream so far
+func (w *WriteStream) Size() int64 {
+	return w.chunks.size - int64(w.reader.Buffered())
+}
+
+// ContentType returns the requested content type of the item, or detects it from its key or first bytes without consuming them
+func (w *WriteStream) ContentType() string {
+	if w.Header.GetContentType() != "" {
+		return w.Header.GetContentType()
+	}
+
+	// http.DetectContentType considers at most the first 512 bytes
+	head, _ := w.reader.Peek(512)
+
+	return DetectContentType(w.Header.GetKey(), head)
+}
+
+// ReceiveWriteStream receives the header of a streamed write
+func ReceiveWriteStream(stream storagepb.Storage_WriteStreamServer) (*WriteStream, error) {
+	msg, err := stream.Recv()
+	if err != nil {
+		return nil, err
+	}
+
+	header := msg.GetHeader()
+	if header == nil {
+		return nil, status.Error(codes.InvalidArgument, "write stream must start with a header")
+	}
+
+	chunks := &chunkReader{stream: stream}
+
+	return &WriteStream{
+		Header: header,
+		chunks: chunks,
+		reader: bufio.NewReaderSize(chunks, ChunkSize),
+	}, nil
+}
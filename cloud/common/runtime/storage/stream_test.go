@@ -0,0 +1,196 @@
+// Copyright 2021 Nitric Technologies Pty Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package storage_test
+
+import (
+	"bytes"
+	"io"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/nitrictech/nitric/cloud/common/runtime/storage"
+	storagepb "github.com/nitrictech/nitric/core/pkg/proto/storage/v1"
+)
+
+type readStream struct {
+	grpc.ServerStream
+	chunks [][]byte
+}
+
+func (r *readStream) Send(resp *storagepb.StorageReadStreamResponse) error {
+	r.chunks = append(r.chunks, append([]byte{}, resp.Chunk...))
+	return nil
+}
+
+type writeStream struct {
+	grpc.ServerStream
+	requests []*storagepb.StorageWriteStreamRequest
+}
+
+func (w *writeStream) Recv() (*storagepb.StorageWriteStreamRequest, error) {
+	if len(w.requests) == 0 {
+		return nil, io.EOF
+	}
+
+	req := w.requests[0]
+	w.requests = w.requests[1:]
+
+	return req, nil
+}
+
+func (w *writeStream) SendAndClose(*storagepb.StorageWriteStreamResponse) error {
+	return nil
+}
+
+func header(key string) *storagepb.StorageWriteStreamRequest {
+	return &storagepb.StorageWriteStreamRequest{
+		Content: &storagepb.StorageWriteStreamRequest_Header{
+			Header: &storagepb.StorageWriteStreamHeader{BucketName: "bucket", Key: key},
+		},
+	}
+}
+
+func chunk(b []byte) *storagepb.StorageWriteStreamRequest {
+	return &storagepb.StorageWriteStreamRequest{
+		Content: &storagepb.StorageWriteStreamRequest_Chunk{Chunk: b},
+	}
+}
+
+var _ = Describe("Streaming", func() {
+	Context("SendChunks", func() {
+		When("the content is larger than a chunk", func() {
+			It("should send it in chunks", func() {
+				content := bytes.Repeat([]byte("a"), storage.ChunkSize+10)
+				stream := &readStream{}
+
+				Expect(storage.SendChunks(bytes.NewReader(content), stream)).To(Succeed())
+
+				Expect(stream.chunks).To(HaveLen(2))
+				Expect(stream.chunks[0]).To(HaveLen(storage.ChunkSize))
+				Expect(bytes.Join(stream.chunks, nil)).To(Equal(content))
+			})
+		})
+
+		When("the content is empty", func() {
+			It("should send nothing", func() {
+				stream := &readStream{}
+
+				Expect(storage.SendChunks(bytes.NewReader(nil), stream)).To(Succeed())
+				Expect(stream.chunks).To(BeEmpty())
+			})
+		})
+	})
+
+	Context("ByteRange", func() {
+		It("should return the range of the requested bytes", func() {
+			Expect(storage.ByteRange(0, 0)).To(Equal(""))
+			Expect(storage.ByteRange(10, 0)).To(Equal("bytes=10-"))
+			Expect(storage.ByteRange(10, 5)).To(Equal("bytes=10-14"))
+		})
+	})
+
+	Context("ReceiveWriteStream", func() {
+		When("the stream starts with a header", func() {
+			It("should read the chunks that follow it", func() {
+				stream, err := storage.ReceiveWriteStream(&writeStream{
+					requests: []*storagepb.StorageWriteStreamRequest{
+						header("test.txt"),
+						chunk([]byte("hello ")),
+						chunk([]byte("world")),
+					},
+				})
+				Expect(err).ToNot(HaveOccurred())
+				Expect(stream.Header.Key).To(Equal("test.txt"))
+				Expect(stream.ContentType()).To(HavePrefix("text/plain"))
+
+				content, err := io.ReadAll(stream)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(string(content)).To(Equal("hello world"))
+				Expect(stream.Size()).To(Equal(int64(11)))
+			})
+		})
+
+		When("the header has a content type", func() {
+			It("should use it instead of detecting one", func() {
+				req := header("test.txt")
+				req.GetHeader().ContentType = "application/json"
+
+				stream, err := storage.ReceiveWriteStream(&writeStream{
+					requests: []*storagepb.StorageWriteStreamRequest{req, chunk([]byte("{}"))},
+				})
+				Expect(err).ToNot(HaveOccurred())
+				Expect(stream.ContentType()).To(Equal("application/json"))
+			})
+		})
+
+		When("the stream doesn't start with a header", func() {
+			It("should return an error", func() {
+				_, err := storage.ReceiveWriteStream(&writeStream{
+					requests: []*storagepb.StorageWriteStreamRequest{chunk([]byte("hello"))},
+				})
+				Expect(err).To(MatchError(ContainSubstring("must start with a header")))
+			})
+		})
+
+		When("a header follows the chunks", func() {
+			It("should return an error reading them", func() {
+				stream, err := storage.ReceiveWriteStream(&writeStream{
+					requests: []*storagepb.StorageWriteStreamRequest{
+						header("test.txt"),
+						chunk([]byte("hello")),
+						header("other.txt"),
+					},
+				})
+				Expect(err).ToNot(HaveOccurred())
+
+				_, err = io.ReadAll(stream)
+				Expect(err).To(MatchError(ContainSubstring("first message")))
+				Expect(status.Code(err)).To(Equal(codes.InvalidArgument))
+				Expect(stream.Err()).To(Equal(err))
+			})
+
+			It("should still return the error after detecting the content type", func() {
+				stream, err := storage.ReceiveWriteStream(&writeStream{
+					requests: []*storagepb.StorageWriteStreamRequest{
+						header("test.txt"),
+						chunk([]byte("hello")),
+						header("other.txt"),
+					},
+				})
+				Expect(err).ToNot(HaveOccurred())
+
+				stream.ContentType()
+
+				_, err = io.ReadAll(stream)
+				Expect(status.Code(err)).To(Equal(codes.InvalidArgument))
+			})
+		})
+	})
+
+	Describe("ErrorCode", func() {
+		It("should return the status code of the error", func() {
+			err := status.Error(codes.InvalidArgument, "bad stream")
+			Expect(storage.ErrorCode(err, codes.Unknown)).To(Equal(codes.InvalidArgument))
+		})
+
+		It("should return the fallback for errors without a status", func() {
+			Expect(storage.ErrorCode(io.ErrClosedPipe, codes.Internal)).To(Equal(codes.Internal))
+		})
+	})
+})
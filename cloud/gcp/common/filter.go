@@ -0,0 +1,80 @@
+// Copyright Nitric Pty Ltd.
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at:
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package common
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+
+	topicspb "github.com/nitrictech/nitric/core/pkg/proto/topics/v1"
+	"github.com/nitrictech/nitric/core/pkg/workers/topics"
+)
+
+var unquotedAttributeName = regexp.MustCompile(`^[a-zA-Z_][a-zA-Z0-9_]*$`)
+
+// filterAttribute returns the attribute reference for a Pub/Sub filter, quoting names that aren't identifiers
+func filterAttribute(name string, operator string) string {
+	if unquotedAttributeName.MatchString(name) {
+		return "attributes" + operator + name
+	}
+
+	return "attributes" + operator + strconv.Quote(name)
+}
+
+// SubscriptionFilter converts a subscription filter to a Pub/Sub subscription filter expression
+func SubscriptionFilter(filter *topicspb.SubscriptionFilter) (string, error) {
+	if err := topics.ValidateFilter(filter); err != nil {
+		return "", err
+	}
+
+	expressions := []string{}
+
+	for _, attributeFilter := range filter.GetAttributes() {
+		name := attributeFilter.GetName()
+		value := filterAttribute(name, ".")
+
+		switch condition := attributeFilter.GetCondition().(type) {
+		case *topicspb.AttributeFilter_AnyOf:
+			values := []string{}
+			for _, v := range condition.AnyOf.GetValues() {
+				values = append(values, fmt.Sprintf("%s = %s", value, strconv.Quote(v)))
+			}
+
+			expressions = append(expressions, "("+strings.Join(values, " OR ")+")")
+		case *topicspb.AttributeFilter_NoneOf:
+			values := []string{}
+			for _, v := range condition.NoneOf.GetValues() {
+				values = append(values, fmt.Sprintf("%s != %s", value, strconv.Quote(v)))
+			}
+
+			// != matches messages without the attribute, so require it to be present
+			expressions = append(expressions, "("+filterAttribute(name, ":")+" AND "+strings.Join(values, " AND ")+")")
+		case *topicspb.AttributeFilter_Prefix:
+			expressions = append(expressions, fmt.Sprintf("hasPrefix(%s, %s)", value, strconv.Quote(condition.Prefix)))
+		case *topicspb.AttributeFilter_Exists:
+			if condition.Exists {
+				expressions = append(expressions, filterAttribute(name, ":"))
+			} else {
+				expressions = append(expressions, "NOT "+filterAttribute(name, ":"))
+			}
+		}
+	}
+
+	return strings.Join(expressions, " AND "), nil
+}
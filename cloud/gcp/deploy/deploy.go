@@ -89,6 +89,7 @@ type NitricGcpPulumiProvider struct {
 	Topics                 map[string]*pubsub.Topic
 	Queues                 map[string]*pubsub.Topic
 	QueueSubscriptions     map[string]*pubsub.Subscription
+	DeadLetterPublishers   map[string]*pubsub.TopicIAMMember
 	Secrets                map[string]*secretmanager.Secret
 	DatabaseMigrationBuild map[string]*cloudrunv2.Job
 
@@ -469,6 +470,7 @@ func NewNitricGcpProvider() *NitricGcpPulumiProvider {
 		Topics:                 make(map[string]*pubsub.Topic),
 		Queues:                 make(map[string]*pubsub.Topic),
 		QueueSubscriptions:     make(map[string]*pubsub.Subscription),
+		DeadLetterPublishers:   make(map[string]*pubsub.TopicIAMMember),
 		Secrets:                make(map[string]*secretmanager.Secret),
 		DatabaseMigrationBuild: make(map[string]*cloudrunv2.Job),
 		WebsiteBuckets:         make(map[string]*storage.Bucket),
@@ -117,6 +117,12 @@ var gcpActionsMap map[v1.Action][]string = map[v1.Action][]string{
 	v1.Action_JobSubmit: {
 		"batch.jobs.create",
 	},
+	v1.Action_ScheduleManage: {
+		"cloudscheduler.jobs.get",
+		"cloudscheduler.jobs.run",
+		"cloudscheduler.jobs.pause",
+		"cloudscheduler.jobs.enable",
+	},
 }
 
 var collectionActions []string = nil
@@ -320,6 +326,21 @@ func (p *NitricGcpPulumiProvider) Policy(ctx *pulumi.Context, parent pulumi.Reso
 				if err != nil {
 					return err
 				}
+			case v1.ResourceType_Schedule:
+				// Cloud Scheduler jobs have no IAM policy of their own, so the role is granted on the project
+				// and conditioned on the full name of the job the schedule is deployed as
+				_, err = projects.NewIAMMember(ctx, memberName, &projects.IAMMemberArgs{
+					Member:  memberId,
+					Project: pulumi.String(p.GcpConfig.ProjectId),
+					Role:    rolePolicy.Name,
+					Condition: &projects.IAMMemberConditionArgs{
+						Title:      pulumi.Sprintf("%s-schedule", memberName),
+						Expression: pulumi.Sprintf("resource.name == \"projects/%s/locations/%s/jobs/%s\"", p.GcpConfig.ProjectId, p.Region, resource.Id.Name),
+					},
+				}, opts...)
+				if err != nil {
+					return err
+				}
 			}
 		}
 	}
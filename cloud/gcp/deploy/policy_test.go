@@ -0,0 +1,53 @@
+// Copyright Nitric Pty Ltd.
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at:
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package deploy
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	v1 "github.com/nitrictech/nitric/core/pkg/proto/resources/v1"
+)
+
+var _ = Describe("Policy", func() {
+	When("granting the actions required to copy a file", func() {
+		It("should allow the source file to be read", func() {
+			Expect(actionsToGcpActions([]v1.Action{v1.Action_BucketFileGet})).To(ContainElements(
+				"storage.objects.get",
+			))
+		})
+
+		It("should allow the destination file to be created or overwritten", func() {
+			Expect(actionsToGcpActions([]v1.Action{v1.Action_BucketFilePut})).To(ContainElements(
+				"storage.objects.create",
+				"storage.objects.delete",
+			))
+		})
+	})
+
+	When("granting the actions required to move a file", func() {
+		It("should allow the source file to be deleted", func() {
+			Expect(actionsToGcpActions([]v1.Action{
+				v1.Action_BucketFileGet,
+				v1.Action_BucketFileDelete,
+			})).To(ContainElements(
+				"storage.objects.get",
+				"storage.objects.delete",
+			))
+		})
+	})
+})
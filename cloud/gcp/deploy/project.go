@@ -15,6 +15,8 @@
 package deploy
 
 import (
+	"fmt"
+
 	"github.com/pulumi/pulumi-gcp/sdk/v8/go/gcp/projects"
 	"github.com/pulumi/pulumi/sdk/v3/go/pulumi"
 )
@@ -22,8 +24,9 @@ import (
 type Project struct {
 	pulumi.ResourceState
 
-	Name     string
-	Services []*projects.Service
+	Name          string
+	ProjectNumber string
+	Services      []*projects.Service
 }
 
 type ProjectArgs struct {
@@ -71,11 +74,18 @@ var requiredServices = []string{
 	"batch.googleapis.com",
 }
 
+// pubsubServiceAgent returns the IAM member of the google managed pubsub service account
+// services-{projectNumber}@gcp-sa-pubsub.iam.gserviceaccount.com
+func pubsubServiceAgent(projectNumber string) string {
+	return fmt.Sprintf("serviceAccount:service-%s@gcp-sa-pubsub.iam.gserviceaccount.com", projectNumber)
+}
+
 // Creates a new GCP Project
 func NewProject(ctx *pulumi.Context, name string, args *ProjectArgs, opts ...pulumi.ResourceOption) (*Project, error) {
 	res := &Project{
-		Name:     name,
-		Services: []*projects.Service{},
+		Name:          name,
+		ProjectNumber: args.ProjectNumber,
+		Services:      []*projects.Service{},
 	}
 
 	err := ctx.RegisterComponentResource("ntiricgcp:project:GcpProject", name, res, opts...)
@@ -102,12 +112,9 @@ func NewProject(ctx *pulumi.Context, name string, args *ProjectArgs, opts ...pul
 	}
 
 	// Add ServiceAccount Token Creator Role to the default pubsub gservice account
-	// services-{projectNumber}@gcp-sa-pubsub.iam.gserviceaccount.com
-	serviceAccount := pulumi.Sprintf("serviceAccount:service-%s@gcp-sa-pubsub.iam.gserviceaccount.com", args.ProjectNumber)
-
 	_, err = projects.NewIAMMember(ctx, "pubsub-token-creator", &projects.IAMMemberArgs{
 		Role:    pulumi.String("roles/iam.serviceAccountTokenCreator"),
-		Member:  serviceAccount,
+		Member:  pulumi.String(pubsubServiceAgent(args.ProjectNumber)),
 		Project: pulumi.String(args.ProjectId),
 		// Only create this once the google managed service account is available
 	}, pulumi.Parent(res), pulumi.DependsOn(deps))
@@ -70,6 +70,8 @@ func (p *NitricGcpPulumiProvider) Schedule(ctx *pulumi.Context, parent pulumi.Re
 	payload := base64.StdEncoding.EncodeToString(eventJSON)
 
 	_, err = cloudscheduler.NewJob(ctx, name, &cloudscheduler.JobArgs{
+		// jobs are named after their schedule so they can be found by the runtime
+		Name:     pulumi.String(name),
 		TimeZone: pulumi.String(p.GcpConfig.ScheduleTimezone),
 		HttpTarget: &cloudscheduler.JobHttpTargetArgs{
 			Uri: pulumi.Sprintf("%s/x-nitric-schedule/%s?token=%s", targetService.Url, name, targetService.EventToken),
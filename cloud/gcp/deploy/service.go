@@ -142,6 +142,10 @@ func (p *NitricGcpPulumiProvider) Service(ctx *pulumi.Context, parent pulumi.Res
 			Name:  pulumi.String("NITRIC_STACK_ID"),
 			Value: pulumi.String(p.StackId),
 		},
+		cloudrunv2.ServiceTemplateContainerEnvArgs{
+			Name:  pulumi.String("NITRIC_SERVICE_NAME"),
+			Value: pulumi.String(name),
+		},
 		cloudrunv2.ServiceTemplateContainerEnvArgs{
 			Name:  pulumi.String("GOOGLE_PROJECT_ID"),
 			Value: pulumi.String(p.GcpConfig.ProjectId),
@@ -22,10 +22,12 @@ import (
 	pubsubv1 "cloud.google.com/go/pubsub/apiv1"
 	"cloud.google.com/go/pubsub/apiv1/pubsubpb"
 	"github.com/nitrictech/nitric/cloud/common/deploy/resources"
+	gcpcommon "github.com/nitrictech/nitric/cloud/gcp/common"
 	"google.golang.org/protobuf/types/known/fieldmaskpb"
 
 	common "github.com/nitrictech/nitric/cloud/common/deploy/tags"
 	deploymentspb "github.com/nitrictech/nitric/core/pkg/proto/deployments/v1"
+	topicspb "github.com/nitrictech/nitric/core/pkg/proto/topics/v1"
 	"github.com/pkg/errors"
 	"github.com/pulumi/pulumi-gcp/sdk/v8/go/gcp/pubsub"
 	"github.com/pulumi/pulumi/sdk/v3/go/pulumi"
@@ -74,6 +76,102 @@ func createTopic(ctx *pulumi.Context, name string, stackId string, tags map[stri
 	}, opts...)
 }
 
+const (
+	// Pub/Sub limits for subscription retry policies
+	defaultMaxDeliveryAttempts = 5
+	maxDeliveryAttempts        = 100
+	defaultMinBackoffSeconds   = 15
+	maxBackoffSeconds          = 600
+)
+
+// subscriptionRetryPolicy converts a subscription retry policy to a Pub/Sub retry policy
+func subscriptionRetryPolicy(policy *deploymentspb.SubscriptionRetryPolicy) (pubsub.SubscriptionRetryPolicyArgs, error) {
+	minBackoff := int32(defaultMinBackoffSeconds)
+	if policy.GetMinBackoffSeconds() > 0 {
+		minBackoff = policy.GetMinBackoffSeconds()
+	}
+
+	maxBackoff := int32(maxBackoffSeconds)
+	if policy.GetMaxBackoffSeconds() > 0 {
+		maxBackoff = policy.GetMaxBackoffSeconds()
+	}
+
+	if maxBackoff > maxBackoffSeconds {
+		return pubsub.SubscriptionRetryPolicyArgs{}, fmt.Errorf("max backoff of %d seconds exceeds the Pub/Sub limit of %d seconds", maxBackoff, maxBackoffSeconds)
+	}
+
+	if minBackoff > maxBackoff {
+		return pubsub.SubscriptionRetryPolicyArgs{}, fmt.Errorf("min backoff of %d seconds is greater than the max backoff of %d seconds", minBackoff, maxBackoff)
+	}
+
+	return pubsub.SubscriptionRetryPolicyArgs{
+		MinimumBackoff: pulumi.Sprintf("%ds", minBackoff),
+		MaximumBackoff: pulumi.Sprintf("%ds", maxBackoff),
+	}, nil
+}
+
+// subscriptionDeadLetterPolicy converts a subscription's dead-letter destination to a Pub/Sub dead letter policy,
+// granting the pubsub service account permission to publish to the destination. Returns nil if the subscription has no destination.
+func (p *NitricGcpPulumiProvider) subscriptionDeadLetterPolicy(ctx *pulumi.Context, sub *deploymentspb.SubscriptionTarget, opts []pulumi.ResourceOption) (*pubsub.SubscriptionDeadLetterPolicyArgs, error) {
+	var deadLetterTopic *pubsub.Topic
+	var destinationName string
+
+	switch destination := sub.GetDeadLetter().GetDestination().(type) {
+	case nil:
+		if sub.GetRetryPolicy().GetMaxAttempts() > 0 {
+			return nil, fmt.Errorf("Pub/Sub subscriptions require a dead-letter destination to limit delivery attempts")
+		}
+
+		return nil, nil
+	case *deploymentspb.DeadLetterDestination_Topic:
+		topic, ok := p.Topics[destination.Topic]
+		if !ok {
+			return nil, fmt.Errorf("unable to find dead-letter topic %s", destination.Topic)
+		}
+
+		deadLetterTopic = topic
+		destinationName = "topic-" + destination.Topic
+	case *deploymentspb.DeadLetterDestination_Queue:
+		queue, ok := p.Queues[destination.Queue]
+		if !ok {
+			return nil, fmt.Errorf("unable to find dead-letter queue %s", destination.Queue)
+		}
+
+		deadLetterTopic = queue
+		destinationName = "queue-" + destination.Queue
+	default:
+		return nil, fmt.Errorf("Pub/Sub subscriptions can only dead-letter to topics or queues")
+	}
+
+	maxAttempts := int32(defaultMaxDeliveryAttempts)
+	if sub.GetRetryPolicy().GetMaxAttempts() > 0 {
+		maxAttempts = sub.GetRetryPolicy().GetMaxAttempts()
+	}
+
+	if maxAttempts < defaultMaxDeliveryAttempts || maxAttempts > maxDeliveryAttempts {
+		return nil, fmt.Errorf("max attempts must be between %d and %d for Pub/Sub subscriptions with a dead-letter destination", defaultMaxDeliveryAttempts, maxDeliveryAttempts)
+	}
+
+	// granted once per destination, as removing a duplicate member would remove the shared binding
+	if _, ok := p.DeadLetterPublishers[destinationName]; !ok {
+		publisher, err := pubsub.NewTopicIAMMember(ctx, destinationName+"-dead-letter-publisher", &pubsub.TopicIAMMemberArgs{
+			Topic:  deadLetterTopic.Name,
+			Role:   pulumi.String("roles/pubsub.publisher"),
+			Member: pulumi.String(pubsubServiceAgent(p.Project.ProjectNumber)),
+		}, p.WithDefaultResourceOptions(opts...)...)
+		if err != nil {
+			return nil, err
+		}
+
+		p.DeadLetterPublishers[destinationName] = publisher
+	}
+
+	return &pubsub.SubscriptionDeadLetterPolicyArgs{
+		DeadLetterTopic:     deadLetterTopic.ID(),
+		MaxDeliveryAttempts: pulumi.Int(int(maxAttempts)),
+	}, nil
+}
+
 func GetSubName(serviceName string, topicName string) string {
 	return fmt.Sprintf("%s-%s-sub", serviceName, topicName)
 }
@@ -97,14 +195,24 @@ func (p *NitricGcpPulumiProvider) Topic(ctx *pulumi.Context, parent pulumi.Resou
 
 	for _, sub := range config.Subscriptions {
 		targetService := p.CloudRunServices[sub.GetService()]
+		subName := GetSubName(targetService.Name, name)
+
+		retryPolicy, err := subscriptionRetryPolicy(sub.GetRetryPolicy())
+		if err != nil {
+			return errors.WithMessage(err, "subscription "+subName)
+		}
 
-		_, err := pubsub.NewSubscription(ctx, GetSubName(targetService.Name, name), &pubsub.SubscriptionArgs{
+		deadLetterPolicy, err := p.subscriptionDeadLetterPolicy(ctx, sub, opts)
+		if err != nil {
+			return errors.WithMessage(err, "subscription "+subName)
+		}
+
+		subscriptionArgs := &pubsub.SubscriptionArgs{
 			Topic:              p.Topics[name].Name, // The GCP topic name
 			AckDeadlineSeconds: pulumi.Int(300),
-			RetryPolicy: pubsub.SubscriptionRetryPolicyArgs{
-				MinimumBackoff: pulumi.String("15s"),
-				MaximumBackoff: pulumi.String("600s"),
-			},
+			RetryPolicy:        retryPolicy,
+			// message ordering can't be changed on existing subscriptions, so it's only enabled for ordered topics
+			EnableMessageOrdering: pulumi.Bool(config.GetOrdered()),
 			PushConfig: pubsub.SubscriptionPushConfigArgs{
 				OidcToken: pubsub.SubscriptionPushConfigOidcTokenArgs{
 					ServiceAccountEmail: targetService.Invoker.Email,
@@ -115,10 +223,37 @@ func (p *NitricGcpPulumiProvider) Topic(ctx *pulumi.Context, parent pulumi.Resou
 			ExpirationPolicy: &pubsub.SubscriptionExpirationPolicyArgs{
 				Ttl: pulumi.String(""),
 			},
-		}, p.WithDefaultResourceOptions(opts...)...)
+		}
+
+		if deadLetterPolicy != nil {
+			subscriptionArgs.DeadLetterPolicy = deadLetterPolicy
+		}
+
+		if len(sub.GetFilter().GetAttributes()) > 0 {
+			filter, err := gcpcommon.SubscriptionFilter(sub.GetFilter())
+			if err != nil {
+				return errors.WithMessage(err, "subscription "+subName)
+			}
+
+			subscriptionArgs.Filter = pulumi.String(filter)
+		}
+
+		subscription, err := pubsub.NewSubscription(ctx, subName, subscriptionArgs, p.WithDefaultResourceOptions(opts...)...)
 		if err != nil {
 			return errors.WithMessage(err, "subscription "+name+"-sub")
 		}
+
+		if deadLetterPolicy != nil {
+			// the pubsub service account acknowledges dead-lettered messages on behalf of the subscription
+			_, err = pubsub.NewSubscriptionIAMMember(ctx, subName+"-dead-letter-subscriber", &pubsub.SubscriptionIAMMemberArgs{
+				Subscription: subscription.Name,
+				Role:         pulumi.String("roles/pubsub.subscriber"),
+				Member:       pulumi.String(pubsubServiceAgent(p.Project.ProjectNumber)),
+			}, p.WithDefaultResourceOptions(opts...)...)
+			if err != nil {
+				return errors.WithMessage(err, "subscription "+subName+" dead-letter subscriber")
+			}
+		}
 	}
 
 	return nil
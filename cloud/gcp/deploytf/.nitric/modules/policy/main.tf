@@ -1,12 +1,16 @@
 data "google_project" "project" {
 }
 
+data "google_client_config" "this" {
+}
+
 locals {
   is_bucket = var.resource_type == "Bucket"
   is_secret = var.resource_type == "Secret"
   is_kv = var.resource_type == "KeyValueStore"
   is_queue = var.resource_type == "Queue"
   is_topic = var.resource_type == "Topic"
+  is_schedule = var.resource_type == "Schedule"
 }
 
 # Apply the IAM policy to the resource
@@ -88,4 +92,17 @@ resource "google_pubsub_topic_iam_member" "queue_iam_member_enqueue" {
   role    = var.iam_roles.queue_enqueue
   member = "serviceAccount:${var.service_account_email}"
   topic = var.resource_name
+}
+
+# Cloud Scheduler jobs have no IAM policy of their own, so grant on the project conditioned on the full name of the job
+resource "google_project_iam_member" "schedule_iam_member_manage" {
+  project = data.google_project.project.project_id
+  count  = local.is_schedule && contains(var.actions, "ScheduleManage") ? 1 : 0
+  role    = var.iam_roles.schedule_manage
+  member = "serviceAccount:${var.service_account_email}"
+
+  condition {
+    title      = "schedule-${var.resource_name}"
+    expression = "resource.name == \"projects/${data.google_project.project.project_id}/locations/${data.google_client_config.this.region}/jobs/${var.resource_name}\""
+  }
 }
\ No newline at end of file
@@ -1,5 +1,5 @@
 variable "resource_type" {
-  description = "The type of the resource (Bucket, Secret, KeyValueStore, Queue)"
+  description = "The type of the resource (Bucket, Secret, KeyValueStore, Queue, Topic, Schedule)"
   type        = string
 }
 
@@ -30,6 +30,7 @@ variable "iam_roles" {
     kv_write          = string
     queue_dequeue     = string
     queue_enqueue     = string
+    schedule_manage   = string
     secret_access     = string
     secret_put        = string
     topic_publish     = string
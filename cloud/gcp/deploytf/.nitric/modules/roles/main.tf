@@ -152,4 +152,16 @@ resource "google_project_iam_custom_role" "queue_dequeue_role" {
 		"pubsub.snapshots.seek",
 		"pubsub.subscriptions.consume",
   ]
+}
+
+# Permissions required to trigger, pause and resume a schedule
+resource "google_project_iam_custom_role" "schedule_manage_role" {
+  role_id     = "ScheduleManage_${random_id.role_id.hex}"
+  title       = "Schedule Manage"
+  permissions = [
+    "cloudscheduler.jobs.get",
+    "cloudscheduler.jobs.run",
+    "cloudscheduler.jobs.pause",
+    "cloudscheduler.jobs.enable",
+  ]
 }
\ No newline at end of file
@@ -56,4 +56,9 @@ output "queue_enqueue" {
 output "queue_dequeue" {
   value       = google_project_iam_custom_role.queue_dequeue_role.id
   description = "The role ID for the Nitric queue dequeue role"
+}
+
+output "schedule_manage" {
+  value       = google_project_iam_custom_role.schedule_manage_role.id
+  description = "The role ID for the Nitric schedule manage role"
 }
\ No newline at end of file
@@ -7,16 +7,36 @@ resource "google_pubsub_topic" "topic" {
   }
 }
 
+# Get the GCP project number
+data "google_project" "project" {
+}
+
+locals {
+  pubsub_service_agent = "serviceAccount:service-${data.google_project.project.number}@gcp-sa-pubsub.iam.gserviceaccount.com"
+}
+
 # Create all CloudRun service subscriptions
 resource "google_pubsub_subscription" "topic_subscriptions" {
   count = length(var.subscriber_services)
   name = "${var.subscriber_services[count.index].name}"
   topic = google_pubsub_topic.topic.name
   ack_deadline_seconds = 300
+  # message ordering can't be changed on existing subscriptions, so it's only enabled for ordered topics
+  enable_message_ordering = var.subscriber_services[count.index].enable_message_ordering
+  filter = var.subscriber_services[count.index].filter
 
   retry_policy {
-    minimum_backoff = "15s"
-    maximum_backoff = "600s"
+    minimum_backoff = var.subscriber_services[count.index].minimum_backoff
+    maximum_backoff = var.subscriber_services[count.index].maximum_backoff
+  }
+
+  dynamic "dead_letter_policy" {
+    for_each = var.subscriber_services[count.index].dead_letter_topic == null ? [] : [var.subscriber_services[count.index]]
+
+    content {
+      dead_letter_topic     = "projects/${data.google_project.project.project_id}/topics/${dead_letter_policy.value.dead_letter_topic}"
+      max_delivery_attempts = dead_letter_policy.value.max_delivery_attempts
+    }
   }
 
   push_config {
@@ -29,4 +49,29 @@ resource "google_pubsub_subscription" "topic_subscriptions" {
   expiration_policy {
     ttl = ""
   }
+}
+
+# The pubsub service agent publishes undeliverable messages to the dead-letter topics,
+# granted once per destination as removing a duplicate member would remove the shared binding
+resource "google_pubsub_topic_iam_member" "dead_letter_publisher" {
+  for_each = toset([
+    for subscriber in var.subscriber_services : subscriber.dead_letter_topic
+    if subscriber.dead_letter_topic != null && subscriber.dead_letter_publisher
+  ])
+
+  topic  = each.value
+  role   = "roles/pubsub.publisher"
+  member = local.pubsub_service_agent
+}
+
+# The pubsub service agent acknowledges dead-lettered messages on behalf of the subscriptions
+resource "google_pubsub_subscription_iam_member" "dead_letter_subscriber" {
+  for_each = {
+    for index, subscriber in var.subscriber_services : subscriber.name => index
+    if subscriber.dead_letter_topic != null
+  }
+
+  subscription = google_pubsub_subscription.topic_subscriptions[each.value].name
+  role         = "roles/pubsub.subscriber"
+  member       = local.pubsub_service_agent
 }
\ No newline at end of file
@@ -15,5 +15,12 @@ variable "subscriber_services" {
     url                   = string
     invoker_service_account_email = string
     event_token           = string
+    minimum_backoff       = string
+    maximum_backoff       = string
+    dead_letter_topic     = optional(string)
+    max_delivery_attempts = optional(number)
+    dead_letter_publisher = optional(bool, false)
+    filter                = optional(string)
+    enable_message_ordering = optional(bool, false)
   }))
 }
@@ -59,6 +59,9 @@ type NitricGcpTerraformProvider struct {
 	Websockets     map[string]websocket.Websocket
 	RawAttributes  map[string]interface{}
 
+	// DeadLetterPublishers - dead-letter topics the pubsub service agent has been granted publish access to
+	DeadLetterPublishers map[string]bool
+
 	provider.NitricDefaultOrder
 }
 
@@ -207,5 +210,7 @@ func NewNitricGcpProvider() *NitricGcpTerraformProvider {
 		KeyValueStores: make(map[string]keyvalue.Keyvalue),
 		Websites:       make(map[string]website.Website),
 		Websockets:     make(map[string]websocket.Websocket),
+
+		DeadLetterPublishers: make(map[string]bool),
 	}
 }
@@ -71,6 +71,13 @@ func (a *NitricGcpTerraformProvider) Policy(stack cdktf.TerraformStack, name str
 				} else {
 					return fmt.Errorf("could not find topic %s", resource.Id.Name)
 				}
+			case v1.ResourceType_Schedule:
+				if _, ok := a.Schedules[resource.Id.Name]; ok {
+					// Jobs are named after their schedule
+					concreteResourceName = jsii.String(resource.Id.Name)
+				} else {
+					return fmt.Errorf("could not find schedule %s", resource.Id.Name)
+				}
 			}
 
 			resourceType := resource.Id.Type.String()
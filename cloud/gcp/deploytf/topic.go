@@ -15,18 +15,111 @@
 package deploytf
 
 import (
+	"fmt"
+
 	"github.com/aws/jsii-runtime-go"
 	"github.com/hashicorp/terraform-cdk-go/cdktf"
+	"github.com/nitrictech/nitric/cloud/gcp/common"
 	"github.com/nitrictech/nitric/cloud/gcp/deploytf/generated/topic"
+	"github.com/nitrictech/nitric/core/pkg/logger"
 	deploymentspb "github.com/nitrictech/nitric/core/pkg/proto/deployments/v1"
 )
 
 type SubscriberService struct {
 	// Explicit JSON names required for JSII serialization
-	Name                       string `json:"name"`
-	Url                        string `json:"url"`
-	InvokerServiceAccountEmail string `json:"invoker_service_account_email"`
-	EventToken                 string `json:"event_token"`
+	Name                       string  `json:"name"`
+	Url                        string  `json:"url"`
+	InvokerServiceAccountEmail string  `json:"invoker_service_account_email"`
+	EventToken                 string  `json:"event_token"`
+	MinimumBackoff             string  `json:"minimum_backoff"`
+	MaximumBackoff             string  `json:"maximum_backoff"`
+	DeadLetterTopic            *string `json:"dead_letter_topic"`
+	MaxDeliveryAttempts        int     `json:"max_delivery_attempts"`
+	DeadLetterPublisher        bool    `json:"dead_letter_publisher"`
+	Filter                     *string `json:"filter"`
+	EnableMessageOrdering      bool    `json:"enable_message_ordering"`
+}
+
+const (
+	// Pub/Sub limits for subscription retry policies
+	defaultMaxDeliveryAttempts = 5
+	maxDeliveryAttempts        = 100
+	defaultMinBackoffSeconds   = 15
+	maxBackoffSeconds          = 600
+)
+
+// withRetryPolicy sets the Pub/Sub retry policy backoff of a subscriber
+func withRetryPolicy(subscriber *SubscriberService, policy *deploymentspb.SubscriptionRetryPolicy) error {
+	minBackoff := int32(defaultMinBackoffSeconds)
+	if policy.GetMinBackoffSeconds() > 0 {
+		minBackoff = policy.GetMinBackoffSeconds()
+	}
+
+	maxBackoff := int32(maxBackoffSeconds)
+	if policy.GetMaxBackoffSeconds() > 0 {
+		maxBackoff = policy.GetMaxBackoffSeconds()
+	}
+
+	if maxBackoff > maxBackoffSeconds {
+		return fmt.Errorf("max backoff of %d seconds exceeds the Pub/Sub limit of %d seconds", maxBackoff, maxBackoffSeconds)
+	}
+
+	if minBackoff > maxBackoff {
+		return fmt.Errorf("min backoff of %d seconds is greater than the max backoff of %d seconds", minBackoff, maxBackoff)
+	}
+
+	subscriber.MinimumBackoff = fmt.Sprintf("%ds", minBackoff)
+	subscriber.MaximumBackoff = fmt.Sprintf("%ds", maxBackoff)
+
+	return nil
+}
+
+// withDeadLetterPolicy sets the Pub/Sub dead-letter topic of a subscriber, if the subscription has a dead-letter destination
+func (a *NitricGcpTerraformProvider) withDeadLetterPolicy(subscriber *SubscriberService, sub *deploymentspb.SubscriptionTarget) error {
+	var destinationName string
+
+	switch destination := sub.GetDeadLetter().GetDestination().(type) {
+	case nil:
+		if sub.GetRetryPolicy().GetMaxAttempts() > 0 {
+			return fmt.Errorf("Pub/Sub subscriptions require a dead-letter destination to limit delivery attempts")
+		}
+
+		return nil
+	case *deploymentspb.DeadLetterDestination_Topic:
+		topic, ok := a.Topics[destination.Topic]
+		if !ok {
+			return fmt.Errorf("unable to find dead-letter topic %s", destination.Topic)
+		}
+
+		subscriber.DeadLetterTopic = topic.TopicNameOutput()
+		destinationName = destination.Topic
+	case *deploymentspb.DeadLetterDestination_Queue:
+		// the queue module doesn't expose the topic backing the queue
+		logger.Warnf("dead-letter queues are not supported by the GCP terraform provider, undeliverable messages of subscription %s are retried until they expire", subscriber.Name)
+
+		return nil
+	default:
+		return fmt.Errorf("Pub/Sub subscriptions can only dead-letter to topics or queues")
+	}
+
+	maxAttempts := int32(defaultMaxDeliveryAttempts)
+	if sub.GetRetryPolicy().GetMaxAttempts() > 0 {
+		maxAttempts = sub.GetRetryPolicy().GetMaxAttempts()
+	}
+
+	if maxAttempts < defaultMaxDeliveryAttempts || maxAttempts > maxDeliveryAttempts {
+		return fmt.Errorf("max attempts must be between %d and %d for Pub/Sub subscriptions with a dead-letter destination", defaultMaxDeliveryAttempts, maxDeliveryAttempts)
+	}
+
+	subscriber.MaxDeliveryAttempts = int(maxAttempts)
+
+	// granted once per destination, as removing a duplicate member would remove the shared binding
+	if !a.DeadLetterPublishers[destinationName] {
+		subscriber.DeadLetterPublisher = true
+		a.DeadLetterPublishers[destinationName] = true
+	}
+
+	return nil
 }
 
 func (a *NitricGcpTerraformProvider) Topic(stack cdktf.TerraformStack, name string, config *deploymentspb.Topic) error {
@@ -42,12 +135,32 @@ func (a *NitricGcpTerraformProvider) Topic(stack cdktf.TerraformStack, name stri
 	for _, subscriber := range config.Subscriptions {
 		subscriberSvc := a.Services[subscriber.GetService()]
 
-		subscriberInput = append(subscriberInput, &SubscriberService{
+		subscriberService := &SubscriberService{
 			Name:                       subscriber.GetService(),
 			Url:                        *serviceEndpoints[subscriber.GetService()],
 			InvokerServiceAccountEmail: *subscriberSvc.InvokerServiceAccountEmailOutput(),
 			EventToken:                 *subscriberSvc.EventTokenOutput(),
-		})
+			EnableMessageOrdering:      config.GetOrdered(),
+		}
+
+		if err := withRetryPolicy(subscriberService, subscriber.GetRetryPolicy()); err != nil {
+			return fmt.Errorf("subscription of service %s to topic %s: %w", subscriber.GetService(), name, err)
+		}
+
+		if err := a.withDeadLetterPolicy(subscriberService, subscriber); err != nil {
+			return fmt.Errorf("subscription of service %s to topic %s: %w", subscriber.GetService(), name, err)
+		}
+
+		if len(subscriber.GetFilter().GetAttributes()) > 0 {
+			filter, err := common.SubscriptionFilter(subscriber.GetFilter())
+			if err != nil {
+				return fmt.Errorf("invalid filter for subscription of service %s to topic %s: %w", subscriber.GetService(), name, err)
+			}
+
+			subscriberService.Filter = jsii.String(filter)
+		}
+
+		subscriberInput = append(subscriberInput, subscriberService)
 	}
 
 	a.Topics[name] = topic.NewTopic(stack, jsii.Sprintf("topic_%s", name), &topic.TopicConfig{
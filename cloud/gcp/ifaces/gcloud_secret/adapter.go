@@ -20,14 +20,15 @@ import (
 	secretmanager "cloud.google.com/go/secretmanager/apiv1"
 	"cloud.google.com/go/secretmanager/apiv1/secretmanagerpb"
 	gax "github.com/googleapis/gax-go/v2"
+	"google.golang.org/api/option"
 )
 
 type realClient struct {
 	*secretmanager.Client
 }
 
-func NewClient(ctx context.Context) (SecretManagerClient, error) {
-	c, err := secretmanager.NewClient(ctx)
+func NewClient(ctx context.Context, opts ...option.ClientOption) (SecretManagerClient, error) {
+	c, err := secretmanager.NewClient(ctx, opts...)
 	if err != nil {
 		return nil, err
 	}
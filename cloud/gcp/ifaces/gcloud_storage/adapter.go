@@ -64,6 +64,10 @@ func (o objectHandle) Key(encryptionKey []byte) ObjectHandle {
 	return objectHandle{o.ObjectHandle.Key(encryptionKey)}
 }
 
+func (o objectHandle) If(conds storage.Conditions) ObjectHandle {
+	return objectHandle{o.ObjectHandle.If(conds)}
+}
+
 func (o objectHandle) NewWriter(ctx context.Context) Writer {
 	return writer{o.ObjectHandle.NewWriter(ctx)}
 }
@@ -77,6 +81,11 @@ func (o objectHandle) NewReader(ctx context.Context) (Reader, error) {
 	return reader{newReader}, err
 }
 
+func (o objectHandle) NewRangeReader(ctx context.Context, offset int64, length int64) (Reader, error) {
+	newReader, err := o.ObjectHandle.NewRangeReader(ctx, offset, length)
+	return reader{newReader}, err
+}
+
 func (o objectHandle) Delete(ctx context.Context) error {
 	return o.ObjectHandle.Delete(ctx)
 }
@@ -84,3 +93,8 @@ func (o objectHandle) Delete(ctx context.Context) error {
 func (o objectHandle) Attrs(ctx context.Context) (*storage.ObjectAttrs, error) {
 	return o.ObjectHandle.Attrs(ctx)
 }
+
+// CopierFrom returns a copier for copying src, which must be an adapted object handle, to this object
+func (o objectHandle) CopierFrom(src ObjectHandle) Copier {
+	return o.ObjectHandle.CopierFrom(src.(objectHandle).ObjectHandle)
+}
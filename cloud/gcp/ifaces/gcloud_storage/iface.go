@@ -19,6 +19,7 @@ import (
 	"io"
 
 	"cloud.google.com/go/storage"
+	"google.golang.org/api/iterator"
 )
 
 type Writer interface {
@@ -33,8 +34,15 @@ type Reader interface {
 type ObjectHandle interface {
 	NewWriter(context.Context) Writer
 	NewReader(context.Context) (Reader, error)
+	NewRangeReader(ctx context.Context, offset int64, length int64) (Reader, error)
 	Delete(ctx context.Context) error
 	Attrs(ctx context.Context) (*storage.ObjectAttrs, error)
+	CopierFrom(src ObjectHandle) Copier
+	If(conds storage.Conditions) ObjectHandle
+}
+
+type Copier interface {
+	Run(ctx context.Context) (*storage.ObjectAttrs, error)
 }
 
 type BucketIterator interface {
@@ -43,6 +51,7 @@ type BucketIterator interface {
 
 type ObjectIterator interface {
 	Next() (*storage.ObjectAttrs, error)
+	PageInfo() *iterator.PageInfo
 }
 
 type BucketHandle interface {
@@ -44,7 +44,7 @@ type (
 )
 
 func (c pubsubClient) Topic(id string) Topic {
-	return topic{c.Client.Topic(id)}
+	return adaptTopic(c.Client.Topic(id))
 }
 
 func (c pubsubClient) Topics(ctx context.Context) TopicIterator {
@@ -53,7 +53,18 @@ func (c pubsubClient) Topics(ctx context.Context) TopicIterator {
 
 func (c topicIterator) Next() (Topic, error) {
 	t, err := c.TopicIterator.Next()
-	return topic{t}, err
+	if err != nil {
+		return topic{t}, err
+	}
+
+	return adaptTopic(t), nil
+}
+
+// adaptTopic adapts a pubsub.Topic, enabling publishing of messages with ordering keys
+func adaptTopic(t *pubsub.Topic) Topic {
+	t.EnableMessageOrdering = true
+
+	return topic{t}
 }
 
 func (c subscriptionIterator) Next() (Subscription, error) {
@@ -77,6 +88,10 @@ func (t topic) Publish(ctx context.Context, msg Message) PublishResult {
 	return publishResult{t.Topic.Publish(ctx, msg.(message).Message)}
 }
 
+func (t topic) ResumePublish(orderingKey string) {
+	t.Topic.ResumePublish(orderingKey)
+}
+
 func (t topic) Subscriptions(ctx context.Context) SubscriptionIterator {
 	return subscriptionIterator{t.Topic.Subscriptions(ctx)}
 }
@@ -34,6 +34,7 @@ type TopicIterator interface {
 type Topic interface {
 	String() string
 	Publish(ctx context.Context, msg Message) PublishResult
+	ResumePublish(orderingKey string)
 	Exists(ctx context.Context) (bool, error)
 	Subscriptions(ctx context.Context) SubscriptionIterator
 	ID() string
@@ -67,4 +68,5 @@ type SubscriberClient interface {
 	Close() error
 	Pull(ctx context.Context, req *pubsubpb.PullRequest, opts ...gax.CallOption) (*pubsubpb.PullResponse, error)
 	Acknowledge(ctx context.Context, req *pubsubpb.AcknowledgeRequest, opts ...gax.CallOption) error
+	ModifyAckDeadline(ctx context.Context, req *pubsubpb.ModifyAckDeadlineRequest, opts ...gax.CallOption) error
 }
@@ -22,7 +22,9 @@ import (
 	batch "cloud.google.com/go/batch/apiv1"
 	gcpbatchpb "cloud.google.com/go/batch/apiv1/batchpb"
 	"cloud.google.com/go/storage"
+	commonbatch "github.com/nitrictech/nitric/cloud/common/runtime/batch"
 	"github.com/nitrictech/nitric/cloud/gcp/runtime/env"
+	"github.com/nitrictech/nitric/cloud/gcp/runtime/tracing"
 	batchpb "github.com/nitrictech/nitric/core/pkg/proto/batch/v1"
 	"golang.org/x/oauth2/google"
 	"google.golang.org/api/iamcredentials/v1"
@@ -59,13 +61,13 @@ func (a *GcpBatchService) SubmitJob(ctx context.Context, request *batchpb.JobSub
 		return nil, err
 	}
 
-	jobData, err := protojson.Marshal(request.Data)
+	jobData, err := commonbatch.EncodeJobData(request.Data)
 	if err != nil {
 		return nil, err
 	}
 
 	// Add job data to environment variables
-	jobDefinition.TaskGroups[0].TaskSpec.Environment.Variables["NITRIC_JOB_DATA"] = string(jobData)
+	jobDefinition.TaskGroups[0].TaskSpec.Environment.Variables["NITRIC_JOB_DATA"] = jobData
 
 	_, err = a.batchClient.CreateJob(ctx, &gcpbatchpb.CreateJobRequest{
 		Parent: fmt.Sprintf("projects/%s/locations/%s", a.projectId, a.region),
@@ -89,12 +91,17 @@ func New() (*GcpBatchService, error) {
 		return nil, fmt.Errorf("GCP credentials error: %w", credentialsError)
 	}
 
-	batchClient, err := batch.NewClient(context.TODO(), option.WithCredentials(credentials))
+	batchClient, err := batch.NewClient(context.TODO(), option.WithCredentials(credentials), tracing.GrpcClientOption())
 	if err != nil {
 		return nil, err
 	}
 
-	storageClient, err := storage.NewClient(context.TODO(), option.WithCredentials(credentials))
+	httpClient, err := tracing.NewHttpClient(context.TODO(), option.WithCredentials(credentials))
+	if err != nil {
+		return nil, err
+	}
+
+	storageClient, err := storage.NewClient(context.TODO(), option.WithCredentials(credentials), option.WithHTTPClient(httpClient))
 	if err != nil {
 		return nil, err
 	}
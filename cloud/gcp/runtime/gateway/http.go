@@ -18,8 +18,11 @@ package gateway
 import (
 	"encoding/json"
 	"fmt"
+	"net/http"
 	"os"
+	"time"
 
+	"github.com/GoogleCloudPlatform/opentelemetry-operations-go/propagator"
 	"github.com/fasthttp/router"
 	"github.com/valyala/fasthttp"
 
@@ -31,8 +34,11 @@ import (
 	storagepb "github.com/nitrictech/nitric/core/pkg/proto/storage/v1"
 	topicpb "github.com/nitrictech/nitric/core/pkg/proto/topics/v1"
 	topicspb "github.com/nitrictech/nitric/core/pkg/proto/topics/v1"
+	"github.com/nitrictech/nitric/core/pkg/tracing"
+	"github.com/nitrictech/nitric/core/pkg/workers/topics"
 	"google.golang.org/protobuf/proto"
 	"google.golang.org/protobuf/types/known/structpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 type gcpMiddleware struct {
@@ -43,9 +49,11 @@ type PubSubMessage struct {
 	Message struct {
 		Attributes map[string]string `json:"attributes"`
 		Data       []byte            `json:"data,omitempty"`
-		ID         string            `json:"id"`
+		ID         string            `json:"messageId"`
 	} `json:"message"`
 	Subscription string `json:"subscription"`
+	// Only provided for subscriptions with a dead letter policy
+	DeliveryAttempt int32 `json:"deliveryAttempt,omitempty"`
 }
 
 func eventAuthorised(ctx *fasthttp.RequestCtx) bool {
@@ -84,33 +92,43 @@ func (g *gcpMiddleware) handleSubscription(opts *gateway.GatewayStartOpts) fasth
 				messageData := map[string]any{}
 				err := json.Unmarshal(pubsubEvent.Message.Data, &messageData)
 				if err != nil {
-					ctx.Error("could not unmarshal event data", 500)
-					return
-				}
-
-				structPayload, err := structpb.NewStruct(messageData)
-				if err != nil {
-					ctx.Error("could not convert message data to struct", 500)
-					return
-				}
-
-				message = topicpb.TopicMessage{
-					Content: &topicspb.TopicMessage_StructPayload{
-						StructPayload: structPayload,
-					},
+					// not json either, pass the message data to subscribers as is
+					message = topicpb.TopicMessage{
+						Content: &topicspb.TopicMessage_RawPayload{
+							RawPayload: pubsubEvent.Message.Data,
+						},
+						ContentType: http.DetectContentType(pubsubEvent.Message.Data),
+					}
+				} else {
+					structPayload, err := structpb.NewStruct(messageData)
+					if err != nil {
+						ctx.Error("could not convert message data to struct", 500)
+						return
+					}
+
+					message = topicpb.TopicMessage{
+						Content: &topicspb.TopicMessage_StructPayload{
+							StructPayload: structPayload,
+						},
+					}
 				}
 			}
 
 			event := &topicspb.ServerMessage{
 				Content: &topicspb.ServerMessage_MessageRequest{
 					MessageRequest: &topicspb.MessageRequest{
-						TopicName: topicName,
-						Message:   &message,
+						TopicName:       topicName,
+						Message:         &message,
+						DeliveryAttempt: pubsubEvent.DeliveryAttempt,
+						MessageId:       pubsubEvent.Message.ID,
 					},
 				},
 			}
 
-			response, err := opts.TopicsListenerPlugin.HandleRequest(event)
+			topics.SetMessageAttributes(event.GetMessageRequest(), pubsubEvent.Message.Attributes, append(propagator.CloudTraceFormatPropagator{}.Fields(), tracing.Fields()...)...)
+
+			// continue the trace of the publisher
+			response, err := opts.TopicsListenerPlugin.HandleRequest(tracing.Extract(ctx, pubsubEvent.Message.Attributes), event)
 			if err != nil {
 				ctx.Error(fmt.Sprintf("Error handling event %v", err), 500)
 				return
@@ -138,11 +156,18 @@ func (g *gcpMiddleware) handleSchedule(opts *gateway.GatewayStartOpts) fasthttp.
 			ctx.Error("Can not handle event for empty schedule", 400)
 		}
 
-		_, err := opts.SchedulesPlugin.HandleRequest(&schedulespb.ServerMessage{
+		intervalRequest := &schedulespb.IntervalRequest{
+			ScheduleName: scheduleName,
+		}
+
+		// Cloud Scheduler sends the time the job was scheduled for
+		if scheduledTime, err := time.Parse(time.RFC3339, string(ctx.Request.Header.Peek("X-CloudScheduler-ScheduleTime"))); err == nil {
+			intervalRequest.ScheduledTime = timestamppb.New(scheduledTime)
+		}
+
+		_, err := opts.SchedulesPlugin.HandleRequest(ctx, &schedulespb.ServerMessage{
 			Content: &schedulespb.ServerMessage_IntervalRequest{
-				IntervalRequest: &schedulespb.IntervalRequest{
-					ScheduleName: scheduleName,
-				},
+				IntervalRequest: intervalRequest,
 			},
 		})
 		if err != nil {
@@ -188,7 +213,7 @@ func (g *gcpMiddleware) handleBucketNotification(opts *gateway.GatewayStartOpts)
 				return
 			}
 
-			resp, err := opts.StorageListenerPlugin.HandleRequest(&storagepb.ServerMessage{
+			resp, err := opts.StorageListenerPlugin.HandleRequest(ctx, &storagepb.ServerMessage{
 				Content: &storagepb.ServerMessage_BlobEventRequest{
 					BlobEventRequest: &storagepb.BlobEventRequest{
 						BucketName: bucketName,
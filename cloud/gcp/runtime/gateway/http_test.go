@@ -80,22 +80,19 @@ var _ = Describe("Http", func() {
 
 				var capturedRequest *apispb.ServerMessage
 				var capturedApiName string
+				var capturedBody []byte
 
 				By("Handling exactly 1 request")
-				mockApiRequestHandler.EXPECT().HandleRequest(gomock.Any(), gomock.Any()).Times(1).DoAndReturn(func(arg0 interface{}, arg1 interface{}) (*apispb.ClientMessage, error) {
+				mockApiRequestHandler.EXPECT().HandleStreamingRequest(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Times(1).DoAndReturn(func(ctx interface{}, arg0 interface{}, arg1 interface{}, arg2 interface{}) (*apispb.HttpResponse, io.ReadCloser, error) {
 					capturedApiName = arg0.(string)
 					capturedRequest = arg1.(*apispb.ServerMessage)
-					// apiName string, request *apispb.ServerMessage
+					capturedBody, _ = io.ReadAll(arg2.(io.Reader))
+					// apiName string, request *apispb.ServerMessage, body io.Reader
 
-					return &apispb.ClientMessage{
-						Id: "test",
-						Content: &apispb.ClientMessage_HttpResponse{
-							HttpResponse: &apispb.HttpResponse{
-								Status: 200,
-								Body:   []byte("success"),
-							},
-						},
-					}, nil
+					return &apispb.HttpResponse{
+						Status: 200,
+						Body:   []byte("success"),
+					}, io.NopCloser(bytes.NewReader([]byte("success"))), nil
 				})
 
 				request, err := http.NewRequest("POST", fmt.Sprintf("%s/x-nitric-api/%s/test", gatewayUrl, "test-api"), bytes.NewReader(payload))
@@ -131,7 +128,7 @@ var _ = Describe("Http", func() {
 				Expect(capturedRequest.GetHttpRequest().Path).To(Equal("test"))
 
 				By("Preserving the original requests body")
-				Expect(capturedRequest.GetHttpRequest().Body).To(BeEquivalentTo([]byte("Test")))
+				Expect(capturedBody).To(BeEquivalentTo([]byte("Test")))
 
 				By("Preserving the original requests headers")
 				Expect(capturedRequest.GetHttpRequest().Headers["User-Agent"].Value[0]).To(Equal("Test"))
@@ -166,18 +163,21 @@ var _ = Describe("Http", func() {
 				"subscription": "test",
 				"message": map[string]interface{}{
 					"attributes": map[string]string{
-						"x-nitric-topic": "test",
+						"x-nitric-topic":            "test",
+						"x-nitric-deduplication-id": "order-1-created",
+						"kind":                      "created",
 					},
-					"id":   "test",
-					"data": b64Event,
+					"messageId": "test",
+					"data":      b64Event,
 				},
+				"deliveryAttempt": 2,
 			})
 
 			It("Should handle the event successfully", func() {
 				var capturedRequest *topicspb.ServerMessage
 
 				By("Handling exactly 1 request")
-				mockTopicRequestHandler.EXPECT().HandleRequest(gomock.Any()).Times(1).DoAndReturn(func(arg0 interface{}) (*topicspb.ClientMessage, error) {
+				mockTopicRequestHandler.EXPECT().HandleRequest(gomock.Any(), gomock.Any()).Times(1).DoAndReturn(func(ctx interface{}, arg0 interface{}) (*topicspb.ClientMessage, error) {
 					capturedRequest = arg0.(*topicspb.ServerMessage)
 
 					return &topicspb.ClientMessage{
@@ -206,6 +206,16 @@ var _ = Describe("Http", func() {
 				By("Passing through the published message data")
 				Expect(capturedMessageBytes).To(Equal(messageBytes))
 
+				By("Passing through the delivery attempt")
+				Expect(capturedRequest.GetMessageRequest().GetDeliveryAttempt()).To(Equal(int32(2)))
+
+				By("Passing through the message id")
+				Expect(capturedRequest.GetMessageRequest().GetMessageId()).To(Equal("test"))
+
+				By("Passing through the published attributes")
+				Expect(capturedRequest.GetMessageRequest().GetAttributes()).To(Equal(map[string]string{"kind": "created"}))
+				Expect(capturedRequest.GetMessageRequest().GetDeduplicationId()).To(Equal("order-1-created"))
+
 				By("The request returns a successful status")
 				Expect(resp.StatusCode).To(Equal(200))
 
@@ -214,6 +224,60 @@ var _ = Describe("Http", func() {
 			})
 		})
 
+		When("From a subscription with a raw payload NitricEvent", func() {
+			message := topicspb.TopicMessage{
+				Content: &topicspb.TopicMessage_RawPayload{
+					RawPayload: []byte{0x00, 0xff, 0x10},
+				},
+				ContentType: "application/octet-stream",
+			}
+
+			messageBytes, err := proto.Marshal(&message)
+			Expect(err).To(BeNil())
+
+			payloadBytes, _ := json.Marshal(&map[string]interface{}{
+				"subscription": "test",
+				"message": map[string]interface{}{
+					"attributes": map[string]string{
+						"x-nitric-topic": "test",
+					},
+					"messageId": "test",
+					"data":      base64.StdEncoding.EncodeToString(messageBytes),
+				},
+			})
+
+			It("Should pass the raw payload through unchanged", func() {
+				var capturedRequest *topicspb.ServerMessage
+
+				By("Handling exactly 1 request")
+				mockTopicRequestHandler.EXPECT().HandleRequest(gomock.Any(), gomock.Any()).Times(1).DoAndReturn(func(ctx interface{}, arg0 interface{}) (*topicspb.ClientMessage, error) {
+					capturedRequest = arg0.(*topicspb.ServerMessage)
+
+					return &topicspb.ClientMessage{
+						Id: "test",
+						Content: &topicspb.ClientMessage_MessageResponse{
+							MessageResponse: &topicspb.MessageResponse{
+								Success: true,
+							},
+						},
+					}, nil
+				})
+
+				request, err := http.NewRequest("POST", fmt.Sprintf("%s/x-nitric-topic/test", gatewayUrl), bytes.NewReader(payloadBytes))
+				Expect(err).To(BeNil())
+				request.Header.Add("Content-Type", "application/json")
+				resp, err := http.DefaultClient.Do(request)
+				Expect(err).To(BeNil())
+
+				By("Passing through the raw payload and its content type")
+				Expect(capturedRequest.GetMessageRequest().GetMessage().GetRawPayload()).To(Equal([]byte{0x00, 0xff, 0x10}))
+				Expect(capturedRequest.GetMessageRequest().GetMessage().GetContentType()).To(Equal("application/octet-stream"))
+
+				By("The request returns a successful status")
+				Expect(resp.StatusCode).To(Equal(200))
+			})
+		})
+
 		When("From a subscription with a JSON event", func() {
 			content := map[string]interface{}{
 				"Test": "Test",
@@ -238,7 +302,7 @@ var _ = Describe("Http", func() {
 				var capturedRequest *topicspb.ServerMessage
 
 				By("Handling exactly 1 request")
-				mockTopicRequestHandler.EXPECT().HandleRequest(gomock.Any()).Times(1).DoAndReturn(func(arg0 interface{}) (*topicspb.ClientMessage, error) {
+				mockTopicRequestHandler.EXPECT().HandleRequest(gomock.Any(), gomock.Any()).Times(1).DoAndReturn(func(ctx interface{}, arg0 interface{}) (*topicspb.ClientMessage, error) {
 					capturedRequest = arg0.(*topicspb.ServerMessage)
 
 					return &topicspb.ClientMessage{
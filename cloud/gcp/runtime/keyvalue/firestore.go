@@ -20,6 +20,7 @@ import (
 	"fmt"
 	"strings"
 
+	"github.com/nitrictech/nitric/cloud/gcp/runtime/tracing"
 	"github.com/nitrictech/nitric/core/pkg/decorators/keyvalue"
 	grpc_errors "github.com/nitrictech/nitric/core/pkg/grpc/errors"
 	v1 "github.com/nitrictech/nitric/core/pkg/proto/kvstore/v1"
@@ -224,7 +225,7 @@ func New() (v1.KvStoreServer, error) {
 		return nil, fmt.Errorf("GCP credentials error: %w", credentialsError)
 	}
 
-	client, clientError := firestore.NewClient(ctx, credentials.ProjectID)
+	client, clientError := firestore.NewClient(ctx, credentials.ProjectID, tracing.GrpcClientOption())
 	if clientError != nil {
 		return nil, fmt.Errorf("firestore client error: %w", clientError)
 	}
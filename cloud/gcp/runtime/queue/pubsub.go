@@ -18,15 +18,20 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"math"
+	"slices"
 	"sync"
+	"time"
 
 	"github.com/nitrictech/nitric/cloud/common/deploy/resources"
 	"github.com/nitrictech/nitric/cloud/common/deploy/tags"
 	"github.com/nitrictech/nitric/cloud/gcp/runtime/env"
+	"github.com/nitrictech/nitric/cloud/gcp/runtime/tracing"
 	"google.golang.org/grpc/codes"
 	grpccodes "google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/durationpb"
 
 	"cloud.google.com/go/pubsub"
 	pubsubbase "cloud.google.com/go/pubsub/apiv1"
@@ -44,6 +49,16 @@ import (
 	queuespb "github.com/nitrictech/nitric/core/pkg/proto/queues/v1"
 )
 
+// The maximum ack deadline of a PubSub message, 10 minutes
+const maxAckDeadline = 10 * time.Minute
+
+// ackDeadlineSeconds returns the lease duration in whole seconds, capped at the maximum PubSub ack deadline
+func ackDeadlineSeconds(leaseDuration *durationpb.Duration) int32 {
+	duration := min(leaseDuration.AsDuration(), maxAckDeadline)
+
+	return int32(math.Ceil(duration.Seconds()))
+}
+
 type PubsubQueueService struct {
 	queuespb.UnimplementedQueuesServer
 	// queue.UnimplementedQueuePlugin
@@ -245,6 +260,27 @@ func (s *PubsubQueueService) Dequeue(ctx context.Context, req *queuespb.QueueDeq
 		}, nil
 	}
 
+	// The pull subscription's ack deadline applies unless a lease duration was requested
+	if req.LeaseDuration != nil {
+		ackIds := make([]string, 0, len(res.ReceivedMessages))
+		for _, m := range res.ReceivedMessages {
+			ackIds = append(ackIds, m.AckId)
+		}
+
+		err = client.ModifyAckDeadline(ctx, &pubsubpb.ModifyAckDeadlineRequest{
+			Subscription:       queueSubscription.String(),
+			AckIds:             ackIds,
+			AckDeadlineSeconds: ackDeadlineSeconds(req.LeaseDuration),
+		})
+		if err != nil {
+			return nil, newErr(
+				codes.Internal,
+				"failed to set the lease duration of pulled messages",
+				err,
+			)
+		}
+	}
+
 	// Convert the PubSub messages into Nitric tasks
 	var tasks []*queuespb.DequeuedMessage
 	for _, m := range res.ReceivedMessages {
@@ -321,11 +357,85 @@ func (s *PubsubQueueService) Complete(ctx context.Context, req *queuespb.QueueCo
 	return &queuespb.QueueCompleteResponse{}, nil
 }
 
+func (s *PubsubQueueService) ExtendLease(ctx context.Context, req *queuespb.QueueExtendLeaseRequest) (*queuespb.QueueExtendLeaseResponse, error) {
+	newErr := grpc_errors.ErrorsWithScope("PubsubQueueService.ExtendLease")
+
+	err := s.modifyAckDeadline(ctx, newErr, req.QueueName, req.LeaseId, ackDeadlineSeconds(req.LeaseDuration))
+	if err != nil {
+		return nil, err
+	}
+
+	// PubSub ack ids are unchanged when the ack deadline is modified
+	return &queuespb.QueueExtendLeaseResponse{
+		LeaseId: req.LeaseId,
+	}, nil
+}
+
+func (s *PubsubQueueService) Release(ctx context.Context, req *queuespb.QueueReleaseRequest) (*queuespb.QueueReleaseResponse, error) {
+	newErr := grpc_errors.ErrorsWithScope("PubsubQueueService.Release")
+
+	// An ack deadline of zero makes the message immediately available for redelivery
+	err := s.modifyAckDeadline(ctx, newErr, req.QueueName, req.LeaseId, 0)
+	if err != nil {
+		return nil, err
+	}
+
+	return &queuespb.QueueReleaseResponse{}, nil
+}
+
+// modifyAckDeadline - Modifies the ack deadline of a previously pulled queue item
+func (s *PubsubQueueService) modifyAckDeadline(ctx context.Context, newErr grpc_errors.ScopedErrorFactory, queueName string, ackId string, ackDeadlineSeconds int32) error {
+	// Find the generic pull subscription for the provided topic (queue)
+	queueSubscription, err := s.getQueueSubscription(ctx, queueName)
+	if err != nil {
+		return newErr(
+			codes.NotFound,
+			"could not find queue subscription",
+			err,
+		)
+	}
+
+	client, err := s.newSubscriberClient(ctx)
+	if err != nil {
+		return newErr(
+			codes.Internal,
+			"failed to create subscriber client",
+			err,
+		)
+	}
+	defer client.Close()
+
+	pubsubRequest := pubsubpb.ModifyAckDeadlineRequest{
+		Subscription:       queueSubscription.String(),
+		AckIds:             []string{ackId},
+		AckDeadlineSeconds: ackDeadlineSeconds,
+	}
+	err = client.ModifyAckDeadline(ctx, &pubsubRequest)
+	if err != nil {
+		errStatus, _ := status.FromError(err)
+		if errStatus.Code() == grpccodes.PermissionDenied {
+			return newErr(
+				codes.PermissionDenied,
+				"permission denied, have you requested access to the queue?",
+				err)
+		}
+
+		return newErr(
+			codes.Internal,
+			"failed to modify task ack deadline",
+			err,
+		)
+	}
+
+	return nil
+}
+
 // adaptNewClient - Adapts the pubsubbase.NewSubscriberClient func to one that implements the SubscriberClient
 // interface. This is used to enable substitution of the base pubsub client, primarily for mocking support.
-func adaptNewClient(f func(context.Context, ...option.ClientOption) (*pubsubbase.SubscriberClient, error)) func(ctx context.Context, opts ...option.ClientOption) (ifaces_pubsub.SubscriberClient, error) {
+// The default options are applied to every client created, ahead of the options passed when it's created.
+func adaptNewClient(f func(context.Context, ...option.ClientOption) (*pubsubbase.SubscriberClient, error), defaultOpts ...option.ClientOption) func(ctx context.Context, opts ...option.ClientOption) (ifaces_pubsub.SubscriberClient, error) {
 	return func(c context.Context, opts ...option.ClientOption) (ifaces_pubsub.SubscriberClient, error) {
-		return f(c, opts...)
+		return f(c, slices.Concat(defaultOpts, opts)...)
 	}
 }
 
@@ -337,14 +447,14 @@ func New() (*PubsubQueueService, error) {
 	if credentialsError != nil {
 		return nil, fmt.Errorf("GCP credentials error: %w", credentialsError)
 	}
-	client, clientError := pubsub.NewClient(ctx, credentials.ProjectID)
+	client, clientError := pubsub.NewClient(ctx, credentials.ProjectID, tracing.GrpcClientOption())
 	if clientError != nil {
 		return nil, fmt.Errorf("pubsub client error: %w", clientError)
 	}
 
 	return &PubsubQueueService{
 		client:              ifaces_pubsub.AdaptPubsubClient(client),
-		newSubscriberClient: adaptNewClient(pubsubbase.NewSubscriberClient),
+		newSubscriberClient: adaptNewClient(pubsubbase.NewSubscriberClient, tracing.GrpcClientOption()),
 		projectId:           credentials.ProjectID,
 	}, nil
 }
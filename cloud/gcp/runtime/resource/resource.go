@@ -22,6 +22,7 @@ import (
 
 	"github.com/nitrictech/nitric/cloud/common/deploy/tags"
 	"github.com/nitrictech/nitric/cloud/gcp/runtime/env"
+	"github.com/nitrictech/nitric/cloud/gcp/runtime/tracing"
 
 	apigateway "cloud.google.com/go/apigateway/apiv1"
 	"cloud.google.com/go/apigateway/apiv1/apigatewaypb"
@@ -159,7 +160,7 @@ func New() (*GcpResourceService, error) {
 	stack := commonenv.NITRIC_STACK_ID.String()
 	region := env.GCP_REGION.String()
 
-	apiClient, err := apigateway.NewClient(context.TODO())
+	apiClient, err := apigateway.NewClient(context.TODO(), tracing.GrpcClientOption())
 	if err != nil {
 		return nil, err
 	}
@@ -0,0 +1,180 @@
+// Copyright 2021 Nitric Technologies Pty Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package schedule
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/samber/lo"
+	cloudscheduler "google.golang.org/api/cloudscheduler/v1"
+	"google.golang.org/api/googleapi"
+	"google.golang.org/api/option"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/nitrictech/nitric/cloud/gcp/runtime/env"
+	"github.com/nitrictech/nitric/cloud/gcp/runtime/resource"
+	"github.com/nitrictech/nitric/cloud/gcp/runtime/tracing"
+	grpc_errors "github.com/nitrictech/nitric/core/pkg/grpc/errors"
+	schedulespb "github.com/nitrictech/nitric/core/pkg/proto/schedules/v1"
+)
+
+const statePaused = "PAUSED"
+
+// CloudSchedulerScheduleService manages the Cloud Scheduler jobs of a stack, jobs are named after the nitric schedule they run
+type CloudSchedulerScheduleService struct {
+	schedulespb.UnimplementedScheduleManagerServer
+
+	jobs     *cloudscheduler.ProjectsLocationsJobsService
+	resolver resource.GcpResourceResolver
+	region   string
+}
+
+var _ schedulespb.ScheduleManagerServer = &CloudSchedulerScheduleService{}
+
+// errorCode returns the gRPC code for an error calling the Cloud Scheduler API
+func errorCode(err error) codes.Code {
+	var ee *googleapi.Error
+	if errors.As(err, &ee) {
+		switch ee.Code {
+		case http.StatusNotFound:
+			return codes.NotFound
+		case http.StatusForbidden:
+			return codes.PermissionDenied
+		case http.StatusBadRequest:
+			return codes.FailedPrecondition
+		}
+	}
+
+	return codes.Internal
+}
+
+func (s *CloudSchedulerScheduleService) jobName(scheduleName string) (string, error) {
+	projectId, err := s.resolver.GetProjectID()
+	if err != nil {
+		return "", err
+	}
+
+	return fmt.Sprintf("projects/%s/locations/%s/jobs/%s", projectId, s.region, scheduleName), nil
+}
+
+func (s *CloudSchedulerScheduleService) Trigger(ctx context.Context, req *schedulespb.ScheduleTriggerRequest) (*schedulespb.ScheduleTriggerResponse, error) {
+	newErr := grpc_errors.ErrorsWithScope("CloudSchedulerScheduleService.Trigger")
+
+	name, err := s.jobName(req.ScheduleName)
+	if err != nil {
+		return nil, newErr(codes.Internal, "error resolving job name", err)
+	}
+
+	_, err = s.jobs.Run(name, &cloudscheduler.RunJobRequest{}).Context(ctx).Do()
+	if err != nil {
+		return nil, newErr(errorCode(err), "error running job", err)
+	}
+
+	return &schedulespb.ScheduleTriggerResponse{}, nil
+}
+
+func (s *CloudSchedulerScheduleService) Pause(ctx context.Context, req *schedulespb.SchedulePauseRequest) (*schedulespb.SchedulePauseResponse, error) {
+	newErr := grpc_errors.ErrorsWithScope("CloudSchedulerScheduleService.Pause")
+
+	name, err := s.jobName(req.ScheduleName)
+	if err != nil {
+		return nil, newErr(codes.Internal, "error resolving job name", err)
+	}
+
+	_, err = s.jobs.Pause(name, &cloudscheduler.PauseJobRequest{}).Context(ctx).Do()
+	if err != nil {
+		return nil, newErr(errorCode(err), "error pausing job", err)
+	}
+
+	return &schedulespb.SchedulePauseResponse{}, nil
+}
+
+func (s *CloudSchedulerScheduleService) Resume(ctx context.Context, req *schedulespb.ScheduleResumeRequest) (*schedulespb.ScheduleResumeResponse, error) {
+	newErr := grpc_errors.ErrorsWithScope("CloudSchedulerScheduleService.Resume")
+
+	name, err := s.jobName(req.ScheduleName)
+	if err != nil {
+		return nil, newErr(codes.Internal, "error resolving job name", err)
+	}
+
+	_, err = s.jobs.Resume(name, &cloudscheduler.ResumeJobRequest{}).Context(ctx).Do()
+	if err != nil {
+		return nil, newErr(errorCode(err), "error resuming job", err)
+	}
+
+	return &schedulespb.ScheduleResumeResponse{}, nil
+}
+
+func (s *CloudSchedulerScheduleService) Describe(ctx context.Context, req *schedulespb.ScheduleDescribeRequest) (*schedulespb.ScheduleDescribeResponse, error) {
+	newErr := grpc_errors.ErrorsWithScope("CloudSchedulerScheduleService.Describe")
+
+	name, err := s.jobName(req.ScheduleName)
+	if err != nil {
+		return nil, newErr(codes.Internal, "error resolving job name", err)
+	}
+
+	job, err := s.jobs.Get(name).Context(ctx).Do()
+	if err != nil {
+		return nil, newErr(errorCode(err), "error getting job", err)
+	}
+
+	if job.State == statePaused {
+		return &schedulespb.ScheduleDescribeResponse{Paused: true}, nil
+	}
+
+	// the next run is reported by the job, later runs are calculated from its schedule
+	var next time.Time
+	if job.ScheduleTime != "" {
+		next, err = time.Parse(time.RFC3339, job.ScheduleTime)
+		if err != nil {
+			return nil, newErr(codes.Internal, "error parsing job schedule time", err)
+		}
+	}
+
+	times, err := nextFireTimes(job.Schedule, job.TimeZone, next, time.Now(), max(1, int(req.NextFireTimeCount)))
+	if err != nil {
+		return nil, newErr(codes.FailedPrecondition, "error calculating next fire times", err)
+	}
+
+	return &schedulespb.ScheduleDescribeResponse{
+		NextFireTimes: lo.Map(times, func(t time.Time, _ int) *timestamppb.Timestamp {
+			return timestamppb.New(t)
+		}),
+	}, nil
+}
+
+// New creates a schedule manager for the Cloud Scheduler jobs of the stack
+func New(resolver resource.GcpResourceResolver) (*CloudSchedulerScheduleService, error) {
+	httpClient, err := tracing.NewHttpClient(context.TODO(), option.WithScopes(cloudscheduler.CloudPlatformScope))
+	if err != nil {
+		return nil, fmt.Errorf("error creating cloud scheduler client: %w", err)
+	}
+
+	service, err := cloudscheduler.NewService(context.TODO(), option.WithHTTPClient(httpClient))
+	if err != nil {
+		return nil, fmt.Errorf("error creating cloud scheduler client: %w", err)
+	}
+
+	return &CloudSchedulerScheduleService{
+		jobs:     cloudscheduler.NewProjectsLocationsJobsService(service),
+		resolver: resolver,
+		region:   env.GCP_REGION.String(),
+	}, nil
+}
@@ -0,0 +1,79 @@
+// Copyright 2021 Nitric Technologies Pty Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package schedule
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/robfig/cron/v3"
+)
+
+// App Engine cron interval syntax, used by Cloud Scheduler for rate schedules e.g. every 5 minutes
+var everyScheduleRegexp = regexp.MustCompile(`^every\s+(\d+)\s+(minutes?|mins?|hours?|hrs?)$`)
+
+// nextFireTimes returns up to count times after from that a Cloud Scheduler job schedule fires,
+// next is the next run reported by the job, if any, which interval schedules are counted from.
+func nextFireTimes(schedule string, timezone string, next time.Time, from time.Time, count int) ([]time.Time, error) {
+	loc := time.UTC
+	if timezone != "" {
+		var err error
+		loc, err = time.LoadLocation(timezone)
+		if err != nil {
+			return nil, fmt.Errorf("invalid schedule timezone %s: %w", timezone, err)
+		}
+	}
+
+	from = from.In(loc)
+	schedule = strings.TrimSpace(schedule)
+
+	if match := everyScheduleRegexp.FindStringSubmatch(schedule); match != nil {
+		value, err := strconv.Atoi(match[1])
+		if err != nil || value < 1 {
+			return nil, fmt.Errorf("invalid interval schedule %s", schedule)
+		}
+
+		interval := time.Duration(value) * time.Minute
+		if strings.HasPrefix(match[2], "h") {
+			interval = time.Duration(value) * time.Hour
+		}
+
+		if next.IsZero() || !next.After(from) {
+			next = from.Add(interval)
+		}
+
+		times := make([]time.Time, 0, count)
+		for t := next.In(loc); len(times) < count; t = t.Add(interval) {
+			times = append(times, t)
+		}
+
+		return times, nil
+	}
+
+	cronSchedule, err := cron.ParseStandard(schedule)
+	if err != nil {
+		return nil, fmt.Errorf("unsupported schedule %s: %w", schedule, err)
+	}
+
+	times := make([]time.Time, 0, count)
+	for t := cronSchedule.Next(from); len(times) < count && !t.IsZero(); t = cronSchedule.Next(t) {
+		times = append(times, t)
+	}
+
+	return times, nil
+}
@@ -0,0 +1,69 @@
+// Copyright 2021 Nitric Technologies Pty Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package schedule
+
+import (
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("nextFireTimes", func() {
+	from := time.Date(2024, 1, 1, 10, 30, 0, 0, time.UTC)
+
+	When("The schedule is a unix-cron expression", func() {
+		It("Should calculate the times in the job timezone", func() {
+			sydney, _ := time.LoadLocation("Australia/Sydney")
+
+			times, err := nextFireTimes("0 9 * * 1-5", "Australia/Sydney", time.Time{}, from, 2)
+
+			Expect(err).ToNot(HaveOccurred())
+			Expect(times).To(HaveLen(2))
+			Expect(times[0].Equal(time.Date(2024, 1, 2, 9, 0, 0, 0, sydney))).To(BeTrue())
+			Expect(times[1].Equal(time.Date(2024, 1, 3, 9, 0, 0, 0, sydney))).To(BeTrue())
+		})
+	})
+
+	When("The schedule is an interval", func() {
+		It("Should count from the next run of the job", func() {
+			next := from.Add(3 * time.Minute)
+
+			times, err := nextFireTimes("every 5 minutes", "", next, from, 3)
+
+			Expect(err).ToNot(HaveOccurred())
+			Expect(times).To(Equal([]time.Time{
+				next,
+				next.Add(5 * time.Minute),
+				next.Add(10 * time.Minute),
+			}))
+		})
+
+		It("Should count from now when the next run isn't known", func() {
+			times, err := nextFireTimes("every 2 hours", "", time.Time{}, from, 1)
+
+			Expect(err).ToNot(HaveOccurred())
+			Expect(times).To(Equal([]time.Time{from.Add(2 * time.Hour)}))
+		})
+	})
+
+	When("The schedule is invalid", func() {
+		It("Should return an error", func() {
+			_, err := nextFireTimes("whenever", "", time.Time{}, from, 1)
+
+			Expect(err).To(HaveOccurred())
+		})
+	})
+})
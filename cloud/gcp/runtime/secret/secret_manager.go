@@ -23,6 +23,7 @@ import (
 
 	"github.com/nitrictech/nitric/cloud/common/deploy/tags"
 	"github.com/nitrictech/nitric/cloud/gcp/runtime/env"
+	"github.com/nitrictech/nitric/cloud/gcp/runtime/tracing"
 
 	secretmanager "cloud.google.com/go/secretmanager/apiv1"
 	"cloud.google.com/go/secretmanager/apiv1/secretmanagerpb"
@@ -227,7 +228,7 @@ func New() (*SecretManagerSecretService, error) {
 		return nil, fmt.Errorf("GCP credentials error: %w", credentialsError)
 	}
 
-	client, clientError := ifaces_gcloud_secret.NewClient(ctx)
+	client, clientError := ifaces_gcloud_secret.NewClient(ctx, tracing.GrpcClientOption())
 	if clientError != nil {
 		return nil, fmt.Errorf("secret manager client error: %w", clientError)
 	}
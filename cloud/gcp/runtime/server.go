@@ -23,6 +23,7 @@ import (
 	"github.com/nitrictech/nitric/cloud/gcp/runtime/keyvalue"
 	"github.com/nitrictech/nitric/cloud/gcp/runtime/queue"
 	"github.com/nitrictech/nitric/cloud/gcp/runtime/resource"
+	"github.com/nitrictech/nitric/cloud/gcp/runtime/schedule"
 	"github.com/nitrictech/nitric/cloud/gcp/runtime/secret"
 	sql_service "github.com/nitrictech/nitric/cloud/gcp/runtime/sql"
 	"github.com/nitrictech/nitric/cloud/gcp/runtime/storage"
@@ -36,6 +37,7 @@ func NewGcpRuntimeServer(resourcesPlugin resource.GcpResourceResolver, opts ...s
 	topicsPlugin, _ := topic.New(resourcesPlugin)
 	storagePlugin, _ := storage.New()
 	batchPlugin, _ := batch.New()
+	schedulePlugin, _ := schedule.New(resourcesPlugin)
 
 	queuesPlugin, _ := queue.New()
 
@@ -50,6 +52,7 @@ func NewGcpRuntimeServer(resourcesPlugin resource.GcpResourceResolver, opts ...s
 	sqlPlugin := sql_service.New()
 
 	defaultGcpOpts := []server.ServerOption{
+		server.WithProvider("gcp"),
 		server.WithKeyValuePlugin(keyValuePlugin),
 		server.WithSecretManagerPlugin(secretPlugin),
 		server.WithGatewayPlugin(gatewayPlugin),
@@ -57,6 +60,7 @@ func NewGcpRuntimeServer(resourcesPlugin resource.GcpResourceResolver, opts ...s
 		server.WithTopicsPlugin(topicsPlugin),
 		server.WithQueuesPlugin(queuesPlugin),
 		server.WithApiPlugin(apiPlugin),
+		server.WithScheduleManagerPlugin(schedulePlugin),
 		server.WithSqlPlugin(sqlPlugin),
 		server.WithBatchPlugin(batchPlugin),
 	}
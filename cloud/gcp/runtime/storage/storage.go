@@ -20,10 +20,13 @@ import (
 	"fmt"
 	"io"
 	"net/http"
+	"strconv"
+	"strings"
 	"time"
 
 	"github.com/nitrictech/nitric/cloud/common/deploy/tags"
 	"github.com/nitrictech/nitric/cloud/gcp/runtime/env"
+	"github.com/nitrictech/nitric/cloud/gcp/runtime/tracing"
 
 	"cloud.google.com/go/storage"
 	"golang.org/x/oauth2/google"
@@ -32,6 +35,7 @@ import (
 	"google.golang.org/api/iterator"
 	"google.golang.org/api/option"
 	"google.golang.org/grpc/codes"
+	"google.golang.org/protobuf/types/known/timestamppb"
 
 	content "github.com/nitrictech/nitric/cloud/common/runtime/storage"
 	ifaces_gcloud_storage "github.com/nitrictech/nitric/cloud/gcp/ifaces/gcloud_storage"
@@ -61,7 +65,8 @@ func (s *StorageStorageService) getBucketByName(bucket string) (ifaces_gcloud_st
 				return nil, fmt.Errorf("an error occurred finding bucket: %s; %w", bucket, err)
 			}
 
-			if name, ok := b.Labels[tags.GetResourceNameKey(env.GetNitricStackID())]; ok && name == bucket {
+			// cache every bucket in the stack, so lookups of other buckets (e.g. copy destinations) are found
+			if name, ok := b.Labels[tags.GetResourceNameKey(env.GetNitricStackID())]; ok {
 				s.cache[name] = s.client.Bucket(b.Name)
 			}
 		}
@@ -83,6 +88,43 @@ func isPermissionDenied(err error) bool {
 	return false
 }
 
+// isPreconditionFailed returns true if the given error is a Google API error for a precondition that wasn't met
+func isPreconditionFailed(err error) bool {
+	var ee *googleapi.Error
+	if errors.As(err, &ee) {
+		return ee.Code == http.StatusPreconditionFailed
+	}
+	return false
+}
+
+// withPreconditions returns the object handle with the generation preconditions of a request applied,
+// the etag of a GCS object is its generation so if_match must be a generation returned by Stat or ListBlobs
+func withPreconditions(obj ifaces_gcloud_storage.ObjectHandle, ifMatch string, ifNoneMatch string) (ifaces_gcloud_storage.ObjectHandle, error) {
+	if ifNoneMatch != "" {
+		return obj.If(storage.Conditions{DoesNotExist: true}), nil
+	}
+
+	if ifMatch != "" {
+		generation, err := strconv.ParseInt(strings.Trim(ifMatch, "\""), 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("etag %s is not a generation of the object: %w", ifMatch, err)
+		}
+
+		return obj.If(storage.Conditions{GenerationMatch: generation}), nil
+	}
+
+	return obj, nil
+}
+
+// isRangeNotSatisfiable returns true if the given error is a Google API error for a range outside of the object
+func isRangeNotSatisfiable(err error) bool {
+	var ee *googleapi.Error
+	if errors.As(err, &ee) {
+		return ee.Code == http.StatusRequestedRangeNotSatisfiable
+	}
+	return false
+}
+
 /**
  * Retrieves a previously stored object from a Google Cloud Storage Bucket
  */
@@ -153,8 +195,16 @@ func (s *StorageStorageService) Write(ctx context.Context, req *storagePb.Storag
 		)
 	}
 
-	writer := bucketHandle.Object(req.Key).NewWriter(ctx)
-	writer.ObjectAttrs().ContentType = content.DetectContentType(req.Key, req.Body)
+	obj, err := withPreconditions(bucketHandle.Object(req.Key), req.IfMatch, req.IfNoneMatch)
+	if err != nil {
+		return nil, newErr(codes.InvalidArgument, "invalid if_match precondition", err)
+	}
+
+	writer := obj.NewWriter(ctx)
+	attrs := writer.ObjectAttrs()
+	attrs.ContentType = content.ContentType(req.ContentType, req.Key, req.Body)
+	attrs.CacheControl = req.CacheControl
+	attrs.Metadata = req.Metadata
 
 	if _, err := writer.Write(req.Body); err != nil {
 		if isPermissionDenied(err) {
@@ -181,6 +231,10 @@ func (s *StorageStorageService) Write(ctx context.Context, req *storagePb.Storag
 			)
 		}
 
+		if isPreconditionFailed(err) {
+			return nil, newErr(codes.FailedPrecondition, "object was not written, its precondition was not met", err)
+		}
+
 		return nil, newErr(
 			codes.Internal,
 			"error closing object write",
@@ -206,7 +260,12 @@ func (s *StorageStorageService) Delete(ctx context.Context, req *storagePb.Stora
 		)
 	}
 
-	if err := bucketHandle.Object(req.Key).Delete(ctx); err != nil {
+	obj, err := withPreconditions(bucketHandle.Object(req.Key), req.IfMatch, "")
+	if err != nil {
+		return nil, newErr(codes.InvalidArgument, "invalid if_match precondition", err)
+	}
+
+	if err := obj.Delete(ctx); err != nil {
 		if isPermissionDenied(err) {
 			return nil, newErr(
 				codes.PermissionDenied,
@@ -215,6 +274,10 @@ func (s *StorageStorageService) Delete(ctx context.Context, req *storagePb.Stora
 			)
 		}
 
+		if isPreconditionFailed(err) {
+			return nil, newErr(codes.FailedPrecondition, "object was not deleted, its precondition was not met", err)
+		}
+
 		// ignore errors caused by the Object not existing.
 		// This is to unify delete behavior between providers.
 		if !errors.Is(err, storage.ErrObjectNotExist) {
@@ -266,6 +329,9 @@ func (s *StorageStorageService) PreSignUrl(ctx context.Context, req *storagePb.S
 	}, nil
 }
 
+// defaultPageSize is the number of objects listed in a page when no page size is requested, matching the GCS default
+const defaultPageSize = 1000
+
 func (s *StorageStorageService) ListBlobs(ctx context.Context, req *storagePb.StorageListBlobsRequest) (*storagePb.StorageListBlobsResponse, error) {
 	newErr := grpc_errors.ErrorsWithScope("StorageStorageService.ListFiles")
 
@@ -281,26 +347,107 @@ func (s *StorageStorageService) ListBlobs(ctx context.Context, req *storagePb.St
 	iter := bucketHandle.Objects(ctx, &storage.Query{
 		Projection: storage.ProjectionNoACL,
 		Prefix:     req.Prefix,
+		Delimiter:  req.Delimiter,
 	})
 
-	fis := make([]*storagePb.Blob, 0)
+	// Retrieve a page at a time, pagers require a positive page size so the service default is used when zero
+	pageSize := int(req.PageSize)
+	if pageSize <= 0 {
+		pageSize = defaultPageSize
+	}
+
+	// without a page size or token every page is listed, callers that don't page expect the complete listing
+	listAll := req.PageSize <= 0 && req.PageToken == ""
+
+	pager := iterator.NewPager(iter, pageSize, req.PageToken)
+	objs := make([]*storage.ObjectAttrs, 0)
+	nextPageToken := ""
+
 	for {
-		obj, err := iter.Next()
+		page := make([]*storage.ObjectAttrs, 0)
+		token, err := pager.NextPage(&page)
+		if err != nil {
+			return nil, newErr(codes.Internal, "error occurred iterating objects", err)
+		}
+
+		objs = append(objs, page...)
 
-		if errors.Is(err, iterator.Done) {
+		if token == "" {
 			break
 		}
-		if err != nil {
-			return nil, newErr(codes.Internal, "error occurred iterating objects", err)
+
+		if !listAll {
+			nextPageToken = token
+			break
 		}
+	}
 
-		fis = append(fis, &storagePb.Blob{
-			Key: obj.Name,
-		})
+	fis := make([]*storagePb.Blob, 0, len(objs))
+	prefixes := make([]string, 0)
+	for _, obj := range objs {
+		// synthetic directory entries produced by the delimiter only have a prefix
+		if obj.Prefix != "" {
+			prefixes = append(prefixes, obj.Prefix)
+			continue
+		}
+
+		fis = append(fis, toBlob(obj))
 	}
 
 	return &storagePb.StorageListBlobsResponse{
-		Blobs: fis,
+		Blobs:          fis,
+		CommonPrefixes: prefixes,
+		NextPageToken:  nextPageToken,
+	}, nil
+}
+
+// toBlob returns the nitric representation of the attributes of an object
+func toBlob(attrs *storage.ObjectAttrs) *storagePb.Blob {
+	return &storagePb.Blob{
+		Key:          attrs.Name,
+		Size:         attrs.Size,
+		Etag:         strconv.FormatInt(attrs.Generation, 10),
+		LastModified: timestamppb.New(attrs.Updated),
+		ContentType:  attrs.ContentType,
+		CacheControl: attrs.CacheControl,
+		Metadata:     attrs.Metadata,
+	}
+}
+
+/**
+ * Retrieves the attributes and metadata of an Item in a Google Cloud Storage Bucket
+ */
+func (s *StorageStorageService) Stat(ctx context.Context, req *storagePb.StorageStatRequest) (*storagePb.StorageStatResponse, error) {
+	newErr := grpc_errors.ErrorsWithScope("StorageStorageService.Stat")
+
+	bucketHandle, err := s.getBucketByName(req.BucketName)
+	if err != nil {
+		return nil, newErr(
+			codes.NotFound,
+			"unable to locate bucket",
+			err,
+		)
+	}
+
+	attrs, err := bucketHandle.Object(req.Key).Attrs(ctx)
+	if err != nil {
+		if errors.Is(err, storage.ErrObjectNotExist) {
+			return nil, newErr(codes.NotFound, "object does not exist", err)
+		}
+
+		if isPermissionDenied(err) {
+			return nil, newErr(
+				codes.PermissionDenied,
+				"unable to read object attributes, have you requested access to this bucket?",
+				err,
+			)
+		}
+
+		return nil, newErr(codes.Internal, "error calling object.Attrs", err)
+	}
+
+	return &storagePb.StorageStatResponse{
+		Blob: toBlob(attrs),
 	}, nil
 }
 
@@ -331,6 +478,214 @@ func (s *StorageStorageService) Exists(ctx context.Context, req *storagePb.Stora
 	}, nil
 }
 
+/**
+ * Streams a previously stored object, or a byte range of it, from a Google Cloud Storage Bucket in chunks
+ */
+func (s *StorageStorageService) ReadStream(req *storagePb.StorageReadStreamRequest, stream storagePb.Storage_ReadStreamServer) error {
+	newErr := grpc_errors.ErrorsWithScope("StorageStorageService.ReadStream")
+
+	bucketHandle, err := s.getBucketByName(req.BucketName)
+	if err != nil {
+		return newErr(
+			codes.NotFound,
+			"unable to locate bucket",
+			err,
+		)
+	}
+
+	// a negative length reads to the end of the object
+	length := req.Length
+	if length == 0 {
+		length = -1
+	}
+
+	reader, err := bucketHandle.Object(req.Key).NewRangeReader(stream.Context(), req.Offset, length)
+	if err != nil {
+		if isPermissionDenied(err) {
+			return newErr(
+				codes.PermissionDenied,
+				"unable to read file, have you requested access to this bucket?",
+				err,
+			)
+		}
+
+		if isRangeNotSatisfiable(err) {
+			return newErr(codes.OutOfRange, "requested range is outside of the file", err)
+		}
+
+		return newErr(
+			codes.Internal,
+			"unable to get reader for object",
+			err,
+		)
+	}
+	defer reader.Close()
+
+	if err := content.SendChunks(reader, stream); err != nil {
+		return newErr(
+			codes.Internal,
+			"error streaming object",
+			err,
+		)
+	}
+
+	return nil
+}
+
+/**
+ * Stores a new Item streamed in chunks to a Google Cloud Storage Bucket with a resumable upload
+ */
+func (s *StorageStorageService) WriteStream(stream storagePb.Storage_WriteStreamServer) error {
+	newErr := grpc_errors.ErrorsWithScope("StorageStorageService.WriteStream")
+
+	writeStream, err := content.ReceiveWriteStream(stream)
+	if err != nil {
+		return newErr(
+			codes.InvalidArgument,
+			"invalid write stream",
+			err,
+		)
+	}
+
+	bucketHandle, err := s.getBucketByName(writeStream.Header.BucketName)
+	if err != nil {
+		return newErr(
+			codes.NotFound,
+			"unable to locate bucket",
+			err,
+		)
+	}
+
+	// cancelling the writer's context abandons the upload, rather than storing the chunks received so far
+	ctx, cancel := context.WithCancel(stream.Context())
+	defer cancel()
+
+	writer := bucketHandle.Object(writeStream.Header.Key).NewWriter(ctx)
+	attrs := writer.ObjectAttrs()
+	attrs.ContentType = writeStream.ContentType()
+	attrs.CacheControl = writeStream.Header.CacheControl
+	attrs.Metadata = writeStream.Header.Metadata
+
+	if _, err := io.Copy(writer, writeStream); err != nil {
+		cancel()
+
+		if recvErr := writeStream.Err(); recvErr != nil {
+			return newErr(content.ErrorCode(recvErr, codes.Unknown), "error receiving file", recvErr)
+		}
+
+		if isPermissionDenied(err) {
+			return newErr(
+				codes.PermissionDenied,
+				"unable to write to file, have you requested access to this bucket?",
+				err,
+			)
+		}
+
+		return newErr(
+			codes.Internal,
+			"unable to write object",
+			err,
+		)
+	}
+
+	if err := writer.Close(); err != nil {
+		if isPermissionDenied(err) {
+			return newErr(
+				codes.PermissionDenied,
+				"unable to write to file, have you requested access to this bucket?",
+				err,
+			)
+		}
+
+		return newErr(
+			codes.Internal,
+			"error closing object write",
+			err,
+		)
+	}
+
+	return stream.SendAndClose(&storagePb.StorageWriteStreamResponse{Size: writeStream.Size()})
+}
+
+// copyObject copies an object server-side between the given buckets
+func copyObject(ctx context.Context, newErr grpc_errors.ScopedErrorFactory, src ifaces_gcloud_storage.BucketHandle, sourceKey string, dst ifaces_gcloud_storage.BucketHandle, destinationKey string) error {
+	if _, err := dst.Object(destinationKey).CopierFrom(src.Object(sourceKey)).Run(ctx); err != nil {
+		if isPermissionDenied(err) {
+			return newErr(
+				codes.PermissionDenied,
+				"unable to copy file, have you requested access to both buckets?",
+				err,
+			)
+		}
+
+		if errors.Is(err, storage.ErrObjectNotExist) {
+			return newErr(codes.NotFound, "source object does not exist", err)
+		}
+
+		return newErr(codes.Unknown, "error copying object", err)
+	}
+
+	return nil
+}
+
+/**
+ * Copies an Item within or between Google Cloud Storage Buckets
+ */
+func (s *StorageStorageService) Copy(ctx context.Context, req *storagePb.StorageCopyRequest) (*storagePb.StorageCopyResponse, error) {
+	newErr := grpc_errors.ErrorsWithScope("StorageStorageService.Copy")
+
+	src, err := s.getBucketByName(req.SourceBucketName)
+	if err != nil {
+		return nil, newErr(codes.NotFound, "unable to locate source bucket", err)
+	}
+
+	dst, err := s.getBucketByName(req.DestinationBucketName)
+	if err != nil {
+		return nil, newErr(codes.NotFound, "unable to locate destination bucket", err)
+	}
+
+	if err := copyObject(ctx, newErr, src, req.SourceKey, dst, req.DestinationKey); err != nil {
+		return nil, err
+	}
+
+	return &storagePb.StorageCopyResponse{}, nil
+}
+
+/**
+ * Moves an Item within or between Google Cloud Storage Buckets, by copying it and deleting the source
+ */
+func (s *StorageStorageService) Move(ctx context.Context, req *storagePb.StorageMoveRequest) (*storagePb.StorageMoveResponse, error) {
+	newErr := grpc_errors.ErrorsWithScope("StorageStorageService.Move")
+
+	src, err := s.getBucketByName(req.SourceBucketName)
+	if err != nil {
+		return nil, newErr(codes.NotFound, "unable to locate source bucket", err)
+	}
+
+	dst, err := s.getBucketByName(req.DestinationBucketName)
+	if err != nil {
+		return nil, newErr(codes.NotFound, "unable to locate destination bucket", err)
+	}
+
+	if err := copyObject(ctx, newErr, src, req.SourceKey, dst, req.DestinationKey); err != nil {
+		return nil, err
+	}
+
+	if err := src.Object(req.SourceKey).Delete(ctx); err != nil {
+		if isPermissionDenied(err) {
+			return nil, newErr(
+				codes.PermissionDenied,
+				"unable to delete source file, have you requested access to this bucket?",
+				err,
+			)
+		}
+
+		return nil, newErr(codes.Unknown, "error deleting source object", err)
+	}
+
+	return &storagePb.StorageMoveResponse{}, nil
+}
+
 /**
  * Creates a new Storage Plugin for use in GCP
  */
@@ -346,8 +701,13 @@ func New() (*StorageStorageService, error) {
 		return nil, fmt.Errorf("GCP credentials error: %w", credentialsError)
 	}
 
-	// Get the client credentials
-	client, err := storage.NewClient(ctx, option.WithCredentials(credentials))
+	httpClient, err := tracing.NewHttpClient(ctx, option.WithCredentials(credentials))
+	if err != nil {
+		return nil, fmt.Errorf("storage client error: %w", err)
+	}
+
+	// the credentials are still passed to the client, they're used to sign blob urls
+	client, err := storage.NewClient(ctx, option.WithCredentials(credentials), option.WithHTTPClient(httpClient))
 	if err != nil {
 		return nil, fmt.Errorf("storage client error: %w", err)
 	}
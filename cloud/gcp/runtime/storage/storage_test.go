@@ -15,6 +15,7 @@
 package storage_test
 
 import (
+	"bytes"
 	"context"
 	"errors"
 	"fmt"
@@ -28,6 +29,7 @@ import (
 	. "github.com/onsi/gomega"
 	"google.golang.org/api/googleapi"
 	"google.golang.org/api/iterator"
+	"google.golang.org/grpc"
 	"google.golang.org/protobuf/types/known/durationpb"
 
 	storage_mock "github.com/nitrictech/nitric/cloud/gcp/mocks/gcp_storage"
@@ -35,6 +37,104 @@ import (
 	storagePb "github.com/nitrictech/nitric/core/pkg/proto/storage/v1"
 )
 
+type mockReadStream struct {
+	grpc.ServerStream
+	chunks [][]byte
+}
+
+func (m *mockReadStream) Context() context.Context {
+	return context.TODO()
+}
+
+func (m *mockReadStream) Send(resp *storagePb.StorageReadStreamResponse) error {
+	m.chunks = append(m.chunks, resp.Chunk)
+	return nil
+}
+
+type mockWriteStream struct {
+	grpc.ServerStream
+	requests []*storagePb.StorageWriteStreamRequest
+	response *storagePb.StorageWriteStreamResponse
+}
+
+func (m *mockWriteStream) Context() context.Context {
+	return context.TODO()
+}
+
+func (m *mockWriteStream) Recv() (*storagePb.StorageWriteStreamRequest, error) {
+	if len(m.requests) == 0 {
+		return nil, io.EOF
+	}
+
+	req := m.requests[0]
+	m.requests = m.requests[1:]
+
+	return req, nil
+}
+
+func (m *mockWriteStream) SendAndClose(resp *storagePb.StorageWriteStreamResponse) error {
+	m.response = resp
+	return nil
+}
+
+// objectPage serves a single page of objects through the iterator page info, recording the requested page
+type objectPage struct {
+	objects       []*storage.ObjectAttrs
+	nextPageToken string
+
+	pageSize  int
+	pageToken string
+}
+
+func (o *objectPage) PageInfo() *iterator.PageInfo {
+	buf := []*storage.ObjectAttrs{}
+	pi, _ := iterator.NewPageInfo(func(pageSize int, pageToken string) (string, error) {
+		o.pageSize = pageSize
+		o.pageToken = pageToken
+		buf = append(buf, o.objects...)
+		return o.nextPageToken, nil
+	}, func() int {
+		return len(buf)
+	}, func() interface{} {
+		b := buf
+		buf = nil
+		return b
+	})
+
+	return pi
+}
+
+// objectPages serves consecutive pages of objects through the iterator page info, recording the requested page tokens
+type objectPages struct {
+	pages [][]*storage.ObjectAttrs
+
+	pageTokens []string
+}
+
+func (o *objectPages) PageInfo() *iterator.PageInfo {
+	buf := []*storage.ObjectAttrs{}
+	pi, _ := iterator.NewPageInfo(func(pageSize int, pageToken string) (string, error) {
+		o.pageTokens = append(o.pageTokens, pageToken)
+
+		page := len(o.pageTokens) - 1
+		buf = append(buf, o.pages[page]...)
+
+		if page+1 < len(o.pages) {
+			return fmt.Sprintf("page-%d", page+1), nil
+		}
+
+		return "", nil
+	}, func() int {
+		return len(buf)
+	}, func() interface{} {
+		b := buf
+		buf = nil
+		return b
+	})
+
+	return pi
+}
+
 var _ = Describe("Storage", func() {
 	os.Setenv("NITRIC_STACK_ID", "test-stack")
 
@@ -91,6 +191,123 @@ var _ = Describe("Storage", func() {
 				})
 			})
 
+			When("Writing an item only if it doesn't exist", func() {
+				ctrl := gomock.NewController(GinkgoT())
+				mockStorageClient := storage_mock.NewMockStorageClient(ctrl)
+				mockBucketIterator := storage_mock.NewMockBucketIterator(ctrl)
+				mockBucket := storage_mock.NewMockBucketHandle(ctrl)
+				mockObject := storage_mock.NewMockObjectHandle(ctrl)
+				mockConditionalObject := storage_mock.NewMockObjectHandle(ctrl)
+				mockWriter := storage_mock.NewMockWriter(ctrl)
+				mockStorageServer, _ := storage_service.NewWithClient(mockStorageClient)
+
+				It("Should return a failed precondition error when it exists", func() {
+					By("The bucket existing")
+					gomock.InOrder(
+						mockBucketIterator.EXPECT().Next().Return(&storage.BucketAttrs{
+							Labels: map[string]string{
+								"x-nitric-test-stack-name": "my-bucket",
+								"x-nitric-test-stack-type": "bucket",
+							},
+							Name: "my-bucket-1234",
+						}, nil),
+						mockBucketIterator.EXPECT().Next().Return(nil, iterator.Done),
+					)
+					mockStorageClient.EXPECT().Buckets(gomock.Any(), gomock.Any()).Return(mockBucketIterator)
+					mockStorageClient.EXPECT().Bucket("my-bucket-1234").Return(mockBucket)
+
+					By("The object requiring that it doesn't exist")
+					mockBucket.EXPECT().Object("manifest.json").Return(mockObject)
+					mockObject.EXPECT().If(storage.Conditions{DoesNotExist: true}).Return(mockConditionalObject)
+
+					By("The precondition failing when the write is closed")
+					mockConditionalObject.EXPECT().NewWriter(gomock.Any()).Return(mockWriter)
+					mockWriter.EXPECT().Write(gomock.Any()).Times(1)
+					mockWriter.EXPECT().ObjectAttrs().Times(1).Return(&storage.ObjectAttrs{})
+					mockWriter.EXPECT().Close().Times(1).Return(&googleapi.Error{Code: 412})
+
+					_, err := mockStorageServer.Write(context.TODO(), &storagePb.StorageWriteRequest{
+						BucketName:  "my-bucket",
+						Key:         "manifest.json",
+						Body:        []byte("{}"),
+						IfNoneMatch: "*",
+					})
+
+					By("Returning a failed precondition error")
+					Expect(err).Should(HaveOccurred())
+					Expect(err.Error()).To(ContainSubstring("FailedPrecondition"))
+
+					ctrl.Finish()
+				})
+			})
+
+			When("Writing an item matching a generation", func() {
+				ctrl := gomock.NewController(GinkgoT())
+				mockStorageClient := storage_mock.NewMockStorageClient(ctrl)
+				mockBucketIterator := storage_mock.NewMockBucketIterator(ctrl)
+				mockBucket := storage_mock.NewMockBucketHandle(ctrl)
+				mockObject := storage_mock.NewMockObjectHandle(ctrl)
+				mockConditionalObject := storage_mock.NewMockObjectHandle(ctrl)
+				mockWriter := storage_mock.NewMockWriter(ctrl)
+				mockStorageServer, _ := storage_service.NewWithClient(mockStorageClient)
+
+				It("Should write with a generation precondition", func() {
+					By("The bucket existing")
+					gomock.InOrder(
+						mockBucketIterator.EXPECT().Next().Return(&storage.BucketAttrs{
+							Labels: map[string]string{
+								"x-nitric-test-stack-name": "my-bucket",
+								"x-nitric-test-stack-type": "bucket",
+							},
+							Name: "my-bucket-1234",
+						}, nil),
+						mockBucketIterator.EXPECT().Next().Return(nil, iterator.Done),
+					)
+					mockStorageClient.EXPECT().Buckets(gomock.Any(), gomock.Any()).Return(mockBucketIterator)
+					mockStorageClient.EXPECT().Bucket("my-bucket-1234").Return(mockBucket)
+
+					By("The object requiring the etag's generation")
+					mockBucket.EXPECT().Object("manifest.json").Return(mockObject)
+					mockObject.EXPECT().If(storage.Conditions{GenerationMatch: 1709546400000000}).Return(mockConditionalObject)
+
+					By("The bytes being written")
+					mockConditionalObject.EXPECT().NewWriter(gomock.Any()).Return(mockWriter)
+					mockWriter.EXPECT().Write(gomock.Any()).Times(1)
+					mockWriter.EXPECT().ObjectAttrs().Times(1).Return(&storage.ObjectAttrs{})
+					mockWriter.EXPECT().Close().Times(1)
+
+					_, err := mockStorageServer.Write(context.TODO(), &storagePb.StorageWriteRequest{
+						BucketName: "my-bucket",
+						Key:        "manifest.json",
+						Body:       []byte("{}"),
+						IfMatch:    "1709546400000000",
+					})
+
+					By("Not returning an error")
+					Expect(err).ShouldNot(HaveOccurred())
+
+					ctrl.Finish()
+				})
+
+				It("Should not write when the etag isn't a generation", func() {
+					By("The object reference being correct")
+					mockBucket.EXPECT().Object("manifest.json").Return(mockObject)
+
+					_, err := mockStorageServer.Write(context.TODO(), &storagePb.StorageWriteRequest{
+						BucketName: "my-bucket",
+						Key:        "manifest.json",
+						Body:       []byte("{}"),
+						IfMatch:    "\"d41d8cd98f00b204e9800998ecf8427e\"",
+					})
+
+					By("Returning an invalid argument error")
+					Expect(err).Should(HaveOccurred())
+					Expect(err.Error()).To(ContainSubstring("InvalidArgument"))
+
+					ctrl.Finish()
+				})
+			})
+
 			When("Writing to a Bucket that does not exist", func() {
 				ctrl := gomock.NewController(GinkgoT())
 				mockStorageClient := storage_mock.NewMockStorageClient(ctrl)
@@ -362,6 +579,47 @@ var _ = Describe("Storage", func() {
 					})
 				})
 
+				When("The item's generation doesn't match", func() {
+					ctrl := gomock.NewController(GinkgoT())
+					mockStorageClient := storage_mock.NewMockStorageClient(ctrl)
+					mockBucketIterator := storage_mock.NewMockBucketIterator(ctrl)
+					mockBucket := storage_mock.NewMockBucketHandle(ctrl)
+					mockObject := storage_mock.NewMockObjectHandle(ctrl)
+					mockConditionalObject := storage_mock.NewMockObjectHandle(ctrl)
+					storagePlugin, _ := storage_service.NewWithClient(mockStorageClient)
+
+					It("Should not delete the item", func() {
+						By("the bucket existing")
+						gomock.InOrder(
+							mockBucketIterator.EXPECT().Next().Return(&storage.BucketAttrs{
+								Labels: map[string]string{
+									"x-nitric-test-stack-name": "test-bucket",
+									"x-nitric-test-stack-type": "bucket",
+								},
+								Name: "my-bucket-1234",
+							}, nil),
+							mockBucketIterator.EXPECT().Next().Return(nil, iterator.Done),
+						)
+						mockStorageClient.EXPECT().Buckets(gomock.Any(), gomock.Any()).Return(mockBucketIterator)
+						mockStorageClient.EXPECT().Bucket("my-bucket-1234").Return(mockBucket)
+
+						By("the delete requiring the generation")
+						mockBucket.EXPECT().Object("test-key").Return(mockObject)
+						mockObject.EXPECT().If(storage.Conditions{GenerationMatch: 1}).Return(mockConditionalObject)
+						mockConditionalObject.EXPECT().Delete(gomock.Any()).Return(&googleapi.Error{Code: 412})
+
+						_, err := storagePlugin.Delete(context.TODO(), &storagePb.StorageDeleteRequest{
+							BucketName: "test-bucket",
+							Key:        "test-key",
+							IfMatch:    "1",
+						})
+
+						By("Returning a failed precondition error")
+						Expect(err).Should(HaveOccurred())
+						Expect(err.Error()).To(ContainSubstring("FailedPrecondition"))
+					})
+				})
+
 				When("The item doesn't exist", func() {
 					ctrl := gomock.NewController(GinkgoT())
 					mockStorageClient := storage_mock.NewMockStorageClient(ctrl)
@@ -643,12 +901,18 @@ var _ = Describe("Storage", func() {
 
 				By("the bucket containing files")
 				mockBucket.EXPECT().Objects(gomock.Any(), gomock.Any()).Return(mockObjectIterator)
-				gomock.InOrder(
-					mockObjectIterator.EXPECT().Next().Return(&storage.ObjectAttrs{
-						Name: "test/test-file",
-					}, nil),
-					mockObjectIterator.EXPECT().Next().Return(nil, iterator.Done),
-				)
+				page := &objectPage{
+					objects: []*storage.ObjectAttrs{{
+						Name:        "test/test-file",
+						Size:        12,
+						Etag:        "etag",
+						Generation:  1709546400000000,
+						Updated:     time.Date(2024, time.March, 4, 10, 0, 0, 0, time.UTC),
+						ContentType: "text/css",
+						Metadata:    map[string]string{"owner": "me"},
+					}},
+				}
+				mockObjectIterator.EXPECT().PageInfo().Return(page.PageInfo())
 
 				resp, err := storagePlugin.ListBlobs(context.TODO(), &storagePb.StorageListBlobsRequest{
 					BucketName: "test-bucket",
@@ -658,11 +922,122 @@ var _ = Describe("Storage", func() {
 				By("Not returning an error")
 				Expect(err).ShouldNot(HaveOccurred())
 
+				By("Requesting a page of the default size")
+				Expect(page.pageSize).To(Equal(1000))
+
 				By("Returning a single file")
 				Expect(resp.Blobs).To(HaveLen(1))
 
 				By("The file having the returned name")
 				Expect(resp.Blobs[0].Key).To(Equal("test/test-file"))
+
+				By("The file having the returned attributes")
+				Expect(resp.Blobs[0].Size).To(Equal(int64(12)))
+				Expect(resp.Blobs[0].Etag).To(Equal("1709546400000000"))
+				Expect(resp.Blobs[0].LastModified.AsTime()).To(Equal(time.Date(2024, time.March, 4, 10, 0, 0, 0, time.UTC)))
+				Expect(resp.Blobs[0].ContentType).To(Equal("text/css"))
+				Expect(resp.Blobs[0].Metadata).To(Equal(map[string]string{"owner": "me"}))
+
+				By("Not returning a next page token")
+				Expect(resp.NextPageToken).To(BeEmpty())
+			})
+
+			It("Should return the files on every page when no page is requested", func() {
+				By("the bucket existing")
+				gomock.InOrder(
+					mockBucketIterator.EXPECT().Next().Return(&storage.BucketAttrs{
+						Labels: map[string]string{
+							"x-nitric-test-stack-name": "test-bucket",
+							"x-nitric-test-stack-type": "bucket",
+						},
+						Name: "my-bucket-1234",
+					}, nil),
+					mockBucketIterator.EXPECT().Next().Return(nil, iterator.Done),
+				)
+				mockStorageClient.EXPECT().Buckets(gomock.Any(), gomock.Any()).Return(mockBucketIterator)
+				mockStorageClient.EXPECT().Bucket("my-bucket-1234").Return(mockBucket)
+
+				By("the bucket containing more files than fit in a page")
+				mockBucket.EXPECT().Objects(gomock.Any(), gomock.Any()).Return(mockObjectIterator)
+				firstPage := make([]*storage.ObjectAttrs, 0, 1000)
+				for i := range 1000 {
+					firstPage = append(firstPage, &storage.ObjectAttrs{Name: fmt.Sprintf("file-%d", i)})
+				}
+				pages := &objectPages{
+					pages: [][]*storage.ObjectAttrs{firstPage, {{Name: "file-1000"}}},
+				}
+				mockObjectIterator.EXPECT().PageInfo().Return(pages.PageInfo())
+
+				resp, err := storagePlugin.ListBlobs(context.TODO(), &storagePb.StorageListBlobsRequest{
+					BucketName: "test-bucket",
+				})
+
+				By("Not returning an error")
+				Expect(err).ShouldNot(HaveOccurred())
+
+				By("Requesting every page")
+				Expect(pages.pageTokens).To(Equal([]string{"", "page-1"}))
+
+				By("Returning the files from every page")
+				Expect(resp.Blobs).To(HaveLen(1001))
+				Expect(resp.Blobs[1000].Key).To(Equal("file-1000"))
+
+				By("Not returning a next page token")
+				Expect(resp.NextPageToken).To(BeEmpty())
+			})
+
+			It("Should return a page of files and common prefixes", func() {
+				By("the bucket existing")
+				gomock.InOrder(
+					mockBucketIterator.EXPECT().Next().Return(&storage.BucketAttrs{
+						Labels: map[string]string{
+							"x-nitric-test-stack-name": "test-bucket",
+							"x-nitric-test-stack-type": "bucket",
+						},
+						Name: "my-bucket-1234",
+					}, nil),
+					mockBucketIterator.EXPECT().Next().Return(nil, iterator.Done),
+				)
+				mockStorageClient.EXPECT().Buckets(gomock.Any(), gomock.Any()).Return(mockBucketIterator)
+				mockStorageClient.EXPECT().Bucket("my-bucket-1234").Return(mockBucket)
+
+				By("the bucket containing files and directories")
+				mockBucket.EXPECT().Objects(gomock.Any(), &storage.Query{
+					Projection: storage.ProjectionNoACL,
+					Prefix:     "logs/",
+					Delimiter:  "/",
+				}).Return(mockObjectIterator)
+				page := &objectPage{
+					objects: []*storage.ObjectAttrs{
+						{Name: "logs/index"},
+						{Prefix: "logs/2024-03-04/"},
+					},
+					nextPageToken: "page-2",
+				}
+				mockObjectIterator.EXPECT().PageInfo().Return(page.PageInfo())
+
+				resp, err := storagePlugin.ListBlobs(context.TODO(), &storagePb.StorageListBlobsRequest{
+					BucketName: "test-bucket",
+					Prefix:     "logs/",
+					PageSize:   2,
+					PageToken:  "page-1",
+					Delimiter:  "/",
+				})
+
+				By("Not returning an error")
+				Expect(err).ShouldNot(HaveOccurred())
+
+				By("Requesting the given page")
+				Expect(page.pageSize).To(Equal(2))
+				Expect(page.pageToken).To(Equal("page-1"))
+
+				By("Returning the files and common prefixes")
+				Expect(resp.Blobs).To(HaveLen(1))
+				Expect(resp.Blobs[0].Key).To(Equal("logs/index"))
+				Expect(resp.CommonPrefixes).To(Equal([]string{"logs/2024-03-04/"}))
+
+				By("Returning the token for the next page")
+				Expect(resp.NextPageToken).To(Equal("page-2"))
 			})
 		})
 
@@ -690,6 +1065,250 @@ var _ = Describe("Storage", func() {
 		})
 	})
 
+	Context("Copy", func() {
+		When("Both buckets exist", func() {
+			ctrl := gomock.NewController(GinkgoT())
+			mockStorageClient := storage_mock.NewMockStorageClient(ctrl)
+			mockBucketIterator := storage_mock.NewMockBucketIterator(ctrl)
+			mockSource := storage_mock.NewMockBucketHandle(ctrl)
+			mockDestination := storage_mock.NewMockBucketHandle(ctrl)
+			mockSourceObject := storage_mock.NewMockObjectHandle(ctrl)
+			mockDestinationObject := storage_mock.NewMockObjectHandle(ctrl)
+			mockCopier := storage_mock.NewMockCopier(ctrl)
+			storagePlugin, _ := storage_service.NewWithClient(mockStorageClient)
+
+			It("Should copy the object between the buckets", func() {
+				By("the buckets existing")
+				gomock.InOrder(
+					mockBucketIterator.EXPECT().Next().Return(&storage.BucketAttrs{
+						Labels: map[string]string{
+							"x-nitric-test-stack-name": "incoming",
+							"x-nitric-test-stack-type": "bucket",
+						},
+						Name: "incoming-1234",
+					}, nil),
+					mockBucketIterator.EXPECT().Next().Return(&storage.BucketAttrs{
+						Labels: map[string]string{
+							"x-nitric-test-stack-name": "processed",
+							"x-nitric-test-stack-type": "bucket",
+						},
+						Name: "processed-1234",
+					}, nil),
+					mockBucketIterator.EXPECT().Next().Return(nil, iterator.Done),
+				)
+				mockStorageClient.EXPECT().Buckets(gomock.Any(), gomock.Any()).Return(mockBucketIterator)
+				mockStorageClient.EXPECT().Bucket("incoming-1234").Return(mockSource)
+				mockStorageClient.EXPECT().Bucket("processed-1234").Return(mockDestination)
+
+				By("copying the source object to the destination object")
+				mockSource.EXPECT().Object("test-key").Return(mockSourceObject)
+				mockDestination.EXPECT().Object("done/test-key").Return(mockDestinationObject)
+				mockDestinationObject.EXPECT().CopierFrom(mockSourceObject).Return(mockCopier)
+				mockCopier.EXPECT().Run(gomock.Any()).Return(&storage.ObjectAttrs{Name: "done/test-key"}, nil)
+
+				_, err := storagePlugin.Copy(context.TODO(), &storagePb.StorageCopyRequest{
+					SourceBucketName:      "incoming",
+					SourceKey:             "test-key",
+					DestinationBucketName: "processed",
+					DestinationKey:        "done/test-key",
+				})
+
+				By("Not returning an error")
+				Expect(err).ShouldNot(HaveOccurred())
+			})
+		})
+
+		When("The source object does not exist", func() {
+			ctrl := gomock.NewController(GinkgoT())
+			mockStorageClient := storage_mock.NewMockStorageClient(ctrl)
+			mockBucketIterator := storage_mock.NewMockBucketIterator(ctrl)
+			mockBucket := storage_mock.NewMockBucketHandle(ctrl)
+			mockSourceObject := storage_mock.NewMockObjectHandle(ctrl)
+			mockDestinationObject := storage_mock.NewMockObjectHandle(ctrl)
+			mockCopier := storage_mock.NewMockCopier(ctrl)
+			storagePlugin, _ := storage_service.NewWithClient(mockStorageClient)
+
+			It("Should return a not found error", func() {
+				By("the bucket existing")
+				gomock.InOrder(
+					mockBucketIterator.EXPECT().Next().Return(&storage.BucketAttrs{
+						Labels: map[string]string{
+							"x-nitric-test-stack-name": "test-bucket",
+							"x-nitric-test-stack-type": "bucket",
+						},
+						Name: "my-bucket-1234",
+					}, nil),
+					mockBucketIterator.EXPECT().Next().Return(nil, iterator.Done),
+				)
+				mockStorageClient.EXPECT().Buckets(gomock.Any(), gomock.Any()).Return(mockBucketIterator)
+				mockStorageClient.EXPECT().Bucket("my-bucket-1234").Return(mockBucket)
+
+				By("the source object not existing")
+				mockBucket.EXPECT().Object("missing").Return(mockSourceObject)
+				mockBucket.EXPECT().Object("copy").Return(mockDestinationObject)
+				mockDestinationObject.EXPECT().CopierFrom(mockSourceObject).Return(mockCopier)
+				mockCopier.EXPECT().Run(gomock.Any()).Return(nil, storage.ErrObjectNotExist)
+
+				_, err := storagePlugin.Copy(context.TODO(), &storagePb.StorageCopyRequest{
+					SourceBucketName:      "test-bucket",
+					SourceKey:             "missing",
+					DestinationBucketName: "test-bucket",
+					DestinationKey:        "copy",
+				})
+
+				By("Returning a not found error")
+				Expect(err).Should(HaveOccurred())
+				Expect(err.Error()).To(ContainSubstring("NotFound"))
+			})
+		})
+	})
+
+	Context("Move", func() {
+		When("The bucket exists", func() {
+			ctrl := gomock.NewController(GinkgoT())
+			mockStorageClient := storage_mock.NewMockStorageClient(ctrl)
+			mockBucketIterator := storage_mock.NewMockBucketIterator(ctrl)
+			mockBucket := storage_mock.NewMockBucketHandle(ctrl)
+			mockSourceObject := storage_mock.NewMockObjectHandle(ctrl)
+			mockDestinationObject := storage_mock.NewMockObjectHandle(ctrl)
+			mockCopier := storage_mock.NewMockCopier(ctrl)
+			storagePlugin, _ := storage_service.NewWithClient(mockStorageClient)
+
+			It("Should copy the object and delete the source", func() {
+				By("the bucket existing")
+				gomock.InOrder(
+					mockBucketIterator.EXPECT().Next().Return(&storage.BucketAttrs{
+						Labels: map[string]string{
+							"x-nitric-test-stack-name": "test-bucket",
+							"x-nitric-test-stack-type": "bucket",
+						},
+						Name: "my-bucket-1234",
+					}, nil),
+					mockBucketIterator.EXPECT().Next().Return(nil, iterator.Done),
+				)
+				mockStorageClient.EXPECT().Buckets(gomock.Any(), gomock.Any()).Return(mockBucketIterator)
+				mockStorageClient.EXPECT().Bucket("my-bucket-1234").Return(mockBucket)
+
+				By("copying the object then deleting the source")
+				mockBucket.EXPECT().Object("old-key").Times(2).Return(mockSourceObject)
+				mockBucket.EXPECT().Object("new-key").Return(mockDestinationObject)
+				gomock.InOrder(
+					mockDestinationObject.EXPECT().CopierFrom(mockSourceObject).Return(mockCopier),
+					mockCopier.EXPECT().Run(gomock.Any()).Return(&storage.ObjectAttrs{Name: "new-key"}, nil),
+					mockSourceObject.EXPECT().Delete(gomock.Any()).Return(nil),
+				)
+
+				_, err := storagePlugin.Move(context.TODO(), &storagePb.StorageMoveRequest{
+					SourceBucketName:      "test-bucket",
+					SourceKey:             "old-key",
+					DestinationBucketName: "test-bucket",
+					DestinationKey:        "new-key",
+				})
+
+				By("Not returning an error")
+				Expect(err).ShouldNot(HaveOccurred())
+			})
+		})
+	})
+
+	Context("Stat", func() {
+		When("The object exists", func() {
+			ctrl := gomock.NewController(GinkgoT())
+			mockStorageClient := storage_mock.NewMockStorageClient(ctrl)
+			mockBucketIterator := storage_mock.NewMockBucketIterator(ctrl)
+			mockBucket := storage_mock.NewMockBucketHandle(ctrl)
+			mockObject := storage_mock.NewMockObjectHandle(ctrl)
+			storagePlugin, _ := storage_service.NewWithClient(mockStorageClient)
+
+			It("Should return its attributes and metadata", func() {
+				By("the bucket existing")
+				gomock.InOrder(
+					mockBucketIterator.EXPECT().Next().Return(&storage.BucketAttrs{
+						Labels: map[string]string{
+							"x-nitric-test-stack-name": "test-bucket",
+							"x-nitric-test-stack-type": "bucket",
+						},
+						Name: "my-bucket-1234",
+					}, nil),
+					mockBucketIterator.EXPECT().Next().Return(nil, iterator.Done),
+				)
+				mockStorageClient.EXPECT().Buckets(gomock.Any(), gomock.Any()).Return(mockBucketIterator)
+				mockStorageClient.EXPECT().Bucket("my-bucket-1234").Return(mockBucket)
+
+				By("the object existing")
+				mockBucket.EXPECT().Object("test-key").Return(mockObject)
+				mockObject.EXPECT().Attrs(gomock.Any()).Times(1).Return(&storage.ObjectAttrs{
+					Name:         "test-key",
+					Size:         12,
+					Etag:         "etag",
+					Generation:   1709546400000000,
+					Updated:      time.Date(2024, time.March, 4, 10, 0, 0, 0, time.UTC),
+					ContentType:  "text/css",
+					CacheControl: "max-age=60",
+					Metadata:     map[string]string{"owner": "me"},
+				}, nil)
+
+				resp, err := storagePlugin.Stat(context.TODO(), &storagePb.StorageStatRequest{
+					BucketName: "test-bucket",
+					Key:        "test-key",
+				})
+
+				By("Not returning an error")
+				Expect(err).ShouldNot(HaveOccurred())
+
+				By("Returning the attributes of the object")
+				Expect(resp.Blob.Key).To(Equal("test-key"))
+				Expect(resp.Blob.Size).To(Equal(int64(12)))
+				Expect(resp.Blob.Etag).To(Equal("1709546400000000"))
+				Expect(resp.Blob.ContentType).To(Equal("text/css"))
+				Expect(resp.Blob.CacheControl).To(Equal("max-age=60"))
+				Expect(resp.Blob.Metadata).To(Equal(map[string]string{"owner": "me"}))
+
+				ctrl.Finish()
+			})
+		})
+
+		When("The object does not exist", func() {
+			ctrl := gomock.NewController(GinkgoT())
+			mockStorageClient := storage_mock.NewMockStorageClient(ctrl)
+			mockBucketIterator := storage_mock.NewMockBucketIterator(ctrl)
+			mockBucket := storage_mock.NewMockBucketHandle(ctrl)
+			mockObject := storage_mock.NewMockObjectHandle(ctrl)
+			storagePlugin, _ := storage_service.NewWithClient(mockStorageClient)
+
+			It("Should return a not found error", func() {
+				By("the bucket existing")
+				gomock.InOrder(
+					mockBucketIterator.EXPECT().Next().Return(&storage.BucketAttrs{
+						Labels: map[string]string{
+							"x-nitric-test-stack-name": "test-bucket",
+							"x-nitric-test-stack-type": "bucket",
+						},
+						Name: "my-bucket-1234",
+					}, nil),
+					mockBucketIterator.EXPECT().Next().Return(nil, iterator.Done),
+				)
+				mockStorageClient.EXPECT().Buckets(gomock.Any(), gomock.Any()).Return(mockBucketIterator)
+				mockStorageClient.EXPECT().Bucket("my-bucket-1234").Return(mockBucket)
+
+				By("the object not existing")
+				mockBucket.EXPECT().Object("test-key").Return(mockObject)
+				mockObject.EXPECT().Attrs(gomock.Any()).Times(1).Return(nil, storage.ErrObjectNotExist)
+
+				_, err := storagePlugin.Stat(context.TODO(), &storagePb.StorageStatRequest{
+					BucketName: "test-bucket",
+					Key:        "test-key",
+				})
+
+				By("Returning a not found error")
+				Expect(err).Should(HaveOccurred())
+				Expect(err.Error()).To(ContainSubstring("NotFound"))
+
+				ctrl.Finish()
+			})
+		})
+	})
+
 	Context("Exists", func() {
 		When("The bucket exists", func() {
 			When("The item exists", func() {
@@ -825,4 +1444,114 @@ var _ = Describe("Storage", func() {
 			})
 		})
 	})
+	Context("ReadStream", func() {
+		When("Reading a byte range of an object", func() {
+			ctrl := gomock.NewController(GinkgoT())
+			mockStorageClient := storage_mock.NewMockStorageClient(ctrl)
+			mockBucketIterator := storage_mock.NewMockBucketIterator(ctrl)
+			mockBucket := storage_mock.NewMockBucketHandle(ctrl)
+			mockObject := storage_mock.NewMockObjectHandle(ctrl)
+			mockStorageServer, _ := storage_service.NewWithClient(mockStorageClient)
+
+			It("Should stream the range of the object", func() {
+				By("The bucket existing")
+				gomock.InOrder(
+					mockBucketIterator.EXPECT().Next().Return(&storage.BucketAttrs{
+						Labels: map[string]string{
+							"x-nitric-test-stack-name": "my-bucket",
+							"x-nitric-test-stack-type": "bucket",
+						},
+						Name: "my-bucket-1234",
+					}, nil),
+					mockBucketIterator.EXPECT().Next().Return(nil, iterator.Done),
+				)
+				mockStorageClient.EXPECT().Buckets(gomock.Any(), gomock.Any()).Return(mockBucketIterator)
+				mockStorageClient.EXPECT().Bucket("my-bucket-1234").Return(mockBucket)
+
+				By("The object reference being correct")
+				mockBucket.EXPECT().Object("test-file").Return(mockObject)
+
+				By("Reading the requested range")
+				mockObject.EXPECT().NewRangeReader(gomock.Any(), int64(10), int64(5)).Return(io.NopCloser(bytes.NewReader([]byte("range"))), nil)
+
+				stream := &mockReadStream{}
+				err := mockStorageServer.ReadStream(&storagePb.StorageReadStreamRequest{
+					BucketName: "my-bucket",
+					Key:        "test-file",
+					Offset:     10,
+					Length:     5,
+				}, stream)
+
+				By("Not returning an error")
+				Expect(err).ShouldNot(HaveOccurred())
+
+				By("Sending the range")
+				Expect(stream.chunks).To(Equal([][]byte{[]byte("range")}))
+
+				ctrl.Finish()
+			})
+		})
+	})
+
+	Context("WriteStream", func() {
+		When("Writing a stream to a bucket that exists", func() {
+			ctrl := gomock.NewController(GinkgoT())
+			mockStorageClient := storage_mock.NewMockStorageClient(ctrl)
+			mockBucketIterator := storage_mock.NewMockBucketIterator(ctrl)
+			mockBucket := storage_mock.NewMockBucketHandle(ctrl)
+			mockObject := storage_mock.NewMockObjectHandle(ctrl)
+			mockWriter := storage_mock.NewMockWriter(ctrl)
+			mockStorageServer, _ := storage_service.NewWithClient(mockStorageClient)
+
+			It("Should store the streamed chunks", func() {
+				By("The bucket existing")
+				gomock.InOrder(
+					mockBucketIterator.EXPECT().Next().Return(&storage.BucketAttrs{
+						Labels: map[string]string{
+							"x-nitric-test-stack-name": "my-bucket",
+							"x-nitric-test-stack-type": "bucket",
+						},
+						Name: "my-bucket-1234",
+					}, nil),
+					mockBucketIterator.EXPECT().Next().Return(nil, iterator.Done),
+				)
+				mockStorageClient.EXPECT().Buckets(gomock.Any(), gomock.Any()).Return(mockBucketIterator)
+				mockStorageClient.EXPECT().Bucket("my-bucket-1234").Return(mockBucket)
+
+				By("The object reference being correct")
+				mockBucket.EXPECT().Object("test-file").Return(mockObject)
+
+				By("The writer being called on the object handle")
+				mockObject.EXPECT().NewWriter(gomock.Any()).Return(mockWriter)
+				mockWriter.EXPECT().ObjectAttrs().Times(1).Return(&storage.ObjectAttrs{})
+
+				By("The chunks being written")
+				written := []byte{}
+				mockWriter.EXPECT().Write(gomock.Any()).AnyTimes().DoAndReturn(func(p []byte) (int, error) {
+					written = append(written, p...)
+					return len(p), nil
+				})
+				mockWriter.EXPECT().Close().Times(1)
+
+				stream := &mockWriteStream{
+					requests: []*storagePb.StorageWriteStreamRequest{
+						{Content: &storagePb.StorageWriteStreamRequest_Header{Header: &storagePb.StorageWriteStreamHeader{BucketName: "my-bucket", Key: "test-file"}}},
+						{Content: &storagePb.StorageWriteStreamRequest_Chunk{Chunk: []byte("hello ")}},
+						{Content: &storagePb.StorageWriteStreamRequest_Chunk{Chunk: []byte("world")}},
+					},
+				}
+
+				err := mockStorageServer.WriteStream(stream)
+
+				By("Not returning an error")
+				Expect(err).ShouldNot(HaveOccurred())
+
+				By("Storing the chunks in order")
+				Expect(string(written)).To(Equal("hello world"))
+				Expect(stream.response.Size).To(Equal(int64(11)))
+
+				ctrl.Finish()
+			})
+		})
+	})
 })
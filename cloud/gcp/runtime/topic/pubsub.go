@@ -28,6 +28,7 @@ import (
 	"google.golang.org/protobuf/proto"
 
 	"github.com/nitrictech/nitric/cloud/common/deploy/tags"
+	gcptracing "github.com/nitrictech/nitric/cloud/gcp/runtime/tracing"
 
 	cloudtasks "cloud.google.com/go/cloudtasks/apiv2"
 	tasks "cloud.google.com/go/cloudtasks/apiv2/cloudtaskspb"
@@ -45,6 +46,8 @@ import (
 	grpc_errors "github.com/nitrictech/nitric/core/pkg/grpc/errors"
 	"github.com/nitrictech/nitric/core/pkg/help"
 	topicpb "github.com/nitrictech/nitric/core/pkg/proto/topics/v1"
+	"github.com/nitrictech/nitric/core/pkg/tracing"
+	"github.com/nitrictech/nitric/core/pkg/workers/topics"
 )
 
 type PubsubEventService struct {
@@ -94,8 +97,9 @@ func (s *PubsubEventService) getPubsubTopicFromName(topic string) (ifaces_pubsub
 }
 
 type httpPubsubMessage struct {
-	Attributes map[string]string `json:"attributes"`
-	Data       []byte            `json:"data"`
+	Attributes  map[string]string `json:"attributes"`
+	Data        []byte            `json:"data"`
+	OrderingKey string            `json:"orderingKey,omitempty"`
 }
 
 type httpPubsubMessages struct {
@@ -110,6 +114,10 @@ func (s *PubsubEventService) publish(ctx context.Context, topic string, pubsubMs
 	}
 
 	_, err = pubsubTopic.Publish(ctx, msg).Get(ctx)
+	if err != nil && pubsubMsg.OrderingKey != "" {
+		// publishing is paused for an ordering key after a failure, until it's resumed
+		pubsubTopic.ResumePublish(pubsubMsg.OrderingKey)
+	}
 
 	return err
 }
@@ -124,8 +132,9 @@ func (s *PubsubEventService) publishDelayed(ctx context.Context, topic string, d
 
 	body := httpPubsubMessages{
 		Messages: []httpPubsubMessage{{
-			Attributes: pubsubMsg.Attributes,
-			Data:       pubsubMsg.Data,
+			Attributes:  pubsubMsg.Attributes,
+			Data:        pubsubMsg.Data,
+			OrderingKey: pubsubMsg.OrderingKey,
 		}},
 	}
 
@@ -181,11 +190,17 @@ func (s *PubsubEventService) Publish(ctx context.Context, req *topicpb.TopicPubl
 		"x-nitric-topic": req.TopicName,
 	}
 
+	for name, value := range topics.PublishAttributes(req) {
+		attributes[name] = value
+	}
+
 	propagator.CloudTraceFormatPropagator{}.Inject(ctx, attributes)
+	tracing.Propagator.Inject(ctx, attributes)
 
 	pubsubMsg := &pubsub.Message{
-		Attributes: attributes,
-		Data:       messageBytes,
+		Attributes:  attributes,
+		Data:        messageBytes,
+		OrderingKey: req.OrderingKey,
 	}
 
 	if delay > 0 {
@@ -221,12 +236,12 @@ func New(provider resource.GcpResourceResolver) (topicpb.TopicsServer, error) {
 		return nil, fmt.Errorf("GCP credentials error: %w", err)
 	}
 
-	client, err := pubsub.NewClient(ctx, credentials.ProjectID)
+	client, err := pubsub.NewClient(ctx, credentials.ProjectID, gcptracing.GrpcClientOption())
 	if err != nil {
 		return nil, fmt.Errorf("pubsub client error: %w", err)
 	}
 
-	tasksClient, err := cloudtasks.NewClient(ctx)
+	tasksClient, err := cloudtasks.NewClient(ctx, gcptracing.GrpcClientOption())
 	if err != nil {
 		return nil, fmt.Errorf("cloudtasks client error: %w", err)
 	}
@@ -31,6 +31,7 @@ import (
 	"google.golang.org/protobuf/types/known/durationpb"
 	"google.golang.org/protobuf/types/known/structpb"
 
+	ifaces_pubsub "github.com/nitrictech/nitric/cloud/gcp/ifaces/pubsub"
 	mock_cloudtasks "github.com/nitrictech/nitric/cloud/gcp/mocks/cloudtasks"
 	mock_core "github.com/nitrictech/nitric/cloud/gcp/mocks/provider"
 	mock_pubsub "github.com/nitrictech/nitric/cloud/gcp/mocks/pubsub"
@@ -121,6 +122,57 @@ var _ = Describe("Pubsub Plugin", func() {
 		})
 	})
 
+	When("Publishing a message with an ordering key fails", func() {
+		payload, _ := structpb.NewStruct(map[string]interface{}{"Test": "test"})
+		message := &topicpb.TopicMessage{
+			Content: &topicpb.TopicMessage_StructPayload{
+				StructPayload: payload,
+			},
+		}
+
+		ctrl := gomock.NewController(GinkgoT())
+		pubsubClient := mock_pubsub.NewMockPubsubClient(ctrl)
+		mockTopic := mock_pubsub.NewMockTopic(ctrl)
+		mockIterator := mock_pubsub.NewMockTopicIterator(ctrl)
+		mockPublishResult := mock_pubsub.NewMockPublishResult(ctrl)
+		pubsubPlugin, _ := pubsub_service.NewWithClient(nil, pubsubClient, nil)
+
+		It("should resume publishing for the ordering key", func() {
+			By("the topic existing")
+			pubsubClient.EXPECT().Topics(gomock.Any()).Return(mockIterator)
+			gomock.InOrder(
+				mockIterator.EXPECT().Next().Return(mockTopic, nil),
+				mockIterator.EXPECT().Next().Return(nil, iterator.Done),
+			)
+
+			mockTopic.EXPECT().Labels(gomock.Any()).Return(map[string]string{
+				"x-nitric-test-stack-name": "Test",
+				"x-nitric-test-stack-type": "topic",
+			}, nil)
+
+			By("publishing the message with its ordering key and attributes")
+			mockTopic.EXPECT().Publish(gomock.Any(), gomock.Any()).DoAndReturn(func(ctx context.Context, msg ifaces_pubsub.Message) ifaces_pubsub.PublishResult {
+				Expect(msg.Attributes()).To(HaveKeyWithValue("kind", "created"))
+				Expect(msg.Attributes()).To(HaveKeyWithValue("x-nitric-ordering-key", "order-1"))
+
+				return mockPublishResult
+			})
+			mockPublishResult.EXPECT().Get(gomock.Any()).Return("", status.Error(codes.Unavailable, "unavailable"))
+
+			By("resuming publishing for the ordering key")
+			mockTopic.EXPECT().ResumePublish("order-1")
+
+			_, err := pubsubPlugin.Publish(context.TODO(), &topicpb.TopicPublishRequest{
+				TopicName:   "Test",
+				Message:     message,
+				Attributes:  map[string]string{"kind": "created"},
+				OrderingKey: "order-1",
+			})
+
+			Expect(err).Should(HaveOccurred())
+		})
+	})
+
 	When("Publishing Delayed Messages", func() {
 		payload, _ := structpb.NewStruct(map[string]interface{}{"Test": "test"})
 		message := &topicpb.TopicMessage{
@@ -0,0 +1,41 @@
+// Copyright 2021 Nitric Technologies Pty Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tracing
+
+import (
+	"context"
+	"net/http"
+
+	"go.opentelemetry.io/contrib/instrumentation/google.golang.org/grpc/otelgrpc"
+	"go.opentelemetry.io/contrib/instrumentation/net/http/otelhttp"
+	"google.golang.org/api/option"
+	htransport "google.golang.org/api/transport/http"
+	"google.golang.org/grpc"
+)
+
+// GrpcClientOption instruments a gRPC GCP API client, creating a span around each call
+func GrpcClientOption() option.ClientOption {
+	return option.WithGRPCDialOption(grpc.WithStatsHandler(otelgrpc.NewClientHandler()))
+}
+
+// NewHttpClient returns an authenticated HTTP client for REST GCP API clients, creating a span around each request
+func NewHttpClient(ctx context.Context, opts ...option.ClientOption) (*http.Client, error) {
+	transport, err := htransport.NewTransport(ctx, http.DefaultTransport, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	return &http.Client{Transport: otelhttp.NewTransport(transport)}, nil
+}
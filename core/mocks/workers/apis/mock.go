@@ -6,10 +6,12 @@ package mock_apis
 
 import (
 	context "context"
+	io "io"
 	reflect "reflect"
 
 	gomock "github.com/golang/mock/gomock"
 	apispb "github.com/nitrictech/nitric/core/pkg/proto/apis/v1"
+	workers "github.com/nitrictech/nitric/core/pkg/workers"
 )
 
 // MockApiRequestHandler is a mock of ApiRequestHandler interface.
@@ -51,18 +53,34 @@ func (mr *MockApiRequestHandlerMockRecorder) ApiDetails(arg0, arg1 interface{})
 }
 
 // HandleRequest mocks base method.
-func (m *MockApiRequestHandler) HandleRequest(arg0 string, arg1 *apispb.ServerMessage) (*apispb.ClientMessage, error) {
+func (m *MockApiRequestHandler) HandleRequest(arg0 context.Context, arg1 string, arg2 *apispb.ServerMessage) (*apispb.ClientMessage, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "HandleRequest", arg0, arg1)
+	ret := m.ctrl.Call(m, "HandleRequest", arg0, arg1, arg2)
 	ret0, _ := ret[0].(*apispb.ClientMessage)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // HandleRequest indicates an expected call of HandleRequest.
-func (mr *MockApiRequestHandlerMockRecorder) HandleRequest(arg0, arg1 interface{}) *gomock.Call {
+func (mr *MockApiRequestHandlerMockRecorder) HandleRequest(arg0, arg1, arg2 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HandleRequest", reflect.TypeOf((*MockApiRequestHandler)(nil).HandleRequest), arg0, arg1)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HandleRequest", reflect.TypeOf((*MockApiRequestHandler)(nil).HandleRequest), arg0, arg1, arg2)
+}
+
+// HandleStreamingRequest mocks base method.
+func (m *MockApiRequestHandler) HandleStreamingRequest(arg0 context.Context, arg1 string, arg2 *apispb.ServerMessage, arg3 io.Reader) (*apispb.HttpResponse, io.ReadCloser, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "HandleStreamingRequest", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(*apispb.HttpResponse)
+	ret1, _ := ret[1].(io.ReadCloser)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// HandleStreamingRequest indicates an expected call of HandleStreamingRequest.
+func (mr *MockApiRequestHandlerMockRecorder) HandleStreamingRequest(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HandleStreamingRequest", reflect.TypeOf((*MockApiRequestHandler)(nil).HandleStreamingRequest), arg0, arg1, arg2, arg3)
 }
 
 // Serve mocks base method.
@@ -92,3 +110,17 @@ func (mr *MockApiRequestHandlerMockRecorder) WorkerCount() *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WorkerCount", reflect.TypeOf((*MockApiRequestHandler)(nil).WorkerCount))
 }
+
+// Workers mocks base method.
+func (m *MockApiRequestHandler) Workers() []workers.WorkerInfo {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Workers")
+	ret0, _ := ret[0].([]workers.WorkerInfo)
+	return ret0
+}
+
+// Workers indicates an expected call of Workers.
+func (mr *MockApiRequestHandlerMockRecorder) Workers() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Workers", reflect.TypeOf((*MockApiRequestHandler)(nil).Workers))
+}
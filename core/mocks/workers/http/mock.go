@@ -9,6 +9,7 @@ import (
 
 	gomock "github.com/golang/mock/gomock"
 	httppb "github.com/nitrictech/nitric/core/pkg/proto/http/v1"
+	workers "github.com/nitrictech/nitric/core/pkg/workers"
 	fasthttp "github.com/valyala/fasthttp"
 )
 
@@ -77,3 +78,17 @@ func (mr *MockHttpRequestHandlerMockRecorder) WorkerCount() *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WorkerCount", reflect.TypeOf((*MockHttpRequestHandler)(nil).WorkerCount))
 }
+
+// Workers mocks base method.
+func (m *MockHttpRequestHandler) Workers() []workers.WorkerInfo {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Workers")
+	ret0, _ := ret[0].([]workers.WorkerInfo)
+	return ret0
+}
+
+// Workers indicates an expected call of Workers.
+func (mr *MockHttpRequestHandlerMockRecorder) Workers() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Workers", reflect.TypeOf((*MockHttpRequestHandler)(nil).Workers))
+}
@@ -5,10 +5,12 @@
 package mock_schedules
 
 import (
+	context "context"
 	reflect "reflect"
 
 	gomock "github.com/golang/mock/gomock"
 	schedulespb "github.com/nitrictech/nitric/core/pkg/proto/schedules/v1"
+	workers "github.com/nitrictech/nitric/core/pkg/workers"
 )
 
 // MockScheduleRequestHandler is a mock of ScheduleRequestHandler interface.
@@ -35,18 +37,18 @@ func (m *MockScheduleRequestHandler) EXPECT() *MockScheduleRequestHandlerMockRec
 }
 
 // HandleRequest mocks base method.
-func (m *MockScheduleRequestHandler) HandleRequest(arg0 *schedulespb.ServerMessage) (*schedulespb.ClientMessage, error) {
+func (m *MockScheduleRequestHandler) HandleRequest(arg0 context.Context, arg1 *schedulespb.ServerMessage) (*schedulespb.ClientMessage, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "HandleRequest", arg0)
+	ret := m.ctrl.Call(m, "HandleRequest", arg0, arg1)
 	ret0, _ := ret[0].(*schedulespb.ClientMessage)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // HandleRequest indicates an expected call of HandleRequest.
-func (mr *MockScheduleRequestHandlerMockRecorder) HandleRequest(arg0 interface{}) *gomock.Call {
+func (mr *MockScheduleRequestHandlerMockRecorder) HandleRequest(arg0, arg1 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HandleRequest", reflect.TypeOf((*MockScheduleRequestHandler)(nil).HandleRequest), arg0)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HandleRequest", reflect.TypeOf((*MockScheduleRequestHandler)(nil).HandleRequest), arg0, arg1)
 }
 
 // Schedule mocks base method.
@@ -76,3 +78,17 @@ func (mr *MockScheduleRequestHandlerMockRecorder) WorkerCount() *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WorkerCount", reflect.TypeOf((*MockScheduleRequestHandler)(nil).WorkerCount))
 }
+
+// Workers mocks base method.
+func (m *MockScheduleRequestHandler) Workers() []workers.WorkerInfo {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Workers")
+	ret0, _ := ret[0].([]workers.WorkerInfo)
+	return ret0
+}
+
+// Workers indicates an expected call of Workers.
+func (mr *MockScheduleRequestHandlerMockRecorder) Workers() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Workers", reflect.TypeOf((*MockScheduleRequestHandler)(nil).Workers))
+}
@@ -5,10 +5,12 @@
 package mock_storage
 
 import (
+	context "context"
 	reflect "reflect"
 
 	gomock "github.com/golang/mock/gomock"
 	storagepb "github.com/nitrictech/nitric/core/pkg/proto/storage/v1"
+	workers "github.com/nitrictech/nitric/core/pkg/workers"
 )
 
 // MockBucketRequestHandler is a mock of BucketRequestHandler interface.
@@ -35,18 +37,18 @@ func (m *MockBucketRequestHandler) EXPECT() *MockBucketRequestHandlerMockRecorde
 }
 
 // HandleRequest mocks base method.
-func (m *MockBucketRequestHandler) HandleRequest(arg0 *storagepb.ServerMessage) (*storagepb.ClientMessage, error) {
+func (m *MockBucketRequestHandler) HandleRequest(arg0 context.Context, arg1 *storagepb.ServerMessage) (*storagepb.ClientMessage, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "HandleRequest", arg0)
+	ret := m.ctrl.Call(m, "HandleRequest", arg0, arg1)
 	ret0, _ := ret[0].(*storagepb.ClientMessage)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // HandleRequest indicates an expected call of HandleRequest.
-func (mr *MockBucketRequestHandlerMockRecorder) HandleRequest(arg0 interface{}) *gomock.Call {
+func (mr *MockBucketRequestHandlerMockRecorder) HandleRequest(arg0, arg1 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HandleRequest", reflect.TypeOf((*MockBucketRequestHandler)(nil).HandleRequest), arg0)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HandleRequest", reflect.TypeOf((*MockBucketRequestHandler)(nil).HandleRequest), arg0, arg1)
 }
 
 // Listen mocks base method.
@@ -76,3 +78,17 @@ func (mr *MockBucketRequestHandlerMockRecorder) WorkerCount() *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WorkerCount", reflect.TypeOf((*MockBucketRequestHandler)(nil).WorkerCount))
 }
+
+// Workers mocks base method.
+func (m *MockBucketRequestHandler) Workers() []workers.WorkerInfo {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Workers")
+	ret0, _ := ret[0].([]workers.WorkerInfo)
+	return ret0
+}
+
+// Workers indicates an expected call of Workers.
+func (mr *MockBucketRequestHandlerMockRecorder) Workers() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Workers", reflect.TypeOf((*MockBucketRequestHandler)(nil).Workers))
+}
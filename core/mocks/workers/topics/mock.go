@@ -5,10 +5,12 @@
 package mock_topics
 
 import (
+	context "context"
 	reflect "reflect"
 
 	gomock "github.com/golang/mock/gomock"
 	topicspb "github.com/nitrictech/nitric/core/pkg/proto/topics/v1"
+	workers "github.com/nitrictech/nitric/core/pkg/workers"
 )
 
 // MockSubscriptionRequestHandler is a mock of SubscriptionRequestHandler interface.
@@ -35,18 +37,18 @@ func (m *MockSubscriptionRequestHandler) EXPECT() *MockSubscriptionRequestHandle
 }
 
 // HandleRequest mocks base method.
-func (m *MockSubscriptionRequestHandler) HandleRequest(arg0 *topicspb.ServerMessage) (*topicspb.ClientMessage, error) {
+func (m *MockSubscriptionRequestHandler) HandleRequest(arg0 context.Context, arg1 *topicspb.ServerMessage) (*topicspb.ClientMessage, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "HandleRequest", arg0)
+	ret := m.ctrl.Call(m, "HandleRequest", arg0, arg1)
 	ret0, _ := ret[0].(*topicspb.ClientMessage)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // HandleRequest indicates an expected call of HandleRequest.
-func (mr *MockSubscriptionRequestHandlerMockRecorder) HandleRequest(arg0 interface{}) *gomock.Call {
+func (mr *MockSubscriptionRequestHandlerMockRecorder) HandleRequest(arg0, arg1 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HandleRequest", reflect.TypeOf((*MockSubscriptionRequestHandler)(nil).HandleRequest), arg0)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HandleRequest", reflect.TypeOf((*MockSubscriptionRequestHandler)(nil).HandleRequest), arg0, arg1)
 }
 
 // Subscribe mocks base method.
@@ -76,3 +78,17 @@ func (mr *MockSubscriptionRequestHandlerMockRecorder) WorkerCount() *gomock.Call
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WorkerCount", reflect.TypeOf((*MockSubscriptionRequestHandler)(nil).WorkerCount))
 }
+
+// Workers mocks base method.
+func (m *MockSubscriptionRequestHandler) Workers() []workers.WorkerInfo {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Workers")
+	ret0, _ := ret[0].([]workers.WorkerInfo)
+	return ret0
+}
+
+// Workers indicates an expected call of Workers.
+func (mr *MockSubscriptionRequestHandlerMockRecorder) Workers() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Workers", reflect.TypeOf((*MockSubscriptionRequestHandler)(nil).Workers))
+}
@@ -5,10 +5,12 @@
 package mock_websockets
 
 import (
+	context "context"
 	reflect "reflect"
 
 	gomock "github.com/golang/mock/gomock"
 	websocketspb "github.com/nitrictech/nitric/core/pkg/proto/websockets/v1"
+	workers "github.com/nitrictech/nitric/core/pkg/workers"
 )
 
 // MockWebsocketRequestHandler is a mock of WebsocketRequestHandler interface.
@@ -49,18 +51,18 @@ func (mr *MockWebsocketRequestHandlerMockRecorder) HandleEvents(arg0 interface{}
 }
 
 // HandleRequest mocks base method.
-func (m *MockWebsocketRequestHandler) HandleRequest(arg0 *websocketspb.ServerMessage) (*websocketspb.ClientMessage, error) {
+func (m *MockWebsocketRequestHandler) HandleRequest(arg0 context.Context, arg1 *websocketspb.ServerMessage) (*websocketspb.ClientMessage, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "HandleRequest", arg0)
+	ret := m.ctrl.Call(m, "HandleRequest", arg0, arg1)
 	ret0, _ := ret[0].(*websocketspb.ClientMessage)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // HandleRequest indicates an expected call of HandleRequest.
-func (mr *MockWebsocketRequestHandlerMockRecorder) HandleRequest(arg0 interface{}) *gomock.Call {
+func (mr *MockWebsocketRequestHandlerMockRecorder) HandleRequest(arg0, arg1 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HandleRequest", reflect.TypeOf((*MockWebsocketRequestHandler)(nil).HandleRequest), arg0)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HandleRequest", reflect.TypeOf((*MockWebsocketRequestHandler)(nil).HandleRequest), arg0, arg1)
 }
 
 // WorkerCount mocks base method.
@@ -76,3 +78,17 @@ func (mr *MockWebsocketRequestHandlerMockRecorder) WorkerCount() *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WorkerCount", reflect.TypeOf((*MockWebsocketRequestHandler)(nil).WorkerCount))
 }
+
+// Workers mocks base method.
+func (m *MockWebsocketRequestHandler) Workers() []workers.WorkerInfo {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Workers")
+	ret0, _ := ret[0].([]workers.WorkerInfo)
+	return ret0
+}
+
+// Workers indicates an expected call of Workers.
+func (mr *MockWebsocketRequestHandlerMockRecorder) Workers() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Workers", reflect.TypeOf((*MockWebsocketRequestHandler)(nil).Workers))
+}
@@ -0,0 +1,41 @@
+// Copyright 2021 Nitric Technologies Pty Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package decorators
+
+// Decorator wraps a plugin server, e.g. storagepb.StorageServer, to add behaviour around its calls
+// such as audit logging, validation or tenant scoping.
+type Decorator[T any] func(inner T) T
+
+// Chain wraps the plugin server with the decorators, the first decorator is the outermost
+func Chain[T any](inner T, decorators ...Decorator[T]) T {
+	for i := len(decorators) - 1; i >= 0; i-- {
+		inner = decorators[i](inner)
+	}
+
+	return inner
+}
+
+// Apply wraps the plugin server with the decorators of its type, ignoring decorators registered for other plugin types
+func Apply[T any](inner T, decorators []any) T {
+	matching := []Decorator[T]{}
+
+	for _, d := range decorators {
+		if decorator, ok := d.(Decorator[T]); ok {
+			matching = append(matching, decorator)
+		}
+	}
+
+	return Chain(inner, matching...)
+}
@@ -0,0 +1,41 @@
+// Copyright 2021 Nitric Technologies Pty Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package decorators_test
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"github.com/nitrictech/nitric/core/pkg/decorators"
+)
+
+var _ = Describe("Apply", func() {
+	It("should chain only the decorators of the plugin's type, the first being outermost", func() {
+		wrap := func(name string) decorators.Decorator[string] {
+			return func(inner string) string {
+				return name + "(" + inner + ")"
+			}
+		}
+
+		registered := []any{
+			wrap("first"),
+			decorators.Decorator[int](func(inner int) int { return inner + 1 }),
+			wrap("second"),
+		}
+
+		Expect(decorators.Apply("plugin", registered)).To(Equal("first(second(plugin))"))
+		Expect(decorators.Apply(1, registered)).To(Equal(2))
+	})
+})
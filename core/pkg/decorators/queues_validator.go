@@ -0,0 +1,120 @@
+// Copyright 2021 Nitric Technologies Pty Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package decorators
+
+import (
+	"context"
+	"fmt"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/durationpb"
+
+	queuespb "github.com/nitrictech/nitric/core/pkg/proto/queues/v1"
+)
+
+type QueuesServerValidator struct {
+	inner queuespb.QueuesServer
+}
+
+var _ queuespb.QueuesServer = &QueuesServerValidator{}
+
+func validateQueueName(queueName string) error {
+	if len(queueName) == 0 {
+		return fmt.Errorf("queue name cannot be blank")
+	}
+
+	return nil
+}
+
+func validateLease(queueName string, leaseId string) error {
+	if err := validateQueueName(queueName); err != nil {
+		return err
+	}
+
+	if len(leaseId) == 0 {
+		return fmt.Errorf("lease id cannot be blank")
+	}
+
+	return nil
+}
+
+func validateLeaseDuration(leaseDuration *durationpb.Duration) error {
+	if err := leaseDuration.CheckValid(); err != nil {
+		return fmt.Errorf("lease duration is invalid: %w", err)
+	}
+
+	if leaseDuration.AsDuration() <= 0 {
+		return fmt.Errorf("lease duration must be greater than zero")
+	}
+
+	return nil
+}
+
+func (q *QueuesServerValidator) Enqueue(ctx context.Context, req *queuespb.QueueEnqueueRequest) (*queuespb.QueueEnqueueResponse, error) {
+	if err := validateQueueName(req.GetQueueName()); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	return q.inner.Enqueue(ctx, req)
+}
+
+func (q *QueuesServerValidator) Dequeue(ctx context.Context, req *queuespb.QueueDequeueRequest) (*queuespb.QueueDequeueResponse, error) {
+	if err := validateQueueName(req.GetQueueName()); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	if req.LeaseDuration != nil {
+		if err := validateLeaseDuration(req.LeaseDuration); err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+	}
+
+	return q.inner.Dequeue(ctx, req)
+}
+
+func (q *QueuesServerValidator) Complete(ctx context.Context, req *queuespb.QueueCompleteRequest) (*queuespb.QueueCompleteResponse, error) {
+	if err := validateLease(req.GetQueueName(), req.GetLeaseId()); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	return q.inner.Complete(ctx, req)
+}
+
+func (q *QueuesServerValidator) ExtendLease(ctx context.Context, req *queuespb.QueueExtendLeaseRequest) (*queuespb.QueueExtendLeaseResponse, error) {
+	if err := validateLease(req.GetQueueName(), req.GetLeaseId()); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	if err := validateLeaseDuration(req.GetLeaseDuration()); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	return q.inner.ExtendLease(ctx, req)
+}
+
+func (q *QueuesServerValidator) Release(ctx context.Context, req *queuespb.QueueReleaseRequest) (*queuespb.QueueReleaseResponse, error) {
+	if err := validateLease(req.GetQueueName(), req.GetLeaseId()); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	return q.inner.Release(ctx, req)
+}
+
+func QueuesServerWithValidation(inner queuespb.QueuesServer) *QueuesServerValidator {
+	return &QueuesServerValidator{
+		inner: inner,
+	}
+}
@@ -0,0 +1,84 @@
+// Copyright 2021 Nitric Technologies Pty Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package decorators
+
+import (
+	"context"
+	"fmt"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	schedulespb "github.com/nitrictech/nitric/core/pkg/proto/schedules/v1"
+)
+
+// MaxNextFireTimeCount is the maximum number of next fire times a schedule can be described with
+const MaxNextFireTimeCount = 100
+
+type ScheduleManagerServerValidator struct {
+	inner schedulespb.ScheduleManagerServer
+}
+
+var _ schedulespb.ScheduleManagerServer = &ScheduleManagerServerValidator{}
+
+func validateScheduleName(name string) error {
+	if len(name) == 0 {
+		return fmt.Errorf("schedule name cannot be blank")
+	}
+
+	return nil
+}
+
+func (s *ScheduleManagerServerValidator) Trigger(ctx context.Context, req *schedulespb.ScheduleTriggerRequest) (*schedulespb.ScheduleTriggerResponse, error) {
+	if err := validateScheduleName(req.GetScheduleName()); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	return s.inner.Trigger(ctx, req)
+}
+
+func (s *ScheduleManagerServerValidator) Pause(ctx context.Context, req *schedulespb.SchedulePauseRequest) (*schedulespb.SchedulePauseResponse, error) {
+	if err := validateScheduleName(req.GetScheduleName()); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	return s.inner.Pause(ctx, req)
+}
+
+func (s *ScheduleManagerServerValidator) Resume(ctx context.Context, req *schedulespb.ScheduleResumeRequest) (*schedulespb.ScheduleResumeResponse, error) {
+	if err := validateScheduleName(req.GetScheduleName()); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	return s.inner.Resume(ctx, req)
+}
+
+func (s *ScheduleManagerServerValidator) Describe(ctx context.Context, req *schedulespb.ScheduleDescribeRequest) (*schedulespb.ScheduleDescribeResponse, error) {
+	if err := validateScheduleName(req.GetScheduleName()); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	if req.GetNextFireTimeCount() < 0 || req.GetNextFireTimeCount() > MaxNextFireTimeCount {
+		return nil, status.Errorf(codes.InvalidArgument, "next fire time count must be between 0 and %d", MaxNextFireTimeCount)
+	}
+
+	return s.inner.Describe(ctx, req)
+}
+
+func ScheduleManagerServerWithValidation(inner schedulespb.ScheduleManagerServer) *ScheduleManagerServerValidator {
+	return &ScheduleManagerServerValidator{
+		inner: inner,
+	}
+}
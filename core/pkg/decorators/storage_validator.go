@@ -0,0 +1,156 @@
+// Copyright 2021 Nitric Technologies Pty Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package decorators
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	storagepb "github.com/nitrictech/nitric/core/pkg/proto/storage/v1"
+)
+
+type StorageServerValidator struct {
+	inner storagepb.StorageServer
+}
+
+var _ storagepb.StorageServer = &StorageServerValidator{}
+
+// metadataKeyPattern limits metadata keys to those every provider stores and returns unchanged,
+// S3 and Azure lower-case keys and Azure requires them to be valid C# identifiers
+var metadataKeyPattern = regexp.MustCompile(`^[a-z_][a-z0-9_]*$`)
+
+func validateMetadata(metadata map[string]string) error {
+	for key := range metadata {
+		if !metadataKeyPattern.MatchString(key) {
+			return fmt.Errorf("invalid metadata key %q, keys must start with a lower-case letter or underscore and contain only lower-case letters, digits and underscores", key)
+		}
+	}
+
+	return nil
+}
+
+// validatePreconditions checks the conditional request options of a write
+func validatePreconditions(ifMatch string, ifNoneMatch string) error {
+	if ifNoneMatch != "" && ifNoneMatch != "*" {
+		return fmt.Errorf("invalid if_none_match %q, only \"*\" is supported", ifNoneMatch)
+	}
+
+	if ifMatch != "" && ifNoneMatch != "" {
+		return fmt.Errorf("if_match and if_none_match cannot both be set")
+	}
+
+	return nil
+}
+
+// validatedWriteStream validates the header of a streamed write as it's received
+type validatedWriteStream struct {
+	storagepb.Storage_WriteStreamServer
+}
+
+func (v *validatedWriteStream) Recv() (*storagepb.StorageWriteStreamRequest, error) {
+	msg, err := v.Storage_WriteStreamServer.Recv()
+	if err != nil {
+		return nil, err
+	}
+
+	if err := validateMetadata(msg.GetHeader().GetMetadata()); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	return msg, nil
+}
+
+func (s *StorageServerValidator) Read(ctx context.Context, req *storagepb.StorageReadRequest) (*storagepb.StorageReadResponse, error) {
+	return s.inner.Read(ctx, req)
+}
+
+func (s *StorageServerValidator) Write(ctx context.Context, req *storagepb.StorageWriteRequest) (*storagepb.StorageWriteResponse, error) {
+	if err := validateMetadata(req.GetMetadata()); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	if err := validatePreconditions(req.GetIfMatch(), req.GetIfNoneMatch()); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	return s.inner.Write(ctx, req)
+}
+
+func (s *StorageServerValidator) Delete(ctx context.Context, req *storagepb.StorageDeleteRequest) (*storagepb.StorageDeleteResponse, error) {
+	return s.inner.Delete(ctx, req)
+}
+
+func (s *StorageServerValidator) PreSignUrl(ctx context.Context, req *storagepb.StoragePreSignUrlRequest) (*storagepb.StoragePreSignUrlResponse, error) {
+	return s.inner.PreSignUrl(ctx, req)
+}
+
+func (s *StorageServerValidator) ListBlobs(ctx context.Context, req *storagepb.StorageListBlobsRequest) (*storagepb.StorageListBlobsResponse, error) {
+	if req.GetPageSize() < 0 {
+		return nil, status.Error(codes.InvalidArgument, "page size cannot be negative")
+	}
+
+	return s.inner.ListBlobs(ctx, req)
+}
+
+func (s *StorageServerValidator) Exists(ctx context.Context, req *storagepb.StorageExistsRequest) (*storagepb.StorageExistsResponse, error) {
+	return s.inner.Exists(ctx, req)
+}
+
+func (s *StorageServerValidator) ReadStream(req *storagepb.StorageReadStreamRequest, stream storagepb.Storage_ReadStreamServer) error {
+	if req.GetOffset() < 0 {
+		return status.Error(codes.InvalidArgument, "offset cannot be negative")
+	}
+
+	if req.GetLength() < 0 {
+		return status.Error(codes.InvalidArgument, "length cannot be negative")
+	}
+
+	return s.inner.ReadStream(req, stream)
+}
+
+func (s *StorageServerValidator) WriteStream(stream storagepb.Storage_WriteStreamServer) error {
+	return s.inner.WriteStream(&validatedWriteStream{stream})
+}
+
+func (s *StorageServerValidator) Stat(ctx context.Context, req *storagepb.StorageStatRequest) (*storagepb.StorageStatResponse, error) {
+	return s.inner.Stat(ctx, req)
+}
+
+func (s *StorageServerValidator) Copy(ctx context.Context, req *storagepb.StorageCopyRequest) (*storagepb.StorageCopyResponse, error) {
+	if req.GetSourceBucketName() == req.GetDestinationBucketName() && req.GetSourceKey() == req.GetDestinationKey() {
+		return nil, status.Error(codes.InvalidArgument, "cannot copy an item onto itself")
+	}
+
+	return s.inner.Copy(ctx, req)
+}
+
+func (s *StorageServerValidator) Move(ctx context.Context, req *storagepb.StorageMoveRequest) (*storagepb.StorageMoveResponse, error) {
+	// moving an item onto itself would delete it after the copy
+	if req.GetSourceBucketName() == req.GetDestinationBucketName() && req.GetSourceKey() == req.GetDestinationKey() {
+		return nil, status.Error(codes.InvalidArgument, "cannot move an item onto itself")
+	}
+
+	return s.inner.Move(ctx, req)
+}
+
+func StorageServerWithValidation(inner storagepb.StorageServer) *StorageServerValidator {
+	return &StorageServerValidator{
+		inner: inner,
+	}
+}
@@ -0,0 +1,78 @@
+// Copyright 2021 Nitric Pty Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package decorators_test
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/nitrictech/nitric/core/pkg/decorators"
+	storagepb "github.com/nitrictech/nitric/core/pkg/proto/storage/v1"
+)
+
+// writeRecorder records the writes that pass validation
+type writeRecorder struct {
+	storagepb.UnimplementedStorageServer
+	writes []*storagepb.StorageWriteRequest
+}
+
+func (w *writeRecorder) Write(ctx context.Context, req *storagepb.StorageWriteRequest) (*storagepb.StorageWriteResponse, error) {
+	w.writes = append(w.writes, req)
+	return &storagepb.StorageWriteResponse{}, nil
+}
+
+var _ = Describe("Storage Validator", func() {
+	When("Writing with preconditions", func() {
+		var inner *writeRecorder
+		var validator *decorators.StorageServerValidator
+
+		BeforeEach(func() {
+			inner = &writeRecorder{}
+			validator = decorators.StorageServerWithValidation(inner)
+		})
+
+		It("should pass create only writes to the plugin", func() {
+			_, err := validator.Write(context.TODO(), &storagepb.StorageWriteRequest{BucketName: "bucket", Key: "manifest.json", IfNoneMatch: "*"})
+
+			Expect(err).ToNot(HaveOccurred())
+			Expect(inner.writes).To(HaveLen(1))
+		})
+
+		It("should pass writes matching an etag to the plugin", func() {
+			_, err := validator.Write(context.TODO(), &storagepb.StorageWriteRequest{BucketName: "bucket", Key: "manifest.json", IfMatch: "\"etag\""})
+
+			Expect(err).ToNot(HaveOccurred())
+			Expect(inner.writes).To(HaveLen(1))
+		})
+
+		It("should reject if_none_match values other than *", func() {
+			_, err := validator.Write(context.TODO(), &storagepb.StorageWriteRequest{BucketName: "bucket", Key: "manifest.json", IfNoneMatch: "\"etag\""})
+
+			Expect(status.Code(err)).To(Equal(codes.InvalidArgument))
+			Expect(inner.writes).To(BeEmpty())
+		})
+
+		It("should reject writes with both preconditions", func() {
+			_, err := validator.Write(context.TODO(), &storagepb.StorageWriteRequest{BucketName: "bucket", Key: "manifest.json", IfMatch: "\"etag\"", IfNoneMatch: "*"})
+
+			Expect(status.Code(err)).To(Equal(codes.InvalidArgument))
+			Expect(inner.writes).To(BeEmpty())
+		})
+	})
+})
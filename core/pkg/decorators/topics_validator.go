@@ -0,0 +1,72 @@
+// Copyright 2021 Nitric Technologies Pty Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package decorators
+
+import (
+	"context"
+	"fmt"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	topicspb "github.com/nitrictech/nitric/core/pkg/proto/topics/v1"
+	"github.com/nitrictech/nitric/core/pkg/workers/topics"
+)
+
+type TopicsServerValidator struct {
+	inner topicspb.TopicsServer
+}
+
+var _ topicspb.TopicsServer = &TopicsServerValidator{}
+
+func validatePublishRequest(req *topicspb.TopicPublishRequest) error {
+	if len(req.GetTopicName()) == 0 {
+		return fmt.Errorf("topic name cannot be blank")
+	}
+
+	if len(req.GetAttributes()) > topics.MaxAttributes {
+		return fmt.Errorf("messages can't have more than %d attributes", topics.MaxAttributes)
+	}
+
+	for name := range req.GetAttributes() {
+		if len(name) == 0 {
+			return fmt.Errorf("attribute names cannot be blank")
+		}
+
+		if topics.IsReservedAttribute(name) {
+			return fmt.Errorf("attribute %s is invalid, attribute names starting with %s are reserved", name, topics.ReservedAttributePrefix)
+		}
+
+		if topics.IsTraceAttribute(name) {
+			return fmt.Errorf("attribute %s is invalid, it's reserved for trace propagation", name)
+		}
+	}
+
+	return nil
+}
+
+func (t *TopicsServerValidator) Publish(ctx context.Context, req *topicspb.TopicPublishRequest) (*topicspb.TopicPublishResponse, error) {
+	if err := validatePublishRequest(req); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	return t.inner.Publish(ctx, req)
+}
+
+func TopicsServerWithValidation(inner topicspb.TopicsServer) *TopicsServerValidator {
+	return &TopicsServerValidator{
+		inner: inner,
+	}
+}
@@ -0,0 +1,93 @@
+// Copyright 2021 Nitric Technologies Pty Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package decorators_test
+
+import (
+	"context"
+	"fmt"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/nitrictech/nitric/core/pkg/decorators"
+	topicspb "github.com/nitrictech/nitric/core/pkg/proto/topics/v1"
+	"github.com/nitrictech/nitric/core/pkg/workers/topics"
+)
+
+// publishRecorder records the publishes that pass validation
+type publishRecorder struct {
+	topicspb.UnimplementedTopicsServer
+	publishes []*topicspb.TopicPublishRequest
+}
+
+func (p *publishRecorder) Publish(ctx context.Context, req *topicspb.TopicPublishRequest) (*topicspb.TopicPublishResponse, error) {
+	p.publishes = append(p.publishes, req)
+	return &topicspb.TopicPublishResponse{}, nil
+}
+
+// attributes returns n distinct message attributes
+func attributes(n int) map[string]string {
+	attrs := map[string]string{}
+	for i := 0; i < n; i++ {
+		attrs[fmt.Sprintf("attribute-%d", i)] = "value"
+	}
+
+	return attrs
+}
+
+var _ = Describe("Topics Validator", func() {
+	When("Publishing with attributes", func() {
+		var inner *publishRecorder
+		var validator *decorators.TopicsServerValidator
+
+		BeforeEach(func() {
+			inner = &publishRecorder{}
+			validator = decorators.TopicsServerWithValidation(inner)
+		})
+
+		It("should pass messages with up to the maximum attributes to the plugin", func() {
+			_, err := validator.Publish(context.TODO(), &topicspb.TopicPublishRequest{TopicName: "updates", Attributes: attributes(topics.MaxAttributes)})
+
+			Expect(err).ToNot(HaveOccurred())
+			Expect(inner.publishes).To(HaveLen(1))
+		})
+
+		It("should reject messages with too many attributes", func() {
+			_, err := validator.Publish(context.TODO(), &topicspb.TopicPublishRequest{TopicName: "updates", Attributes: attributes(topics.MaxAttributes + 1)})
+
+			Expect(status.Code(err)).To(Equal(codes.InvalidArgument))
+			Expect(inner.publishes).To(BeEmpty())
+		})
+
+		It("should reject reserved attributes", func() {
+			_, err := validator.Publish(context.TODO(), &topicspb.TopicPublishRequest{TopicName: "updates", Attributes: map[string]string{"x-nitric-ordering-key": "order-1"}})
+
+			Expect(status.Code(err)).To(Equal(codes.InvalidArgument))
+			Expect(inner.publishes).To(BeEmpty())
+		})
+
+		It("should reject trace propagation attributes", func() {
+			for _, name := range []string{"traceparent", "Baggage", "x-amzn-trace-id"} {
+				_, err := validator.Publish(context.TODO(), &topicspb.TopicPublishRequest{TopicName: "updates", Attributes: map[string]string{name: "value"}})
+
+				Expect(status.Code(err)).To(Equal(codes.InvalidArgument), name)
+			}
+
+			Expect(inner.publishes).To(BeEmpty())
+		})
+	})
+})
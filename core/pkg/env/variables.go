@@ -19,8 +19,41 @@ var (
 	MAX_WORKERS     = GetEnv("MAX_WORKERS", "300")
 	MIN_WORKERS     = GetEnv("MIN_WORKERS", "1")
 	WORKER_TIMEOUT  = GetEnv("WORKER_TIMEOUT", "10")
+	DRAIN_TIMEOUT   = GetEnv("DRAIN_TIMEOUT", "10")
 	SERVICE_ADDRESS = GetEnv("SERVICE_ADDRESS", "127.0.0.1:")
 	LOG_LEVEL       = GetEnv("LOG_LEVEL", "INFO")
+	// The format of runtime logs, either text or json
+	LOG_FORMAT = GetEnv("LOG_FORMAT", "text")
+	// Log levels of individual runtime components overriding LOG_LEVEL, e.g. workers=DEBUG,process=WARN
+	LOG_COMPONENT_LEVELS = GetEnv("LOG_COMPONENT_LEVELS", "")
+	// The address of the admin health and introspection endpoints, disabled when empty
+	ADMIN_ADDRESS = GetEnv("ADMIN_ADDRESS", "")
+	// The address of the Prometheus metrics scrape endpoint, disabled when empty
+	METRICS_ADDRESS = GetEnv("METRICS_ADDRESS", "")
 	// The execution type of the nitric execution unit, can either be job or service
 	EXECUTION_TYPE = GetEnv("NITRIC_EXECUTION_TYPE", "service")
+	// The stack and service the runtime is deployed in, added to runtime logs when set
+	NITRIC_STACK_ID     = GetEnv("NITRIC_STACK_ID", "")
+	NITRIC_SERVICE_NAME = GetEnv("NITRIC_SERVICE_NAME", "")
+)
+
+// Access control of the runtime services, SERVICE_ADDRESS may also be a unix domain socket, e.g. unix:///var/run/nitric.sock
+var (
+	// Require calls to the runtime services to carry a token generated each time the runtime starts, passed to the child process
+	SERVICE_TOKEN_AUTH = GetEnv("SERVICE_TOKEN_AUTH", "false")
+	// The certificate and key the runtime services are served with over TLS, disabled when empty
+	SERVICE_TLS_CERT_FILE = GetEnv("SERVICE_TLS_CERT_FILE", "")
+	SERVICE_TLS_KEY_FILE  = GetEnv("SERVICE_TLS_KEY_FILE", "")
+	// The CA client certificates must be signed by to call the runtime services (mTLS), client certificates aren't required when empty
+	SERVICE_TLS_CLIENT_CA_FILE = GetEnv("SERVICE_TLS_CLIENT_CA_FILE", "")
+)
+
+// Time in seconds to wait for a worker to respond to each trigger type before the request is cancelled, 0 waits indefinitely
+var (
+	API_TIMEOUT             = GetEnv("NITRIC_API_TIMEOUT", "0")
+	SUBSCRIPTION_TIMEOUT    = GetEnv("NITRIC_SUBSCRIPTION_TIMEOUT", "0")
+	SCHEDULE_TIMEOUT        = GetEnv("NITRIC_SCHEDULE_TIMEOUT", "0")
+	BUCKET_LISTENER_TIMEOUT = GetEnv("NITRIC_BUCKET_LISTENER_TIMEOUT", "0")
+	WEBSOCKET_TIMEOUT       = GetEnv("NITRIC_WEBSOCKET_TIMEOUT", "0")
+	JOB_TIMEOUT             = GetEnv("NITRIC_JOB_TIMEOUT", "0")
 )
@@ -34,11 +34,20 @@ type GatewayStartOpts struct {
 	StorageListenerPlugin   storage.BucketRequestHandler
 	WebsocketListenerPlugin websockets.WebsocketRequestHandler
 	JobHandlerPlugin        jobs.JobRequestHandler
+	// OnStarted is called once the gateway is ready to handle requests
+	OnStarted func()
+}
+
+// Started notifies the server that the gateway is ready to handle requests
+func (o *GatewayStartOpts) Started() {
+	if o.OnStarted != nil {
+		o.OnStarted()
+	}
 }
 
 // GatewayService - The interface for a Nitric Gateway, which acts as provider specific adapter for all incoming requests.
 type GatewayService interface {
-	// Start the Gateway
+	// Start the Gateway, calling opts.Started once it is ready to handle requests
 	Start(opts *GatewayStartOpts) error
 	// Stop the Gateway
 	Stop() error
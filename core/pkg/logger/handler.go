@@ -0,0 +1,92 @@
+// Copyright 2021 Nitric Technologies Pty Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package logger
+
+import (
+	"context"
+	"log/slog"
+	"sync/atomic"
+
+	"go.opentelemetry.io/otel/trace"
+)
+
+type derivedHandler struct {
+	generation uint64
+	handler    slog.Handler
+}
+
+// handler filters records by component level and adds the fields carried by their context,
+// records are written through the current base handler so output and format changes apply to existing loggers.
+type handler struct {
+	component string
+	// attributes and groups added with With and WithGroup, applied to the base handler in order
+	derive []func(slog.Handler) slog.Handler
+
+	cache atomic.Pointer[derivedHandler]
+}
+
+var _ slog.Handler = &handler{}
+
+func (h *handler) Enabled(_ context.Context, level slog.Level) bool {
+	return level >= GetComponentLevel(h.component).Level()
+}
+
+func (h *handler) current() slog.Handler {
+	b, gen := baseHandler()
+
+	if cached := h.cache.Load(); cached != nil && cached.generation == gen {
+		return cached.handler
+	}
+
+	for _, derive := range h.derive {
+		b = derive(b)
+	}
+
+	h.cache.Store(&derivedHandler{generation: gen, handler: b})
+
+	return b
+}
+
+func (h *handler) Handle(ctx context.Context, record slog.Record) error {
+	record.AddAttrs(Fields(ctx)...)
+
+	if spanContext := trace.SpanContextFromContext(ctx); spanContext.IsValid() {
+		record.AddAttrs(
+			slog.String(TraceIdKey, spanContext.TraceID().String()),
+			slog.String(SpanIdKey, spanContext.SpanID().String()),
+		)
+	}
+
+	return h.current().Handle(ctx, record)
+}
+
+func (h *handler) with(derive func(slog.Handler) slog.Handler) *handler {
+	return &handler{
+		component: h.component,
+		derive:    append(append([]func(slog.Handler) slog.Handler{}, h.derive...), derive),
+	}
+}
+
+func (h *handler) WithAttrs(attrs []slog.Attr) slog.Handler {
+	return h.with(func(b slog.Handler) slog.Handler {
+		return b.WithAttrs(attrs)
+	})
+}
+
+func (h *handler) WithGroup(name string) slog.Handler {
+	return h.with(func(b slog.Handler) slog.Handler {
+		return b.WithGroup(name)
+	})
+}
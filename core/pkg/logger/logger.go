@@ -15,9 +15,15 @@
 package logger
 
 import (
+	"context"
 	"fmt"
 	"io"
 	"log"
+	"log/slog"
+	"os"
+	"strings"
+	"sync"
+	"sync/atomic"
 
 	"github.com/nitrictech/nitric/core/pkg/env"
 )
@@ -38,73 +44,310 @@ func (l LogLevel) String() string {
 	return levelNames[l]
 }
 
+// Level returns the equivalent slog level
+func (l LogLevel) Level() slog.Level {
+	switch l {
+	case DEBUG:
+		return slog.LevelDebug
+	case WARN:
+		return slog.LevelWarn
+	case ERROR:
+		return slog.LevelError
+	case FATAL:
+		return levelFatal
+	default:
+		return slog.LevelInfo
+	}
+}
+
 func LogLevelFromString(level string) LogLevel {
 	for i, name := range levelNames {
-		if name == level {
+		if strings.EqualFold(name, strings.TrimSpace(level)) {
 			return LogLevel(i)
 		}
 	}
 	return INFO // default to INFO
 }
 
-var logLevel = LogLevelFromString(env.LOG_LEVEL.String())
+// levelFatal is logged before the process exits, slog has no equivalent level
+const levelFatal = slog.LevelError + 4
+
+type Format int
+
+const (
+	TEXT Format = iota
+	JSON
+)
+
+func FormatFromString(format string) Format {
+	if strings.EqualFold(strings.TrimSpace(format), "json") {
+		return JSON
+	}
+	return TEXT // default to TEXT
+}
+
+// Keys of the fields added to log records automatically
+const (
+	StackIdKey     = "stack_id"
+	ServiceKey     = "service"
+	ComponentKey   = "component"
+	RequestIdKey   = "request_id"
+	TriggerKey     = "trigger"
+	TriggerNameKey = "trigger_name"
+	TraceIdKey     = "trace_id"
+	SpanIdKey      = "span_id"
+)
+
+var (
+	lock            sync.RWMutex
+	output          io.Writer = os.Stderr
+	format                    = FormatFromString(env.LOG_FORMAT.String())
+	logLevel                  = LogLevelFromString(env.LOG_LEVEL.String())
+	componentLevels           = parseComponentLevels(env.LOG_COMPONENT_LEVELS.String())
+	staticFields              = defaultStaticFields()
+
+	// base is the handler all loggers write through, it's rebuilt when the output or format changes
+	base       slog.Handler
+	generation atomic.Uint64
+
+	root = slog.New(&handler{})
+)
+
+func init() {
+	rebuild()
+}
+
+// SetDefault makes the nitric logger the default slog logger, it's called by the runtime entrypoints.
+//
+//	Output of the standard log package is left as is, so log.Printf output of plugins isn't filtered by the log level.
+func SetDefault() {
+	writer, flags := log.Writer(), log.Flags()
+
+	slog.SetDefault(root)
+
+	// slog.SetDefault redirects the standard logger to the new default, restore it
+	log.SetOutput(writer)
+	log.SetFlags(flags)
+}
+
+// parseComponentLevels parses levels in the form component=LEVEL,component=LEVEL
+func parseComponentLevels(levels string) map[string]LogLevel {
+	componentLevels := map[string]LogLevel{}
+
+	for _, pair := range strings.Split(levels, ",") {
+		component, level, ok := strings.Cut(pair, "=")
+		if !ok {
+			continue
+		}
+
+		componentLevels[strings.TrimSpace(component)] = LogLevelFromString(level)
+	}
+
+	return componentLevels
+}
+
+func defaultStaticFields() []slog.Attr {
+	fields := []slog.Attr{}
+
+	if stackId := env.NITRIC_STACK_ID.String(); stackId != "" {
+		fields = append(fields, slog.String(StackIdKey, stackId))
+	}
+
+	if service := env.NITRIC_SERVICE_NAME.String(); service != "" {
+		fields = append(fields, slog.String(ServiceKey, service))
+	}
+
+	return fields
+}
+
+func replaceLevel(groups []string, attr slog.Attr) slog.Attr {
+	if len(groups) == 0 && attr.Key == slog.LevelKey {
+		if level, ok := attr.Value.Any().(slog.Level); ok && level >= levelFatal {
+			attr.Value = slog.StringValue(FATAL.String())
+		}
+	}
+
+	return attr
+}
+
+// rebuild the base handler, must be called with the lock held or during init
+func rebuild() {
+	opts := &slog.HandlerOptions{
+		// levels are filtered per component before records reach the base handler
+		Level:       slog.Level(-8),
+		ReplaceAttr: replaceLevel,
+	}
+
+	var h slog.Handler
+	if format == JSON {
+		h = slog.NewJSONHandler(output, opts)
+	} else {
+		h = slog.NewTextHandler(output, opts)
+	}
+
+	base = h.WithAttrs(staticFields)
+	generation.Add(1)
+}
 
 func SetLogLevel(level LogLevel) {
+	lock.Lock()
+	defer lock.Unlock()
+
 	logLevel = level
 }
 
 func GetLogLevel() LogLevel {
+	lock.RLock()
+	defer lock.RUnlock()
+
+	return logLevel
+}
+
+// SetComponentLevel overrides the log level of a single component, see Component
+func SetComponentLevel(component string, level LogLevel) {
+	lock.Lock()
+	defer lock.Unlock()
+
+	componentLevels[component] = level
+}
+
+// GetComponentLevel returns the log level of a component, defaulting to the global log level
+func GetComponentLevel(component string) LogLevel {
+	lock.RLock()
+	defer lock.RUnlock()
+
+	if level, ok := componentLevels[component]; ok {
+		return level
+	}
+
 	return logLevel
 }
 
 func SetOutput(w io.Writer) {
-	log.SetOutput(w)
+	lock.Lock()
+	defer lock.Unlock()
+
+	output = w
+	rebuild()
 }
 
-func logMsg(level LogLevel, msg string) {
-	if level >= logLevel {
-		if level == FATAL {
-			log.Fatalf("%s %s\n", level.String(), msg)
-		} else {
-			log.Printf("%s %s\n", level.String(), msg)
-		}
+func SetFormat(f Format) {
+	lock.Lock()
+	defer lock.Unlock()
+
+	format = f
+	rebuild()
+}
+
+// SetStaticFields replaces the fields added to every log record, by default the stack id and service name
+func SetStaticFields(fields ...slog.Attr) {
+	lock.Lock()
+	defer lock.Unlock()
+
+	staticFields = fields
+	rebuild()
+}
+
+func baseHandler() (slog.Handler, uint64) {
+	lock.RLock()
+	defer lock.RUnlock()
+
+	return base, generation.Load()
+}
+
+// Logger returns the root structured logger
+func Logger() *slog.Logger {
+	return root
+}
+
+// Component returns a structured logger for a component of the runtime, e.g. workers or process.
+//
+//	Component loggers add a component field to their records and are filtered by their component log level.
+func Component(name string) *slog.Logger {
+	return slog.New(&handler{component: name}).With(ComponentKey, name)
+}
+
+type fieldsKey struct{}
+
+// WithFields returns a context carrying fields that are added to records logged with it, e.g. the id of the request being handled
+func WithFields(ctx context.Context, fields ...slog.Attr) context.Context {
+	existing, _ := ctx.Value(fieldsKey{}).([]slog.Attr)
+
+	merged := make([]slog.Attr, 0, len(existing)+len(fields))
+	merged = append(merged, existing...)
+	merged = append(merged, fields...)
+
+	return context.WithValue(ctx, fieldsKey{}, merged)
+}
+
+// Fields returns the fields carried by the context
+func Fields(ctx context.Context) []slog.Attr {
+	fields, _ := ctx.Value(fieldsKey{}).([]slog.Attr)
+	return fields
+}
+
+func logMsg(ctx context.Context, level LogLevel, msg string, args ...any) {
+	root.Log(ctx, level.Level(), strings.TrimRight(msg, "\n"), args...)
+
+	if level == FATAL {
+		os.Exit(1)
 	}
 }
 
 func Debug(msg string) {
-	logMsg(DEBUG, msg)
+	logMsg(context.Background(), DEBUG, msg)
 }
 
 func Debugf(format string, v ...interface{}) {
 	Debug(fmt.Sprintf(format, v...))
 }
 
+// DebugContext logs a message with the fields carried by ctx and the given key value pairs
+func DebugContext(ctx context.Context, msg string, args ...any) {
+	logMsg(ctx, DEBUG, msg, args...)
+}
+
 func Info(msg string) {
-	logMsg(INFO, msg)
+	logMsg(context.Background(), INFO, msg)
 }
 
 func Infof(format string, v ...interface{}) {
 	Info(fmt.Sprintf(format, v...))
 }
 
+// InfoContext logs a message with the fields carried by ctx and the given key value pairs
+func InfoContext(ctx context.Context, msg string, args ...any) {
+	logMsg(ctx, INFO, msg, args...)
+}
+
 func Warn(msg string) {
-	logMsg(WARN, msg)
+	logMsg(context.Background(), WARN, msg)
 }
 
 func Warnf(format string, v ...interface{}) {
 	Warn(fmt.Sprintf(format, v...))
 }
 
+// WarnContext logs a message with the fields carried by ctx and the given key value pairs
+func WarnContext(ctx context.Context, msg string, args ...any) {
+	logMsg(ctx, WARN, msg, args...)
+}
+
 func Error(msg string) {
-	logMsg(ERROR, msg)
+	logMsg(context.Background(), ERROR, msg)
 }
 
 func Errorf(format string, v ...interface{}) {
 	Error(fmt.Sprintf(format, v...))
 }
 
+// ErrorContext logs a message with the fields carried by ctx and the given key value pairs
+func ErrorContext(ctx context.Context, msg string, args ...any) {
+	logMsg(ctx, ERROR, msg, args...)
+}
+
 func Fatal(msg string) {
-	logMsg(FATAL, msg)
+	logMsg(context.Background(), FATAL, msg)
 }
 
 func Fatalf(format string, v ...interface{}) {
@@ -0,0 +1,194 @@
+// Copyright 2021 Nitric Technologies Pty Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package logger_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"log"
+	"log/slog"
+	"os"
+	"strings"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/nitrictech/nitric/core/pkg/logger"
+)
+
+// records decodes the JSON log records written to buf
+func records(buf *bytes.Buffer) []map[string]interface{} {
+	records := []map[string]interface{}{}
+
+	for _, line := range strings.Split(strings.TrimSpace(buf.String()), "\n") {
+		if line == "" {
+			continue
+		}
+
+		record := map[string]interface{}{}
+		Expect(json.Unmarshal([]byte(line), &record)).To(Succeed())
+
+		records = append(records, record)
+	}
+
+	return records
+}
+
+var _ = Describe("Logger", func() {
+	var buf *bytes.Buffer
+
+	BeforeEach(func() {
+		buf = &bytes.Buffer{}
+
+		logger.SetOutput(buf)
+		logger.SetFormat(logger.JSON)
+		logger.SetLogLevel(logger.INFO)
+	})
+
+	AfterEach(func() {
+		logger.SetOutput(os.Stderr)
+		logger.SetFormat(logger.TEXT)
+		logger.SetLogLevel(logger.INFO)
+	})
+
+	When("logging below the log level", func() {
+		It("should not write the record", func() {
+			logger.Debug("hidden")
+			logger.Info("shown")
+
+			Expect(records(buf)).To(HaveLen(1))
+			Expect(records(buf)[0]).To(HaveKeyWithValue("msg", "shown"))
+			Expect(records(buf)[0]).To(HaveKeyWithValue("level", "INFO"))
+		})
+	})
+
+	When("logging with a request context", func() {
+		It("should add the context fields and trace id", func() {
+			spanContext := trace.NewSpanContext(trace.SpanContextConfig{
+				TraceID:    trace.TraceID{0x4b, 0xf9, 0x2f, 0x35, 0x77, 0xb3, 0x4d, 0xa6, 0xa3, 0xce, 0x92, 0x9d, 0x0e, 0x0e, 0x47, 0x36},
+				SpanID:     trace.SpanID{0x00, 0xf0, 0x67, 0xaa, 0x0b, 0xa9, 0x02, 0xb7},
+				TraceFlags: trace.FlagsSampled,
+			})
+
+			ctx := trace.ContextWithSpanContext(context.TODO(), spanContext)
+			ctx = logger.WithFields(ctx, slog.String(logger.RequestIdKey, "request-1"), slog.String(logger.TriggerKey, "topic"))
+
+			logger.InfoContext(ctx, "handled", "subscriber", "sub-1")
+
+			Expect(records(buf)).To(HaveLen(1))
+			record := records(buf)[0]
+			Expect(record).To(HaveKeyWithValue(logger.RequestIdKey, "request-1"))
+			Expect(record).To(HaveKeyWithValue(logger.TriggerKey, "topic"))
+			Expect(record).To(HaveKeyWithValue(logger.TraceIdKey, "4bf92f3577b34da6a3ce929d0e0e4736"))
+			Expect(record).To(HaveKeyWithValue(logger.SpanIdKey, "00f067aa0ba902b7"))
+			Expect(record).To(HaveKeyWithValue("subscriber", "sub-1"))
+		})
+	})
+
+	When("a component has its own log level", func() {
+		AfterEach(func() {
+			logger.SetComponentLevel("test-component", logger.INFO)
+		})
+
+		It("should filter the component's records by its level", func() {
+			componentLogger := logger.Component("test-component")
+			logger.SetComponentLevel("test-component", logger.DEBUG)
+
+			componentLogger.Debug("component debug")
+			logger.Debug("root debug")
+
+			Expect(records(buf)).To(HaveLen(1))
+			Expect(records(buf)[0]).To(HaveKeyWithValue("msg", "component debug"))
+			Expect(records(buf)[0]).To(HaveKeyWithValue(logger.ComponentKey, "test-component"))
+		})
+	})
+
+	When("the output changes after a component logger is created", func() {
+		It("should write to the new output", func() {
+			componentLogger := logger.Component("test-component").With("key", "value")
+			componentLogger.Info("first")
+
+			second := &bytes.Buffer{}
+			logger.SetOutput(second)
+			componentLogger.Info("second")
+
+			Expect(records(buf)).To(HaveLen(1))
+			Expect(records(second)).To(HaveLen(1))
+			Expect(records(second)[0]).To(HaveKeyWithValue("key", "value"))
+		})
+	})
+
+	When("static fields are set", func() {
+		AfterEach(func() {
+			logger.SetStaticFields()
+		})
+
+		It("should add them to every record", func() {
+			logger.SetStaticFields(slog.String(logger.StackIdKey, "stack-1"), slog.String(logger.ServiceKey, "service-1"))
+
+			logger.Warn("warning")
+
+			Expect(records(buf)[0]).To(HaveKeyWithValue(logger.StackIdKey, "stack-1"))
+			Expect(records(buf)[0]).To(HaveKeyWithValue(logger.ServiceKey, "service-1"))
+		})
+	})
+
+	When("the nitric logger is set as the default", func() {
+		var stdOut *bytes.Buffer
+		var previous *slog.Logger
+
+		BeforeEach(func() {
+			stdOut = &bytes.Buffer{}
+			previous = slog.Default()
+
+			log.SetOutput(stdOut)
+			logger.SetDefault()
+		})
+
+		AfterEach(func() {
+			slog.SetDefault(previous)
+			log.SetOutput(os.Stderr)
+		})
+
+		It("should filter slog records by the log level", func() {
+			logger.SetLogLevel(logger.WARN)
+
+			slog.Info("hidden")
+			slog.Warn("shown")
+
+			Expect(records(buf)).To(HaveLen(1))
+			Expect(records(buf)[0]).To(HaveKeyWithValue("msg", "shown"))
+		})
+
+		It("should not filter the standard logger", func() {
+			logger.SetLogLevel(logger.ERROR)
+
+			log.Printf("plugin output")
+
+			Expect(stdOut.String()).To(ContainSubstring("plugin output"))
+			Expect(buf.Len()).To(Equal(0))
+		})
+	})
+
+	When("parsing log levels", func() {
+		It("should ignore case and default to INFO", func() {
+			Expect(logger.LogLevelFromString("debug")).To(Equal(logger.DEBUG))
+			Expect(logger.LogLevelFromString("WARN")).To(Equal(logger.WARN))
+			Expect(logger.LogLevelFromString("verbose")).To(Equal(logger.INFO))
+		})
+	})
+})
@@ -0,0 +1,67 @@
+// Copyright 2021 Nitric Technologies Pty Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package metrics
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/status"
+)
+
+// splitMethod splits a full gRPC method name, e.g. /nitric.proto.kvstore.v1.KvStore/GetValue, into its service and method
+func splitMethod(fullMethod string) (string, string) {
+	service, method, found := strings.Cut(strings.TrimPrefix(fullMethod, "/"), "/")
+	if !found {
+		return "unknown", fullMethod
+	}
+
+	return service, method
+}
+
+// UnaryServerInterceptor records the count, duration and status code of calls to plugin servers of the given provider
+func UnaryServerInterceptor(provider string) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		start := time.Now()
+
+		resp, err := handler(ctx, req)
+
+		service, method := splitMethod(info.FullMethod)
+		RecordPluginCall(ctx, provider, service, method, status.Code(err).String(), time.Since(start))
+
+		return resp, err
+	}
+}
+
+// StreamServerInterceptor records the count, duration and status code of streaming calls to plugin servers of the given provider, e.g. storage reads and writes.
+// Bidirectional streams are worker connections that last the lifetime of the worker, so they aren't recorded.
+func StreamServerInterceptor(provider string) grpc.StreamServerInterceptor {
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		if info.IsClientStream && info.IsServerStream {
+			return handler(srv, ss)
+		}
+
+		start := time.Now()
+
+		err := handler(srv, ss)
+
+		service, method := splitMethod(info.FullMethod)
+		RecordPluginCall(ss.Context(), provider, service, method, status.Code(err).String(), time.Since(start))
+
+		return err
+	}
+}
@@ -0,0 +1,113 @@
+// Copyright 2021 Nitric Technologies Pty Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package metrics
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+)
+
+const instrumentationName = "github.com/nitrictech/nitric/core"
+
+const (
+	StatusOk    = "ok"
+	StatusError = "error"
+)
+
+// durationBuckets are the histogram bucket boundaries for durations in seconds, from 5ms to 60s
+var durationBuckets = []float64{0.005, 0.01, 0.025, 0.05, 0.1, 0.25, 0.5, 1, 2.5, 5, 10, 30, 60}
+
+type instruments struct {
+	triggers        metric.Int64Counter
+	triggerDuration metric.Float64Histogram
+	calls           metric.Int64Counter
+	callDuration    metric.Float64Histogram
+}
+
+var (
+	global     *instruments
+	globalOnce sync.Once
+)
+
+// meterInstruments returns the instruments created from the globally registered meter provider,
+// instruments created before a provider is registered forward to it once it is registered.
+func meterInstruments() *instruments {
+	globalOnce.Do(func() {
+		meter := otel.Meter(instrumentationName)
+
+		// the global meter only returns errors for invalid instrument names, which are fixed here
+		global = &instruments{}
+		global.triggers, _ = meter.Int64Counter("nitric.triggers",
+			metric.WithDescription("The number of triggers handled by workers"),
+		)
+		global.triggerDuration, _ = meter.Float64Histogram("nitric.trigger.duration",
+			metric.WithDescription("The time taken for workers to handle triggers"),
+			metric.WithUnit("s"),
+			metric.WithExplicitBucketBoundaries(durationBuckets...),
+		)
+		global.calls, _ = meter.Int64Counter("nitric.plugin.calls",
+			metric.WithDescription("The number of calls to plugin servers"),
+		)
+		global.callDuration, _ = meter.Float64Histogram("nitric.plugin.duration",
+			metric.WithDescription("The time taken for plugin servers to handle calls"),
+			metric.WithUnit("s"),
+			metric.WithExplicitBucketBoundaries(durationBuckets...),
+		)
+	})
+
+	return global
+}
+
+// RecordTrigger records a trigger handled by workers, e.g. an API request or topic message
+//
+//	kind is the type of trigger, e.g. api or topic, and name the resource that was triggered, e.g. the API or topic name.
+//	attributes are recorded in addition to the kind, name and status, e.g. the matched route and response status of an API request.
+func RecordTrigger(ctx context.Context, kind string, name string, duration time.Duration, err error, attributes ...attribute.KeyValue) {
+	status := StatusOk
+	if err != nil {
+		status = StatusError
+	}
+
+	attributes = append([]attribute.KeyValue{
+		attribute.String("nitric.trigger.kind", kind),
+		attribute.String("nitric.trigger.name", name),
+		attribute.String("nitric.status", status),
+	}, attributes...)
+
+	set := metric.WithAttributes(attributes...)
+
+	i := meterInstruments()
+	i.triggers.Add(ctx, 1, set)
+	i.triggerDuration.Record(ctx, duration.Seconds(), set)
+}
+
+// RecordPluginCall records a call to a plugin server, e.g. a key value store get
+func RecordPluginCall(ctx context.Context, provider string, service string, method string, code string, duration time.Duration) {
+	attributes := metric.WithAttributes(
+		attribute.String("nitric.provider", provider),
+		attribute.String("rpc.service", service),
+		attribute.String("rpc.method", method),
+		attribute.String("rpc.grpc.status_code", code),
+	)
+
+	i := meterInstruments()
+	i.calls.Add(ctx, 1, attributes)
+	i.callDuration.Record(ctx, duration.Seconds(), attributes)
+}
@@ -0,0 +1,125 @@
+// Copyright 2021 Nitric Technologies Pty Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package metrics_test
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"go.opentelemetry.io/otel/attribute"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/nitrictech/nitric/core/pkg/metrics"
+)
+
+type mockServerStream struct {
+	grpc.ServerStream
+}
+
+func (m *mockServerStream) Context() context.Context {
+	return context.TODO()
+}
+
+// the meter provider can only be registered once, instruments remain bound to the first provider registered
+var scrape func() string
+
+var _ = BeforeSuite(func() {
+	handler, err := metrics.NewPrometheusHandler()
+	Expect(err).ToNot(HaveOccurred())
+
+	scrape = func() string {
+		recorder := httptest.NewRecorder()
+		handler.ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, "/metrics", nil))
+
+		body, err := io.ReadAll(recorder.Body)
+		Expect(err).ToNot(HaveOccurred())
+
+		return string(body)
+	}
+})
+
+var _ = Describe("Metrics", func() {
+	When("triggers are handled", func() {
+		It("should count them by kind, name and status", func() {
+			metrics.RecordTrigger(context.TODO(), "topic", "updates", 20*time.Millisecond, nil)
+			metrics.RecordTrigger(context.TODO(), "topic", "updates", 30*time.Millisecond, errors.New("failed"))
+
+			output := scrape()
+			Expect(output).To(ContainSubstring(`nitric_triggers_total{nitric_status="ok",nitric_trigger_kind="topic",nitric_trigger_name="updates"`))
+			Expect(output).To(ContainSubstring(`nitric_triggers_total{nitric_status="error",nitric_trigger_kind="topic",nitric_trigger_name="updates"`))
+			Expect(output).To(ContainSubstring(`nitric_trigger_duration_seconds_bucket{`))
+		})
+	})
+
+	When("triggers are recorded with additional attributes", func() {
+		It("should count them by the additional attributes", func() {
+			metrics.RecordTrigger(context.TODO(), "api", "public", 20*time.Millisecond, nil,
+				attribute.String("http.route", "/customers/:id"),
+				attribute.Int("http.response.status_code", 404),
+			)
+
+			output := scrape()
+			Expect(output).To(ContainSubstring(`nitric_triggers_total{http_response_status_code="404",http_route="/customers/:id",nitric_status="ok",nitric_trigger_kind="api",nitric_trigger_name="public"`))
+		})
+	})
+
+	When("plugin servers are called", func() {
+		It("should count the calls by provider, method and status code", func() {
+			interceptor := metrics.UnaryServerInterceptor("aws")
+			info := &grpc.UnaryServerInfo{FullMethod: "/nitric.proto.kvstore.v1.KvStore/GetValue"}
+
+			_, err := interceptor(context.TODO(), nil, info, func(ctx context.Context, req interface{}) (interface{}, error) {
+				return nil, status.Error(codes.NotFound, "not found")
+			})
+			Expect(err).To(HaveOccurred())
+
+			output := scrape()
+			Expect(output).To(ContainSubstring(`nitric_plugin_calls_total{nitric_provider="aws",otel_scope_name="github.com/nitrictech/nitric/core",otel_scope_version="",rpc_grpc_status_code="NotFound",rpc_method="GetValue",rpc_service="nitric.proto.kvstore.v1.KvStore"} 1`))
+		})
+
+		It("should count streaming calls", func() {
+			interceptor := metrics.StreamServerInterceptor("aws")
+			info := &grpc.StreamServerInfo{FullMethod: "/nitric.proto.storage.v1.Storage/ReadStream", IsServerStream: true}
+
+			err := interceptor(nil, &mockServerStream{}, info, func(srv interface{}, stream grpc.ServerStream) error {
+				return nil
+			})
+			Expect(err).ToNot(HaveOccurred())
+
+			output := scrape()
+			Expect(output).To(ContainSubstring(`nitric_plugin_calls_total{nitric_provider="aws",otel_scope_name="github.com/nitrictech/nitric/core",otel_scope_version="",rpc_grpc_status_code="OK",rpc_method="ReadStream",rpc_service="nitric.proto.storage.v1.Storage"} 1`))
+		})
+
+		It("should not count worker connections", func() {
+			interceptor := metrics.StreamServerInterceptor("aws")
+			info := &grpc.StreamServerInfo{FullMethod: "/nitric.proto.topics.v1.Subscriber/Subscribe", IsClientStream: true, IsServerStream: true}
+
+			err := interceptor(nil, &mockServerStream{}, info, func(srv interface{}, stream grpc.ServerStream) error {
+				return nil
+			})
+			Expect(err).ToNot(HaveOccurred())
+
+			Expect(scrape()).ToNot(ContainSubstring(`rpc_method="Subscribe"`))
+		})
+	})
+})
@@ -18,15 +18,38 @@ import (
 	"fmt"
 	"os"
 	"os/exec"
+	"sync"
 	"syscall"
+	"time"
 
 	"github.com/nitrictech/nitric/core/pkg/logger"
 	"github.com/pkg/errors"
 )
 
+var log = logger.Component("process")
+
+// The time to wait for a process' output to be copied after it exits, in case it's held open by processes it started
+const outputWaitDelay = time.Second
+
 type process struct {
-	Command []string
-	cmd     *exec.Cmd
+	spec ProcessSpec
+	env  []string
+
+	// prefixed output writers, nil if the output isn't prefixed
+	stdout *prefixWriter
+	stderr *prefixWriter
+
+	// the currently running command, replaced each time the process is restarted
+	cmd  *exec.Cmd
+	lock sync.Mutex
+
+	// closed to prevent any further restarts
+	stopChan chan struct{}
+	stopOnce sync.Once
+
+	// closed once the process has exited and won't be restarted
+	exited  chan struct{}
+	exitErr error
 }
 
 type pMgr struct {
@@ -39,18 +62,39 @@ type ProcessManager interface {
 	StartPreProcesses(...string) error
 	StartUserProcess(...string) error
 	Monitor() error
-	StopAll()
+	// UserProcessRunning returns true if the user process has started and hasn't exited
+	UserProcessRunning() bool
+	// StopAll sends SIGTERM to the user process and waits for it to exit, then does the same for the pre-processes,
+	// processes still running once the grace period has elapsed are killed
+	StopAll(gracePeriod time.Duration)
 }
 
-func NewProcessManager(userCommand []string, preCommands [][]string) ProcessManager {
+func newProcess(spec ProcessSpec, prefixOutput bool) *process {
+	p := &process{
+		spec:     spec,
+		stopChan: make(chan struct{}),
+	}
+
+	if prefixOutput {
+		prefix := fmt.Sprintf("[%s] ", spec.name())
+		p.stdout = newPrefixWriter(prefix, os.Stdout)
+		p.stderr = newPrefixWriter(prefix, os.Stderr)
+	}
+
+	return p
+}
+
+// NewProcessManager creates a process manager for the user process and the processes started before it,
+// the output of pre-processes is prefixed with their name.
+func NewProcessManager(userCommand []string, preProcesses []ProcessSpec) ProcessManager {
 	m := &pMgr{
-		userProcess:    &process{Command: userCommand},
+		userProcess:    newProcess(ProcessSpec{Command: userCommand, RestartPolicy: RestartNever}, false),
 		preProcesses:   []*process{},
-		monitorErrChan: make(chan error),
+		monitorErrChan: make(chan error, len(preProcesses)+1),
 	}
 
-	for _, p := range preCommands {
-		m.preProcesses = append(m.preProcesses, &process{Command: p})
+	for _, spec := range preProcesses {
+		m.preProcesses = append(m.preProcesses, newProcess(spec, true))
 	}
 
 	return m
@@ -60,69 +104,249 @@ func (pm *pMgr) StartUserProcess(env ...string) error {
 	return pm.userProcess.start(env...)
 }
 
+// StartPreProcesses starts all pre-processes, then waits for them to pass their readiness probes
 func (pm *pMgr) StartPreProcesses(env ...string) error {
+	for i := range pm.preProcesses {
+		if err := pm.preProcesses[i].spec.validate(); err != nil {
+			return err
+		}
+	}
+
 	for i := range pm.preProcesses {
 		if err := pm.preProcesses[i].start(env...); err != nil {
 			return err
 		}
 	}
 
+	for _, p := range pm.preProcesses {
+		if p.spec.Readiness == nil || p.exited == nil {
+			continue
+		}
+
+		log.Debug("waiting for process to be ready", "process", p.spec.name())
+
+		if err := p.spec.Readiness.wait(p.exited); err != nil {
+			return fmt.Errorf("%s failed its readiness probe: %w", p.spec.name(), err)
+		}
+	}
+
 	return nil
 }
 
-func (pm *pMgr) StopAll() {
-	err := pm.userProcess.stop()
-	if err != nil {
-		fmt.Println(err)
+func (pm *pMgr) UserProcessRunning() bool {
+	return pm.userProcess.running()
+}
+
+// stopProcesses sends SIGTERM to the processes and waits for them to exit, processes still running at the deadline are killed
+func stopProcesses(processes []*process, deadline time.Time, gracePeriod time.Duration) {
+	for _, p := range processes {
+		if err := p.stop(); err != nil {
+			log.Error("error stopping process", "process", p.spec.name(), "error", err)
+		}
 	}
 
-	for _, p := range pm.preProcesses {
-		err := p.stop()
-		if err != nil {
-			fmt.Println(err)
+	for _, p := range processes {
+		if p.exited == nil {
+			continue
+		}
+
+		select {
+		case <-p.exited:
+		case <-time.After(time.Until(deadline)):
+			log.Warn("process did not exit within the grace period, killing it", "process", p.spec.name(), "grace_period", gracePeriod)
+
+			if err := p.kill(); err != nil {
+				log.Error("error killing process", "process", p.spec.name(), "error", err)
+			}
 		}
 	}
 }
 
+func (pm *pMgr) StopAll(gracePeriod time.Duration) {
+	// the grace period is shared by all processes
+	deadline := time.Now().Add(gracePeriod)
+
+	// the user process is stopped first, so the pre-processes it depends on keep running while it drains
+	stopProcesses([]*process{pm.userProcess}, deadline, gracePeriod)
+	stopProcesses(pm.preProcesses, deadline, gracePeriod)
+}
+
+// Monitor blocks until a process exits without being restarted, returning its exit error
+//
+//	processes with the on-failure restart policy that exit successfully have completed, and don't stop the monitor.
 func (pm *pMgr) Monitor() error {
 	for _, p := range append(pm.preProcesses, pm.userProcess) {
-		if p.cmd == nil {
+		if p.exited == nil {
 			continue
 		}
 
-		go func(c *exec.Cmd) {
-			pm.monitorErrChan <- c.Wait()
-		}(p.cmd)
+		go func(p *process) {
+			<-p.exited
+
+			if p.spec.completed(p.exitErr) {
+				log.Debug("process completed", "process", p.spec.name())
+				return
+			}
+
+			pm.monitorErrChan <- p.exitErr
+		}(p)
 	}
 
 	return <-pm.monitorErrChan
 }
 
+func (p *process) newCmd() *exec.Cmd {
+	allEnv := append([]string{}, os.Environ()...)
+	allEnv = append(allEnv, p.env...)
+
+	cmd := exec.Command(p.spec.Command[0], p.spec.Command[1:]...) //#nosec G204 -- This is by design inputs are determined at compile time
+	cmd.Stdout = os.Stdout
+	cmd.Stderr = os.Stderr
+	cmd.Env = allEnv
+
+	if p.stdout != nil {
+		cmd.Stdout = p.stdout
+		cmd.Stderr = p.stderr
+		cmd.WaitDelay = outputWaitDelay
+	}
+
+	return cmd
+}
+
 func (p *process) start(env ...string) error {
-	if len(p.Command) == 0 {
-		logger.Debug("No Command Specified, Skipping...")
+	if len(p.spec.Command) == 0 {
+		log.Debug("no command specified, skipping")
 
 		return nil
 	}
 
-	allEnv := append([]string{}, os.Environ()...)
-	allEnv = append(allEnv, env...)
+	p.env = env
+
+	cmd := p.newCmd()
 
-	p.cmd = exec.Command(p.Command[0], p.Command[1:]...) //#nosec G204 -- This is by design inputs are determined at compile time
-	p.cmd.Stdout = os.Stdout
-	p.cmd.Stderr = os.Stderr
-	p.cmd.Env = allEnv
+	log.Debug("starting process", "process", p.spec.name(), "command", p.spec.Command[0])
 
-	logger.Debugf("Starting: %s", p.Command[0])
+	if err := cmd.Start(); err != nil {
+		return errors.WithMessagef(err, "there was an error starting the process %s", p.spec.Command[0])
+	}
 
-	return errors.WithMessagef(p.cmd.Start(), "there was an error starting the process %s", p.Command[0])
+	p.lock.Lock()
+	p.cmd = cmd
+	p.exited = make(chan struct{})
+	p.lock.Unlock()
+
+	go p.supervise(cmd)
+
+	return nil
+}
+
+// supervise waits for the process to exit, restarting it according to its restart policy
+func (p *process) supervise(cmd *exec.Cmd) {
+	defer close(p.exited)
+
+	restarts := 0
+
+	for {
+		started := time.Now()
+		err := cmd.Wait()
+		p.flushOutput()
+
+		if p.stopping() || !p.spec.shouldRestart(err) {
+			p.exitErr = err
+			return
+		}
+
+		// a process that ran for longer than the maximum backoff was stable, so the backoff starts again
+		if time.Since(started) > p.spec.Backoff.maxDelay() {
+			restarts = 0
+		}
+
+		delay := p.spec.Backoff.delay(restarts)
+		restarts++
+
+		log.Warn("process exited, restarting", "process", p.spec.name(), "error", err, "delay", delay)
+
+		select {
+		case <-time.After(delay):
+		case <-p.stopChan:
+			p.exitErr = err
+			return
+		}
+
+		cmd, err = p.restart()
+		if err != nil {
+			log.Error("process could not be restarted", "process", p.spec.name(), "error", err)
+			p.exitErr = err
+			return
+		}
+	}
+}
+
+// restart starts a new instance of the process, unless the process is being stopped
+func (p *process) restart() (*exec.Cmd, error) {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+
+	if p.stopping() {
+		return nil, fmt.Errorf("process %s stopped", p.spec.name())
+	}
+
+	cmd := p.newCmd()
+	if err := cmd.Start(); err != nil {
+		return nil, errors.WithMessagef(err, "there was an error restarting the process %s", p.spec.Command[0])
+	}
+
+	p.cmd = cmd
+
+	return cmd, nil
+}
+
+func (p *process) flushOutput() {
+	if p.stdout == nil {
+		return
+	}
+
+	_ = p.stdout.Flush()
+	_ = p.stderr.Flush()
+}
+
+func (p *process) running() bool {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+
+	if p.exited == nil {
+		return false
+	}
+
+	select {
+	case <-p.exited:
+		return false
+	default:
+		return true
+	}
+}
+
+func (p *process) stopping() bool {
+	select {
+	case <-p.stopChan:
+		return true
+	default:
+		return false
+	}
 }
 
 func (p *process) stop() error {
-	if p == nil || p.cmd == nil {
+	if p == nil || p.exited == nil {
 		return nil
 	}
 
+	p.stopOnce.Do(func() {
+		close(p.stopChan)
+	})
+
+	p.lock.Lock()
+	defer p.lock.Unlock()
+
 	err := p.cmd.Process.Signal(syscall.Signal(0))
 	if err != nil {
 		if errors.Is(err, os.ErrProcessDone) {
@@ -141,3 +365,17 @@ func (p *process) stop() error {
 
 	return nil
 }
+
+func (p *process) kill() error {
+	p.lock.Lock()
+	err := p.cmd.Process.Kill()
+	p.lock.Unlock()
+
+	if err != nil && !errors.Is(err, os.ErrProcessDone) {
+		return err
+	}
+
+	<-p.exited
+
+	return nil
+}
@@ -0,0 +1,158 @@
+// Copyright 2021 Nitric Technologies Pty Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pm_test
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	pm "github.com/nitrictech/nitric/core/pkg/process"
+)
+
+var _ = Describe("ProcessManager", func() {
+	fastBackoff := pm.Backoff{Initial: 10 * time.Millisecond, Max: 50 * time.Millisecond}
+
+	When("a pre-process with the on-failure policy fails", func() {
+		var dir string
+		var counter string
+
+		BeforeEach(func() {
+			var err error
+			dir, err = os.MkdirTemp("", "process-manager")
+			Expect(err).ToNot(HaveOccurred())
+
+			counter = filepath.Join(dir, "runs")
+		})
+
+		AfterEach(func() {
+			os.RemoveAll(dir)
+		})
+
+		It("should restart it until it succeeds", func() {
+			manager := pm.NewProcessManager(nil, []pm.ProcessSpec{{
+				Name:          "flaky",
+				Command:       []string{"sh", "-c", "echo run >> " + counter + "; [ $(wc -l < " + counter + ") -ge 3 ]"},
+				RestartPolicy: pm.RestartOnFailure,
+				Backoff:       fastBackoff,
+			}})
+
+			Expect(manager.StartPreProcesses()).To(Succeed())
+
+			Eventually(func() int {
+				runs, _ := os.ReadFile(counter)
+				return strings.Count(string(runs), "run")
+			}, "5s").Should(Equal(3))
+
+			Consistently(func() int {
+				runs, _ := os.ReadFile(counter)
+				return strings.Count(string(runs), "run")
+			}, "200ms").Should(Equal(3))
+
+			manager.StopAll(time.Second)
+		})
+	})
+
+	When("a pre-process with the never policy exits", func() {
+		It("should be reported by the monitor", func() {
+			manager := pm.NewProcessManager(nil, []pm.ProcessSpec{{
+				Command: []string{"sh", "-c", "exit 3"},
+			}})
+
+			Expect(manager.StartPreProcesses()).To(Succeed())
+			Expect(manager.Monitor()).To(MatchError(ContainSubstring("exit status 3")))
+		})
+	})
+
+	When("a pre-process has a readiness probe", func() {
+		It("should wait for the probe to succeed", func() {
+			dir, err := os.MkdirTemp("", "process-manager")
+			Expect(err).ToNot(HaveOccurred())
+			defer os.RemoveAll(dir)
+
+			ready := filepath.Join(dir, "ready")
+
+			manager := pm.NewProcessManager(nil, []pm.ProcessSpec{{
+				Name:    "slow",
+				Command: []string{"sh", "-c", "sleep 0.3; touch " + ready + "; exec sleep 30"},
+				Readiness: &pm.ReadinessProbe{
+					Exec:     []string{"test", "-f", ready},
+					Interval: 20 * time.Millisecond,
+				},
+			}})
+			defer manager.StopAll(time.Second)
+
+			Expect(manager.StartPreProcesses()).To(Succeed())
+			Expect(ready).To(BeAnExistingFile())
+		})
+
+		It("should fail if the process exits before it is ready", func() {
+			manager := pm.NewProcessManager(nil, []pm.ProcessSpec{{
+				Name:    "broken",
+				Command: []string{"sh", "-c", "exit 1"},
+				Readiness: &pm.ReadinessProbe{
+					Exec:     []string{"false"},
+					Interval: 20 * time.Millisecond,
+				},
+			}})
+
+			Expect(manager.StartPreProcesses()).To(MatchError(ContainSubstring("broken failed its readiness probe")))
+		})
+	})
+
+	When("a readiness probe is invalid", func() {
+		It("should not start any processes", func() {
+			manager := pm.NewProcessManager(nil, []pm.ProcessSpec{{
+				Name:      "invalid",
+				Command:   []string{"sleep", "30"},
+				Readiness: &pm.ReadinessProbe{},
+			}})
+
+			Expect(manager.StartPreProcesses()).To(MatchError(ContainSubstring("invalid readiness probe")))
+		})
+	})
+
+	When("stopping all processes", func() {
+		It("should stop the pre-processes once the user process has exited", func() {
+			dir, err := os.MkdirTemp("", "process-manager")
+			Expect(err).ToNot(HaveOccurred())
+			defer os.RemoveAll(dir)
+
+			stopped := filepath.Join(dir, "stopped")
+
+			manager := pm.NewProcessManager(
+				[]string{"sh", "-c", "trap 'sleep 0.3; echo user >> " + stopped + "; exit 0' TERM; while true; do sleep 0.05; done"},
+				[]pm.ProcessSpec{{
+					Name:    "sidecar",
+					Command: []string{"sh", "-c", "trap 'echo sidecar >> " + stopped + "; exit 0' TERM; while true; do sleep 0.05; done"},
+				}},
+			)
+
+			Expect(manager.StartPreProcesses()).To(Succeed())
+			Expect(manager.StartUserProcess()).To(Succeed())
+
+			// give the shells time to install their traps
+			time.Sleep(200 * time.Millisecond)
+
+			manager.StopAll(5 * time.Second)
+
+			Expect(os.ReadFile(stopped)).To(Equal([]byte("user\nsidecar\n")))
+		})
+	})
+})
@@ -0,0 +1,87 @@
+// Copyright 2021 Nitric Technologies Pty Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pm
+
+import (
+	"bytes"
+	"io"
+	"sync"
+)
+
+// maxLineLength is the length at which incomplete lines are written, so output without newlines isn't buffered indefinitely
+const maxLineLength = 64 * 1024
+
+// prefixWriter writes each line of output with a prefix, e.g. the name of the process that wrote it
+type prefixWriter struct {
+	prefix []byte
+	out    io.Writer
+	buf    []byte
+	lock   sync.Mutex
+}
+
+func newPrefixWriter(prefix string, out io.Writer) *prefixWriter {
+	return &prefixWriter{
+		prefix: []byte(prefix),
+		out:    out,
+	}
+}
+
+func (w *prefixWriter) Write(p []byte) (int, error) {
+	w.lock.Lock()
+	defer w.lock.Unlock()
+
+	w.buf = append(w.buf, p...)
+
+	for {
+		end := bytes.IndexByte(w.buf, '\n')
+		if end < 0 {
+			if len(w.buf) >= maxLineLength {
+				return len(p), w.flush()
+			}
+
+			return len(p), nil
+		}
+
+		if err := w.writeLine(w.buf[:end+1]); err != nil {
+			return len(p), err
+		}
+
+		w.buf = w.buf[end+1:]
+	}
+}
+
+// Flush writes any incomplete line remaining in the buffer
+func (w *prefixWriter) Flush() error {
+	w.lock.Lock()
+	defer w.lock.Unlock()
+
+	return w.flush()
+}
+
+func (w *prefixWriter) flush() error {
+	if len(w.buf) == 0 {
+		return nil
+	}
+
+	line := append(w.buf, '\n')
+	w.buf = nil
+
+	return w.writeLine(line)
+}
+
+func (w *prefixWriter) writeLine(line []byte) error {
+	_, err := w.out.Write(append(append([]byte{}, w.prefix...), line...))
+	return err
+}
@@ -0,0 +1,66 @@
+// Copyright 2021 Nitric Technologies Pty Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pm
+
+import (
+	"bytes"
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("prefixWriter", func() {
+	It("should prefix each complete line", func() {
+		out := &bytes.Buffer{}
+		w := newPrefixWriter("[db] ", out)
+
+		_, err := w.Write([]byte("first\nsec"))
+		Expect(err).ToNot(HaveOccurred())
+		Expect(out.String()).To(Equal("[db] first\n"))
+
+		_, err = w.Write([]byte("ond\n"))
+		Expect(err).ToNot(HaveOccurred())
+		Expect(out.String()).To(Equal("[db] first\n[db] second\n"))
+	})
+
+	It("should write partial lines when flushed", func() {
+		out := &bytes.Buffer{}
+		w := newPrefixWriter("[db] ", out)
+
+		_, err := w.Write([]byte("partial"))
+		Expect(err).ToNot(HaveOccurred())
+		Expect(w.Flush()).To(Succeed())
+		Expect(out.String()).To(Equal("[db] partial\n"))
+	})
+})
+
+var _ = Describe("Backoff", func() {
+	It("should double the delay up to the maximum", func() {
+		b := Backoff{Initial: time.Second, Max: 5 * time.Second}
+
+		Expect(b.delay(0)).To(Equal(time.Second))
+		Expect(b.delay(1)).To(Equal(2 * time.Second))
+		Expect(b.delay(2)).To(Equal(4 * time.Second))
+		Expect(b.delay(3)).To(Equal(5 * time.Second))
+	})
+
+	It("should use the defaults when unset", func() {
+		b := Backoff{}
+
+		Expect(b.delay(0)).To(Equal(defaultInitialBackoff))
+		Expect(b.delay(100)).To(Equal(defaultMaxBackoff))
+	})
+})
@@ -0,0 +1,105 @@
+// Copyright 2021 Nitric Technologies Pty Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pm
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"os/exec"
+	"time"
+)
+
+const (
+	defaultProbeInterval = 100 * time.Millisecond
+	defaultProbeTimeout  = 30 * time.Second
+)
+
+// ReadinessProbe checks whether a process is ready, either by connecting to a TCP address or running a command.
+// Exactly one of TCPAddress or Exec must be set.
+type ReadinessProbe struct {
+	// The process is ready once a connection to the address succeeds, e.g. localhost:4317
+	TCPAddress string
+	// The process is ready once the command exits successfully
+	Exec []string
+	// The delay between probe attempts, defaults to 100 milliseconds
+	Interval time.Duration
+	// The total time to wait for the process to become ready, defaults to 30 seconds
+	Timeout time.Duration
+}
+
+func (r *ReadinessProbe) validate() error {
+	if (r.TCPAddress == "") == (len(r.Exec) == 0) {
+		return fmt.Errorf("exactly one of a TCP address or exec command must be set")
+	}
+
+	return nil
+}
+
+func (r *ReadinessProbe) interval() time.Duration {
+	if r.Interval <= 0 {
+		return defaultProbeInterval
+	}
+
+	return r.Interval
+}
+
+func (r *ReadinessProbe) timeout() time.Duration {
+	if r.Timeout <= 0 {
+		return defaultProbeTimeout
+	}
+
+	return r.Timeout
+}
+
+// check runs a single probe attempt, returning an error if the process isn't ready
+func (r *ReadinessProbe) check(ctx context.Context) error {
+	if r.TCPAddress != "" {
+		dialer := net.Dialer{Timeout: r.interval()}
+
+		conn, err := dialer.DialContext(ctx, "tcp", r.TCPAddress)
+		if err != nil {
+			return err
+		}
+
+		return conn.Close()
+	}
+
+	return exec.CommandContext(ctx, r.Exec[0], r.Exec[1:]...).Run() //#nosec G204 -- This is by design inputs are determined at compile time
+}
+
+// wait blocks until the probe succeeds, returning an error if the timeout elapses or the process exits first
+func (r *ReadinessProbe) wait(exited <-chan struct{}) error {
+	ctx, cancel := context.WithTimeout(context.Background(), r.timeout())
+	defer cancel()
+
+	ticker := time.NewTicker(r.interval())
+	defer ticker.Stop()
+
+	for {
+		err := r.check(ctx)
+		if err == nil {
+			return nil
+		}
+
+		select {
+		case <-exited:
+			return fmt.Errorf("process exited before it was ready")
+		case <-ctx.Done():
+			return fmt.Errorf("process not ready after %s: %w", r.timeout(), err)
+		case <-ticker.C:
+		}
+	}
+}
@@ -0,0 +1,146 @@
+// Copyright 2021 Nitric Technologies Pty Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pm
+
+import (
+	"fmt"
+	"path/filepath"
+	"time"
+)
+
+// RestartPolicy determines whether a process is restarted after it exits
+type RestartPolicy string
+
+const (
+	// RestartNever doesn't restart the process, the server stops when it exits
+	RestartNever RestartPolicy = "never"
+	// RestartOnFailure restarts the process when it exits with an error, a successful exit isn't restarted and doesn't stop the server
+	RestartOnFailure RestartPolicy = "on-failure"
+	// RestartAlways restarts the process whenever it exits
+	RestartAlways RestartPolicy = "always"
+)
+
+const (
+	defaultInitialBackoff = time.Second
+	defaultMaxBackoff     = 30 * time.Second
+)
+
+// Backoff is the delay between consecutive restarts of a process,
+// the delay doubles after each restart, up to the maximum delay.
+type Backoff struct {
+	// The delay before the first restart, defaults to 1 second
+	Initial time.Duration
+	// The maximum delay between restarts, defaults to 30 seconds.
+	// A process that runs for longer than the maximum delay is considered stable and the delay is reset.
+	Max time.Duration
+}
+
+func (b Backoff) initialDelay() time.Duration {
+	if b.Initial <= 0 {
+		return defaultInitialBackoff
+	}
+
+	return b.Initial
+}
+
+func (b Backoff) maxDelay() time.Duration {
+	if b.Max <= 0 {
+		return max(defaultMaxBackoff, b.initialDelay())
+	}
+
+	return b.Max
+}
+
+// delay returns the delay before the given restart, starting from 0
+func (b Backoff) delay(restart int) time.Duration {
+	delay := b.initialDelay()
+	for i := 0; i < restart && delay < b.maxDelay(); i++ {
+		delay *= 2
+	}
+
+	return min(delay, b.maxDelay())
+}
+
+// ProcessSpec describes a process started by the process manager
+type ProcessSpec struct {
+	// The name of the process, used to prefix its output, defaults to the base name of the command
+	Name string
+	// The command used to start the process
+	Command []string
+	// Whether the process is restarted when it exits, defaults to RestartNever
+	RestartPolicy RestartPolicy
+	// The delay between restarts of the process
+	Backoff Backoff
+	// An optional probe that must succeed before the user process is started
+	Readiness *ReadinessProbe
+}
+
+func (s ProcessSpec) name() string {
+	if s.Name != "" {
+		return s.Name
+	}
+
+	if len(s.Command) > 0 {
+		return filepath.Base(s.Command[0])
+	}
+
+	return ""
+}
+
+func (s ProcessSpec) validate() error {
+	switch s.RestartPolicy {
+	case "", RestartNever, RestartOnFailure, RestartAlways:
+	default:
+		return fmt.Errorf("process %s has an invalid restart policy %q", s.name(), s.RestartPolicy)
+	}
+
+	if s.Readiness != nil {
+		if err := s.Readiness.validate(); err != nil {
+			return fmt.Errorf("process %s has an invalid readiness probe: %w", s.name(), err)
+		}
+	}
+
+	return nil
+}
+
+// shouldRestart returns true if a process that exited with the given error should be restarted
+func (s ProcessSpec) shouldRestart(exitErr error) bool {
+	switch s.RestartPolicy {
+	case RestartAlways:
+		return true
+	case RestartOnFailure:
+		return exitErr != nil
+	default:
+		return false
+	}
+}
+
+// completed returns true if a process that exited with the given error has finished without stopping the server
+func (s ProcessSpec) completed(exitErr error) bool {
+	return s.RestartPolicy == RestartOnFailure && exitErr == nil
+}
+
+// PreCommandSpecs converts pre-commands to process specs that are never restarted
+func PreCommandSpecs(commands [][]string) []ProcessSpec {
+	specs := make([]ProcessSpec, 0, len(commands))
+	for _, command := range commands {
+		specs = append(specs, ProcessSpec{
+			Command:       command,
+			RestartPolicy: RestartNever,
+		})
+	}
+
+	return specs
+}
@@ -126,6 +126,7 @@ type ClientMessage struct {
 	//
 	//	*ClientMessage_RegistrationRequest
 	//	*ClientMessage_HttpResponse
+	//	*ClientMessage_HttpResponseBodyChunk
 	Content isClientMessage_Content `protobuf_oneof:"content"`
 }
 
@@ -189,6 +190,13 @@ func (x *ClientMessage) GetHttpResponse() *HttpResponse {
 	return nil
 }
 
+func (x *ClientMessage) GetHttpResponseBodyChunk() *HttpResponseBodyChunk {
+	if x, ok := x.GetContent().(*ClientMessage_HttpResponseBodyChunk); ok {
+		return x.HttpResponseBodyChunk
+	}
+	return nil
+}
+
 type isClientMessage_Content interface {
 	isClientMessage_Content()
 }
@@ -203,10 +211,17 @@ type ClientMessage_HttpResponse struct {
 	HttpResponse *HttpResponse `protobuf:"bytes,3,opt,name=http_response,json=httpResponse,proto3,oneof"`
 }
 
+type ClientMessage_HttpResponseBodyChunk struct {
+	// Chunk of the body of a streamed HTTP response with the same id
+	HttpResponseBodyChunk *HttpResponseBodyChunk `protobuf:"bytes,4,opt,name=http_response_body_chunk,json=httpResponseBodyChunk,proto3,oneof"`
+}
+
 func (*ClientMessage_RegistrationRequest) isClientMessage_Content() {}
 
 func (*ClientMessage_HttpResponse) isClientMessage_Content() {}
 
+func (*ClientMessage_HttpResponseBodyChunk) isClientMessage_Content() {}
+
 type HeaderValue struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -318,6 +333,9 @@ type HttpRequest struct {
 	PathParams map[string]string `protobuf:"bytes,5,rep,name=path_params,json=pathParams,proto3" json:"path_params,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
 	// HTTP Request body
 	Body []byte `protobuf:"bytes,6,opt,name=body,proto3" json:"body,omitempty"`
+	// When true the body is not set, it follows in HttpRequestBodyChunk messages with the same id as this request.
+	// Only sent to services that registered with stream_bodies.
+	StreamedBody bool `protobuf:"varint,7,opt,name=streamed_body,json=streamedBody,proto3" json:"streamed_body,omitempty"`
 }
 
 func (x *HttpRequest) Reset() {
@@ -394,6 +412,71 @@ func (x *HttpRequest) GetBody() []byte {
 	return nil
 }
 
+func (x *HttpRequest) GetStreamedBody() bool {
+	if x != nil {
+		return x.StreamedBody
+	}
+	return false
+}
+
+// HttpRequestBodyChunk is part of the body of a streamed HTTP request
+type HttpRequestBodyChunk struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The next bytes of the request body
+	Data []byte `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
+	// When true this is the last chunk of the request body
+	End bool `protobuf:"varint,2,opt,name=end,proto3" json:"end,omitempty"`
+}
+
+func (x *HttpRequestBodyChunk) Reset() {
+	*x = HttpRequestBodyChunk{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_nitric_proto_apis_v1_apis_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *HttpRequestBodyChunk) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HttpRequestBodyChunk) ProtoMessage() {}
+
+func (x *HttpRequestBodyChunk) ProtoReflect() protoreflect.Message {
+	mi := &file_nitric_proto_apis_v1_apis_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HttpRequestBodyChunk.ProtoReflect.Descriptor instead.
+func (*HttpRequestBodyChunk) Descriptor() ([]byte, []int) {
+	return file_nitric_proto_apis_v1_apis_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *HttpRequestBodyChunk) GetData() []byte {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+func (x *HttpRequestBodyChunk) GetEnd() bool {
+	if x != nil {
+		return x.End
+	}
+	return false
+}
+
 // HttpResponseMessage
 type HttpResponse struct {
 	state         protoimpl.MessageState
@@ -406,12 +489,15 @@ type HttpResponse struct {
 	Headers map[string]*HeaderValue `protobuf:"bytes,2,rep,name=headers,proto3" json:"headers,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
 	// HTTP response body
 	Body []byte `protobuf:"bytes,3,opt,name=body,proto3" json:"body,omitempty"`
+	// When true the body is not set, it follows in HttpResponseBodyChunk messages with the same id as this response.
+	// Only accepted from services that registered with stream_bodies.
+	StreamedBody bool `protobuf:"varint,4,opt,name=streamed_body,json=streamedBody,proto3" json:"streamed_body,omitempty"`
 }
 
 func (x *HttpResponse) Reset() {
 	*x = HttpResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_nitric_proto_apis_v1_apis_proto_msgTypes[6]
+		mi := &file_nitric_proto_apis_v1_apis_proto_msgTypes[7]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -424,7 +510,7 @@ func (x *HttpResponse) String() string {
 func (*HttpResponse) ProtoMessage() {}
 
 func (x *HttpResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_nitric_proto_apis_v1_apis_proto_msgTypes[6]
+	mi := &file_nitric_proto_apis_v1_apis_proto_msgTypes[7]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -437,7 +523,7 @@ func (x *HttpResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use HttpResponse.ProtoReflect.Descriptor instead.
 func (*HttpResponse) Descriptor() ([]byte, []int) {
-	return file_nitric_proto_apis_v1_apis_proto_rawDescGZIP(), []int{6}
+	return file_nitric_proto_apis_v1_apis_proto_rawDescGZIP(), []int{7}
 }
 
 func (x *HttpResponse) GetStatus() int32 {
@@ -461,6 +547,71 @@ func (x *HttpResponse) GetBody() []byte {
 	return nil
 }
 
+func (x *HttpResponse) GetStreamedBody() bool {
+	if x != nil {
+		return x.StreamedBody
+	}
+	return false
+}
+
+// HttpResponseBodyChunk is part of the body of a streamed HTTP response
+type HttpResponseBodyChunk struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The next bytes of the response body
+	Data []byte `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
+	// When true this is the last chunk of the response body
+	End bool `protobuf:"varint,2,opt,name=end,proto3" json:"end,omitempty"`
+}
+
+func (x *HttpResponseBodyChunk) Reset() {
+	*x = HttpResponseBodyChunk{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_nitric_proto_apis_v1_apis_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *HttpResponseBodyChunk) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HttpResponseBodyChunk) ProtoMessage() {}
+
+func (x *HttpResponseBodyChunk) ProtoReflect() protoreflect.Message {
+	mi := &file_nitric_proto_apis_v1_apis_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HttpResponseBodyChunk.ProtoReflect.Descriptor instead.
+func (*HttpResponseBodyChunk) Descriptor() ([]byte, []int) {
+	return file_nitric_proto_apis_v1_apis_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *HttpResponseBodyChunk) GetData() []byte {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+func (x *HttpResponseBodyChunk) GetEnd() bool {
+	if x != nil {
+		return x.End
+	}
+	return false
+}
+
 // ServerMessage sent by the nitric server to the service
 type ServerMessage struct {
 	state         protoimpl.MessageState
@@ -473,13 +624,18 @@ type ServerMessage struct {
 	//
 	//	*ServerMessage_RegistrationResponse
 	//	*ServerMessage_HttpRequest
+	//	*ServerMessage_CancelRequest
+	//	*ServerMessage_HttpRequestBodyChunk
 	Content isServerMessage_Content `protobuf_oneof:"content"`
+	// W3C trace context (traceparent, tracestate and baggage) of the trigger,
+	// used to continue its trace in the worker
+	TraceContext map[string]string `protobuf:"bytes,10,rep,name=trace_context,json=traceContext,proto3" json:"trace_context,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
 }
 
 func (x *ServerMessage) Reset() {
 	*x = ServerMessage{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_nitric_proto_apis_v1_apis_proto_msgTypes[7]
+		mi := &file_nitric_proto_apis_v1_apis_proto_msgTypes[9]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -492,7 +648,7 @@ func (x *ServerMessage) String() string {
 func (*ServerMessage) ProtoMessage() {}
 
 func (x *ServerMessage) ProtoReflect() protoreflect.Message {
-	mi := &file_nitric_proto_apis_v1_apis_proto_msgTypes[7]
+	mi := &file_nitric_proto_apis_v1_apis_proto_msgTypes[9]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -505,7 +661,7 @@ func (x *ServerMessage) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ServerMessage.ProtoReflect.Descriptor instead.
 func (*ServerMessage) Descriptor() ([]byte, []int) {
-	return file_nitric_proto_apis_v1_apis_proto_rawDescGZIP(), []int{7}
+	return file_nitric_proto_apis_v1_apis_proto_rawDescGZIP(), []int{9}
 }
 
 func (x *ServerMessage) GetId() string {
@@ -536,6 +692,27 @@ func (x *ServerMessage) GetHttpRequest() *HttpRequest {
 	return nil
 }
 
+func (x *ServerMessage) GetCancelRequest() *CancelRequest {
+	if x, ok := x.GetContent().(*ServerMessage_CancelRequest); ok {
+		return x.CancelRequest
+	}
+	return nil
+}
+
+func (x *ServerMessage) GetHttpRequestBodyChunk() *HttpRequestBodyChunk {
+	if x, ok := x.GetContent().(*ServerMessage_HttpRequestBodyChunk); ok {
+		return x.HttpRequestBodyChunk
+	}
+	return nil
+}
+
+func (x *ServerMessage) GetTraceContext() map[string]string {
+	if x != nil {
+		return x.TraceContext
+	}
+	return nil
+}
+
 type isServerMessage_Content interface {
 	isServerMessage_Content()
 }
@@ -550,10 +727,64 @@ type ServerMessage_HttpRequest struct {
 	HttpRequest *HttpRequest `protobuf:"bytes,3,opt,name=http_request,json=httpRequest,proto3,oneof"`
 }
 
+type ServerMessage_CancelRequest struct {
+	// Cancel a previously sent HTTP request with the same id
+	CancelRequest *CancelRequest `protobuf:"bytes,4,opt,name=cancel_request,json=cancelRequest,proto3,oneof"`
+}
+
+type ServerMessage_HttpRequestBodyChunk struct {
+	// Chunk of the body of a streamed HTTP request with the same id
+	HttpRequestBodyChunk *HttpRequestBodyChunk `protobuf:"bytes,5,opt,name=http_request_body_chunk,json=httpRequestBodyChunk,proto3,oneof"`
+}
+
 func (*ServerMessage_RegistrationResponse) isServerMessage_Content() {}
 
 func (*ServerMessage_HttpRequest) isServerMessage_Content() {}
 
+func (*ServerMessage_CancelRequest) isServerMessage_Content() {}
+
+func (*ServerMessage_HttpRequestBodyChunk) isServerMessage_Content() {}
+
+// CancelRequest notifies the service that the nitric server is no longer awaiting a response
+// for a request, e.g. because its deadline was exceeded or its caller went away.
+type CancelRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *CancelRequest) Reset() {
+	*x = CancelRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_nitric_proto_apis_v1_apis_proto_msgTypes[10]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CancelRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelRequest) ProtoMessage() {}
+
+func (x *CancelRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_nitric_proto_apis_v1_apis_proto_msgTypes[10]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelRequest.ProtoReflect.Descriptor instead.
+func (*CancelRequest) Descriptor() ([]byte, []int) {
+	return file_nitric_proto_apis_v1_apis_proto_rawDescGZIP(), []int{10}
+}
+
 type RegistrationResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -563,7 +794,7 @@ type RegistrationResponse struct {
 func (x *RegistrationResponse) Reset() {
 	*x = RegistrationResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_nitric_proto_apis_v1_apis_proto_msgTypes[8]
+		mi := &file_nitric_proto_apis_v1_apis_proto_msgTypes[11]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -576,7 +807,7 @@ func (x *RegistrationResponse) String() string {
 func (*RegistrationResponse) ProtoMessage() {}
 
 func (x *RegistrationResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_nitric_proto_apis_v1_apis_proto_msgTypes[8]
+	mi := &file_nitric_proto_apis_v1_apis_proto_msgTypes[11]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -589,7 +820,7 @@ func (x *RegistrationResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RegistrationResponse.ProtoReflect.Descriptor instead.
 func (*RegistrationResponse) Descriptor() ([]byte, []int) {
-	return file_nitric_proto_apis_v1_apis_proto_rawDescGZIP(), []int{8}
+	return file_nitric_proto_apis_v1_apis_proto_rawDescGZIP(), []int{11}
 }
 
 type ApiWorkerScopes struct {
@@ -603,7 +834,7 @@ type ApiWorkerScopes struct {
 func (x *ApiWorkerScopes) Reset() {
 	*x = ApiWorkerScopes{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_nitric_proto_apis_v1_apis_proto_msgTypes[9]
+		mi := &file_nitric_proto_apis_v1_apis_proto_msgTypes[12]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -616,7 +847,7 @@ func (x *ApiWorkerScopes) String() string {
 func (*ApiWorkerScopes) ProtoMessage() {}
 
 func (x *ApiWorkerScopes) ProtoReflect() protoreflect.Message {
-	mi := &file_nitric_proto_apis_v1_apis_proto_msgTypes[9]
+	mi := &file_nitric_proto_apis_v1_apis_proto_msgTypes[12]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -629,7 +860,7 @@ func (x *ApiWorkerScopes) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ApiWorkerScopes.ProtoReflect.Descriptor instead.
 func (*ApiWorkerScopes) Descriptor() ([]byte, []int) {
-	return file_nitric_proto_apis_v1_apis_proto_rawDescGZIP(), []int{9}
+	return file_nitric_proto_apis_v1_apis_proto_rawDescGZIP(), []int{12}
 }
 
 func (x *ApiWorkerScopes) GetScopes() []string {
@@ -657,7 +888,7 @@ type ApiWorkerOptions struct {
 func (x *ApiWorkerOptions) Reset() {
 	*x = ApiWorkerOptions{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_nitric_proto_apis_v1_apis_proto_msgTypes[10]
+		mi := &file_nitric_proto_apis_v1_apis_proto_msgTypes[13]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -670,7 +901,7 @@ func (x *ApiWorkerOptions) String() string {
 func (*ApiWorkerOptions) ProtoMessage() {}
 
 func (x *ApiWorkerOptions) ProtoReflect() protoreflect.Message {
-	mi := &file_nitric_proto_apis_v1_apis_proto_msgTypes[10]
+	mi := &file_nitric_proto_apis_v1_apis_proto_msgTypes[13]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -683,7 +914,7 @@ func (x *ApiWorkerOptions) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ApiWorkerOptions.ProtoReflect.Descriptor instead.
 func (*ApiWorkerOptions) Descriptor() ([]byte, []int) {
-	return file_nitric_proto_apis_v1_apis_proto_rawDescGZIP(), []int{10}
+	return file_nitric_proto_apis_v1_apis_proto_rawDescGZIP(), []int{13}
 }
 
 func (x *ApiWorkerOptions) GetSecurity() map[string]*ApiWorkerScopes {
@@ -709,12 +940,15 @@ type RegistrationRequest struct {
 	Path    string            `protobuf:"bytes,2,opt,name=path,proto3" json:"path,omitempty"`
 	Methods []string          `protobuf:"bytes,3,rep,name=methods,proto3" json:"methods,omitempty"`
 	Options *ApiWorkerOptions `protobuf:"bytes,4,opt,name=options,proto3" json:"options,omitempty"`
+	// The service accepts streamed request bodies and may stream response bodies,
+	// otherwise request bodies are sent in full in the HttpRequest.
+	StreamBodies bool `protobuf:"varint,5,opt,name=stream_bodies,json=streamBodies,proto3" json:"stream_bodies,omitempty"`
 }
 
 func (x *RegistrationRequest) Reset() {
 	*x = RegistrationRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_nitric_proto_apis_v1_apis_proto_msgTypes[11]
+		mi := &file_nitric_proto_apis_v1_apis_proto_msgTypes[14]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -727,7 +961,7 @@ func (x *RegistrationRequest) String() string {
 func (*RegistrationRequest) ProtoMessage() {}
 
 func (x *RegistrationRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_nitric_proto_apis_v1_apis_proto_msgTypes[11]
+	mi := &file_nitric_proto_apis_v1_apis_proto_msgTypes[14]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -740,7 +974,7 @@ func (x *RegistrationRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RegistrationRequest.ProtoReflect.Descriptor instead.
 func (*RegistrationRequest) Descriptor() ([]byte, []int) {
-	return file_nitric_proto_apis_v1_apis_proto_rawDescGZIP(), []int{11}
+	return file_nitric_proto_apis_v1_apis_proto_rawDescGZIP(), []int{14}
 }
 
 func (x *RegistrationRequest) GetApi() string {
@@ -771,6 +1005,13 @@ func (x *RegistrationRequest) GetOptions() *ApiWorkerOptions {
 	return nil
 }
 
+func (x *RegistrationRequest) GetStreamBodies() bool {
+	if x != nil {
+		return x.StreamBodies
+	}
+	return false
+}
+
 var File_nitric_proto_apis_v1_apis_proto protoreflect.FileDescriptor
 
 var file_nitric_proto_apis_v1_apis_proto_rawDesc = []byte{
@@ -783,7 +1024,7 @@ var file_nitric_proto_apis_v1_apis_proto_rawDesc = []byte{
 	0x61, 0x70, 0x69, 0x4e, 0x61, 0x6d, 0x65, 0x22, 0x26, 0x0a, 0x12, 0x41, 0x70, 0x69, 0x44, 0x65,
 	0x74, 0x61, 0x69, 0x6c, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x10, 0x0a,
 	0x03, 0x75, 0x72, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x72, 0x6c, 0x22,
-	0xd5, 0x01, 0x0a, 0x0d, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67,
+	0xbd, 0x02, 0x0a, 0x0d, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67,
 	0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69,
 	0x64, 0x12, 0x5e, 0x0a, 0x14, 0x72, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f,
 	0x6e, 0x5f, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
@@ -795,77 +1036,118 @@ var file_nitric_proto_apis_v1_apis_proto_rawDesc = []byte{
 	0x73, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x6e, 0x69, 0x74, 0x72, 0x69,
 	0x63, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x61, 0x70, 0x69, 0x73, 0x2e, 0x76, 0x31, 0x2e,
 	0x48, 0x74, 0x74, 0x70, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x48, 0x00, 0x52, 0x0c,
-	0x68, 0x74, 0x74, 0x70, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x42, 0x09, 0x0a, 0x07,
-	0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x22, 0x23, 0x0a, 0x0b, 0x48, 0x65, 0x61, 0x64, 0x65,
-	0x72, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18,
-	0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x22, 0x22, 0x0a, 0x0a,
-	0x51, 0x75, 0x65, 0x72, 0x79, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61,
-	0x6c, 0x75, 0x65, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
-	0x22, 0xc2, 0x04, 0x0a, 0x0b, 0x48, 0x74, 0x74, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x12, 0x16, 0x0a, 0x06, 0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x06, 0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x74, 0x68,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x70, 0x61, 0x74, 0x68, 0x12, 0x48, 0x0a, 0x07,
-	0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2e, 0x2e,
-	0x6e, 0x69, 0x74, 0x72, 0x69, 0x63, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x61, 0x70, 0x69,
-	0x73, 0x2e, 0x76, 0x31, 0x2e, 0x48, 0x74, 0x74, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x2e, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x07, 0x68,
-	0x65, 0x61, 0x64, 0x65, 0x72, 0x73, 0x12, 0x55, 0x0a, 0x0c, 0x71, 0x75, 0x65, 0x72, 0x79, 0x5f,
-	0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x32, 0x2e, 0x6e,
-	0x69, 0x74, 0x72, 0x69, 0x63, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x61, 0x70, 0x69, 0x73,
-	0x2e, 0x76, 0x31, 0x2e, 0x48, 0x74, 0x74, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e,
-	0x51, 0x75, 0x65, 0x72, 0x79, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79,
-	0x52, 0x0b, 0x71, 0x75, 0x65, 0x72, 0x79, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x12, 0x52, 0x0a,
-	0x0b, 0x70, 0x61, 0x74, 0x68, 0x5f, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x18, 0x05, 0x20, 0x03,
-	0x28, 0x0b, 0x32, 0x31, 0x2e, 0x6e, 0x69, 0x74, 0x72, 0x69, 0x63, 0x2e, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x2e, 0x61, 0x70, 0x69, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x48, 0x74, 0x74, 0x70, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x50, 0x61, 0x74, 0x68, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73,
-	0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0a, 0x70, 0x61, 0x74, 0x68, 0x50, 0x61, 0x72, 0x61, 0x6d,
-	0x73, 0x12, 0x12, 0x0a, 0x04, 0x62, 0x6f, 0x64, 0x79, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0c, 0x52,
-	0x04, 0x62, 0x6f, 0x64, 0x79, 0x1a, 0x5d, 0x0a, 0x0c, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x73,
+	0x68, 0x74, 0x74, 0x70, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x66, 0x0a, 0x18,
+	0x68, 0x74, 0x74, 0x70, 0x5f, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x5f, 0x62, 0x6f,
+	0x64, 0x79, 0x5f, 0x63, 0x68, 0x75, 0x6e, 0x6b, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2b,
+	0x2e, 0x6e, 0x69, 0x74, 0x72, 0x69, 0x63, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x61, 0x70,
+	0x69, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x48, 0x74, 0x74, 0x70, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x42, 0x6f, 0x64, 0x79, 0x43, 0x68, 0x75, 0x6e, 0x6b, 0x48, 0x00, 0x52, 0x15, 0x68,
+	0x74, 0x74, 0x70, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x42, 0x6f, 0x64, 0x79, 0x43,
+	0x68, 0x75, 0x6e, 0x6b, 0x42, 0x09, 0x0a, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x22,
+	0x23, 0x0a, 0x0b, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x14,
+	0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x05, 0x76,
+	0x61, 0x6c, 0x75, 0x65, 0x22, 0x22, 0x0a, 0x0a, 0x51, 0x75, 0x65, 0x72, 0x79, 0x56, 0x61, 0x6c,
+	0x75, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x01, 0x20, 0x03, 0x28,
+	0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x22, 0xe7, 0x04, 0x0a, 0x0b, 0x48, 0x74, 0x74,
+	0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x6d, 0x65, 0x74, 0x68,
+	0x6f, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64,
+	0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x74, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
+	0x70, 0x61, 0x74, 0x68, 0x12, 0x48, 0x0a, 0x07, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x73, 0x18,
+	0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2e, 0x2e, 0x6e, 0x69, 0x74, 0x72, 0x69, 0x63, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x61, 0x70, 0x69, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x48, 0x74, 0x74,
+	0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x73,
+	0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x07, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x73, 0x12, 0x55,
+	0x0a, 0x0c, 0x71, 0x75, 0x65, 0x72, 0x79, 0x5f, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x18, 0x04,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x32, 0x2e, 0x6e, 0x69, 0x74, 0x72, 0x69, 0x63, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x2e, 0x61, 0x70, 0x69, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x48, 0x74, 0x74, 0x70,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x50, 0x61, 0x72,
+	0x61, 0x6d, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0b, 0x71, 0x75, 0x65, 0x72, 0x79, 0x50,
+	0x61, 0x72, 0x61, 0x6d, 0x73, 0x12, 0x52, 0x0a, 0x0b, 0x70, 0x61, 0x74, 0x68, 0x5f, 0x70, 0x61,
+	0x72, 0x61, 0x6d, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x31, 0x2e, 0x6e, 0x69, 0x74,
+	0x72, 0x69, 0x63, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x61, 0x70, 0x69, 0x73, 0x2e, 0x76,
+	0x31, 0x2e, 0x48, 0x74, 0x74, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x50, 0x61,
+	0x74, 0x68, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0a, 0x70,
+	0x61, 0x74, 0x68, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x62, 0x6f, 0x64,
+	0x79, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x62, 0x6f, 0x64, 0x79, 0x12, 0x23, 0x0a,
+	0x0d, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x65, 0x64, 0x5f, 0x62, 0x6f, 0x64, 0x79, 0x18, 0x07,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x0c, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x65, 0x64, 0x42, 0x6f,
+	0x64, 0x79, 0x1a, 0x5d, 0x0a, 0x0c, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x73, 0x45, 0x6e, 0x74,
+	0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x03, 0x6b, 0x65, 0x79, 0x12, 0x37, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x6e, 0x69, 0x74, 0x72, 0x69, 0x63, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x2e, 0x61, 0x70, 0x69, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x48, 0x65, 0x61, 0x64, 0x65,
+	0x72, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38,
+	0x01, 0x1a, 0x60, 0x0a, 0x10, 0x51, 0x75, 0x65, 0x72, 0x79, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73,
 	0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x37, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x6e, 0x69, 0x74, 0x72, 0x69, 0x63, 0x2e,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x61, 0x70, 0x69, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x48, 0x65,
-	0x61, 0x64, 0x65, 0x72, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
-	0x3a, 0x02, 0x38, 0x01, 0x1a, 0x60, 0x0a, 0x10, 0x51, 0x75, 0x65, 0x72, 0x79, 0x50, 0x61, 0x72,
-	0x61, 0x6d, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x36, 0x0a, 0x05, 0x76, 0x61,
-	0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x6e, 0x69, 0x74, 0x72,
-	0x69, 0x63, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x61, 0x70, 0x69, 0x73, 0x2e, 0x76, 0x31,
-	0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x05, 0x76, 0x61, 0x6c,
-	0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x3d, 0x0a, 0x0f, 0x50, 0x61, 0x74, 0x68, 0x50, 0x61,
-	0x72, 0x61, 0x6d, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76,
-	0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75,
-	0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0xe4, 0x01, 0x0a, 0x0c, 0x48, 0x74, 0x74, 0x70, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x49,
-	0x0a, 0x07, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32,
-	0x2f, 0x2e, 0x6e, 0x69, 0x74, 0x72, 0x69, 0x63, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x61,
-	0x70, 0x69, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x48, 0x74, 0x74, 0x70, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x2e, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79,
-	0x52, 0x07, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x62, 0x6f, 0x64,
-	0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x62, 0x6f, 0x64, 0x79, 0x1a, 0x5d, 0x0a,
+	0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x36, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x6e, 0x69, 0x74, 0x72, 0x69, 0x63, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x61, 0x70, 0x69, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75,
+	0x65, 0x72, 0x79, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a,
+	0x02, 0x38, 0x01, 0x1a, 0x3d, 0x0a, 0x0f, 0x50, 0x61, 0x74, 0x68, 0x50, 0x61, 0x72, 0x61, 0x6d,
+	0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02,
+	0x38, 0x01, 0x22, 0x3c, 0x0a, 0x14, 0x48, 0x74, 0x74, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x42, 0x6f, 0x64, 0x79, 0x43, 0x68, 0x75, 0x6e, 0x6b, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x61,
+	0x74, 0x61, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x12, 0x10,
+	0x0a, 0x03, 0x65, 0x6e, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x03, 0x65, 0x6e, 0x64,
+	0x22, 0x89, 0x02, 0x0a, 0x0c, 0x48, 0x74, 0x74, 0x70, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x49, 0x0a, 0x07, 0x68, 0x65, 0x61,
+	0x64, 0x65, 0x72, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2f, 0x2e, 0x6e, 0x69, 0x74,
+	0x72, 0x69, 0x63, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x61, 0x70, 0x69, 0x73, 0x2e, 0x76,
+	0x31, 0x2e, 0x48, 0x74, 0x74, 0x70, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x48,
+	0x65, 0x61, 0x64, 0x65, 0x72, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x07, 0x68, 0x65, 0x61,
+	0x64, 0x65, 0x72, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x62, 0x6f, 0x64, 0x79, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x0c, 0x52, 0x04, 0x62, 0x6f, 0x64, 0x79, 0x12, 0x23, 0x0a, 0x0d, 0x73, 0x74, 0x72, 0x65,
+	0x61, 0x6d, 0x65, 0x64, 0x5f, 0x62, 0x6f, 0x64, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x0c, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x65, 0x64, 0x42, 0x6f, 0x64, 0x79, 0x1a, 0x5d, 0x0a,
 	0x0c, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a,
 	0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12,
 	0x37, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x21,
 	0x2e, 0x6e, 0x69, 0x74, 0x72, 0x69, 0x63, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x61, 0x70,
 	0x69, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x56, 0x61, 0x6c, 0x75,
-	0x65, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0xd5, 0x01, 0x0a,
-	0x0d, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x0e,
-	0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x61,
-	0x0a, 0x15, 0x72, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x72,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2a, 0x2e,
-	0x6e, 0x69, 0x74, 0x72, 0x69, 0x63, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x61, 0x70, 0x69,
-	0x73, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f,
-	0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x48, 0x00, 0x52, 0x14, 0x72, 0x65, 0x67,
-	0x69, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x12, 0x46, 0x0a, 0x0c, 0x68, 0x74, 0x74, 0x70, 0x5f, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x6e, 0x69, 0x74, 0x72, 0x69, 0x63,
+	0x65, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x3d, 0x0a, 0x15,
+	0x48, 0x74, 0x74, 0x70, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x42, 0x6f, 0x64, 0x79,
+	0x43, 0x68, 0x75, 0x6e, 0x6b, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0c, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x12, 0x10, 0x0a, 0x03, 0x65, 0x6e, 0x64,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x03, 0x65, 0x6e, 0x64, 0x22, 0xa5, 0x04, 0x0a, 0x0d,
+	0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x0e, 0x0a,
+	0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x61, 0x0a,
+	0x15, 0x72, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x72, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2a, 0x2e, 0x6e,
+	0x69, 0x74, 0x72, 0x69, 0x63, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x61, 0x70, 0x69, 0x73,
+	0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x48, 0x00, 0x52, 0x14, 0x72, 0x65, 0x67, 0x69,
+	0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x46, 0x0a, 0x0c, 0x68, 0x74, 0x74, 0x70, 0x5f, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x6e, 0x69, 0x74, 0x72, 0x69, 0x63, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x61, 0x70, 0x69, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x48, 0x74,
+	0x74, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x48, 0x00, 0x52, 0x0b, 0x68, 0x74, 0x74,
+	0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x4c, 0x0a, 0x0e, 0x63, 0x61, 0x6e, 0x63,
+	0x65, 0x6c, 0x5f, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x23, 0x2e, 0x6e, 0x69, 0x74, 0x72, 0x69, 0x63, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e,
+	0x61, 0x70, 0x69, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x48, 0x00, 0x52, 0x0d, 0x63, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x63, 0x0a, 0x17, 0x68, 0x74, 0x74, 0x70, 0x5f, 0x72,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x5f, 0x62, 0x6f, 0x64, 0x79, 0x5f, 0x63, 0x68, 0x75, 0x6e,
+	0x6b, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2a, 0x2e, 0x6e, 0x69, 0x74, 0x72, 0x69, 0x63,
 	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x61, 0x70, 0x69, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x48,
-	0x74, 0x74, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x48, 0x00, 0x52, 0x0b, 0x68, 0x74,
-	0x74, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x42, 0x09, 0x0a, 0x07, 0x63, 0x6f, 0x6e,
-	0x74, 0x65, 0x6e, 0x74, 0x22, 0x16, 0x0a, 0x14, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x61,
+	0x74, 0x74, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x42, 0x6f, 0x64, 0x79, 0x43, 0x68,
+	0x75, 0x6e, 0x6b, 0x48, 0x00, 0x52, 0x14, 0x68, 0x74, 0x74, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x42, 0x6f, 0x64, 0x79, 0x43, 0x68, 0x75, 0x6e, 0x6b, 0x12, 0x5a, 0x0a, 0x0d, 0x74,
+	0x72, 0x61, 0x63, 0x65, 0x5f, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x18, 0x0a, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x35, 0x2e, 0x6e, 0x69, 0x74, 0x72, 0x69, 0x63, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x2e, 0x61, 0x70, 0x69, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72,
+	0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x2e, 0x54, 0x72, 0x61, 0x63, 0x65, 0x43, 0x6f, 0x6e,
+	0x74, 0x65, 0x78, 0x74, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0c, 0x74, 0x72, 0x61, 0x63, 0x65,
+	0x43, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x1a, 0x3f, 0x0a, 0x11, 0x54, 0x72, 0x61, 0x63, 0x65,
+	0x43, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03,
+	0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14,
+	0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76,
+	0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x42, 0x09, 0x0a, 0x07, 0x63, 0x6f, 0x6e, 0x74,
+	0x65, 0x6e, 0x74, 0x22, 0x0f, 0x0a, 0x0d, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x22, 0x16, 0x0a, 0x14, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x61,
 	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x29, 0x0a, 0x0f,
 	0x41, 0x70, 0x69, 0x57, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x53, 0x63, 0x6f, 0x70, 0x65, 0x73, 0x12,
 	0x16, 0x0a, 0x06, 0x73, 0x63, 0x6f, 0x70, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52,
@@ -885,7 +1167,7 @@ var file_nitric_proto_apis_v1_apis_proto_rawDesc = []byte{
 	0x6e, 0x69, 0x74, 0x72, 0x69, 0x63, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x61, 0x70, 0x69,
 	0x73, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x70, 0x69, 0x57, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x53, 0x63,
 	0x6f, 0x70, 0x65, 0x73, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22,
-	0x97, 0x01, 0x0a, 0x13, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0xbc, 0x01, 0x0a, 0x13, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
 	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x61, 0x70, 0x69, 0x18, 0x01,
 	0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x61, 0x70, 0x69, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x74,
 	0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x70, 0x61, 0x74, 0x68, 0x12, 0x18, 0x0a,
@@ -894,29 +1176,31 @@ var file_nitric_proto_apis_v1_apis_proto_rawDesc = []byte{
 	0x6e, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x6e, 0x69, 0x74, 0x72, 0x69,
 	0x63, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x61, 0x70, 0x69, 0x73, 0x2e, 0x76, 0x31, 0x2e,
 	0x41, 0x70, 0x69, 0x57, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73,
-	0x52, 0x07, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x32, 0xbd, 0x01, 0x0a, 0x03, 0x41, 0x70,
-	0x69, 0x12, 0x55, 0x0a, 0x05, 0x53, 0x65, 0x72, 0x76, 0x65, 0x12, 0x23, 0x2e, 0x6e, 0x69, 0x74,
-	0x72, 0x69, 0x63, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x61, 0x70, 0x69, 0x73, 0x2e, 0x76,
-	0x31, 0x2e, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x1a,
+	0x52, 0x07, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x23, 0x0a, 0x0d, 0x73, 0x74, 0x72,
+	0x65, 0x61, 0x6d, 0x5f, 0x62, 0x6f, 0x64, 0x69, 0x65, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x0c, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x42, 0x6f, 0x64, 0x69, 0x65, 0x73, 0x32, 0xbd,
+	0x01, 0x0a, 0x03, 0x41, 0x70, 0x69, 0x12, 0x55, 0x0a, 0x05, 0x53, 0x65, 0x72, 0x76, 0x65, 0x12,
 	0x23, 0x2e, 0x6e, 0x69, 0x74, 0x72, 0x69, 0x63, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x61,
-	0x70, 0x69, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x4d, 0x65, 0x73,
-	0x73, 0x61, 0x67, 0x65, 0x28, 0x01, 0x30, 0x01, 0x12, 0x5f, 0x0a, 0x0a, 0x41, 0x70, 0x69, 0x44,
-	0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x12, 0x27, 0x2e, 0x6e, 0x69, 0x74, 0x72, 0x69, 0x63, 0x2e,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x61, 0x70, 0x69, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x70,
-	0x69, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
-	0x28, 0x2e, 0x6e, 0x69, 0x74, 0x72, 0x69, 0x63, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x61,
-	0x70, 0x69, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x70, 0x69, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c,
-	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x42, 0x92, 0x01, 0x0a, 0x17, 0x69, 0x6f,
-	0x2e, 0x6e, 0x69, 0x74, 0x72, 0x69, 0x63, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x61, 0x70,
-	0x69, 0x73, 0x2e, 0x76, 0x31, 0x42, 0x0b, 0x41, 0x70, 0x69, 0x73, 0x53, 0x65, 0x72, 0x76, 0x69,
-	0x63, 0x65, 0x50, 0x01, 0x5a, 0x3a, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d,
-	0x2f, 0x6e, 0x69, 0x74, 0x72, 0x69, 0x63, 0x74, 0x65, 0x63, 0x68, 0x2f, 0x6e, 0x69, 0x74, 0x72,
-	0x69, 0x63, 0x2f, 0x63, 0x6f, 0x72, 0x65, 0x2f, 0x70, 0x6b, 0x67, 0x2f, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x2f, 0x61, 0x70, 0x69, 0x73, 0x2f, 0x76, 0x31, 0x3b, 0x61, 0x70, 0x69, 0x73, 0x70, 0x62,
-	0xaa, 0x02, 0x14, 0x4e, 0x69, 0x74, 0x72, 0x69, 0x63, 0x2e, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x2e,
-	0x41, 0x70, 0x69, 0x73, 0x2e, 0x76, 0x31, 0xca, 0x02, 0x14, 0x4e, 0x69, 0x74, 0x72, 0x69, 0x63,
-	0x5c, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x5c, 0x41, 0x70, 0x69, 0x73, 0x5c, 0x56, 0x31, 0x62, 0x06,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x70, 0x69, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x4d, 0x65, 0x73,
+	0x73, 0x61, 0x67, 0x65, 0x1a, 0x23, 0x2e, 0x6e, 0x69, 0x74, 0x72, 0x69, 0x63, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x2e, 0x61, 0x70, 0x69, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65, 0x72, 0x76,
+	0x65, 0x72, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x28, 0x01, 0x30, 0x01, 0x12, 0x5f, 0x0a,
+	0x0a, 0x41, 0x70, 0x69, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x12, 0x27, 0x2e, 0x6e, 0x69,
+	0x74, 0x72, 0x69, 0x63, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x61, 0x70, 0x69, 0x73, 0x2e,
+	0x76, 0x31, 0x2e, 0x41, 0x70, 0x69, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x28, 0x2e, 0x6e, 0x69, 0x74, 0x72, 0x69, 0x63, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x2e, 0x61, 0x70, 0x69, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x70, 0x69, 0x44,
+	0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x42, 0x92,
+	0x01, 0x0a, 0x17, 0x69, 0x6f, 0x2e, 0x6e, 0x69, 0x74, 0x72, 0x69, 0x63, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x2e, 0x61, 0x70, 0x69, 0x73, 0x2e, 0x76, 0x31, 0x42, 0x0b, 0x41, 0x70, 0x69, 0x73,
+	0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x50, 0x01, 0x5a, 0x3a, 0x67, 0x69, 0x74, 0x68, 0x75,
+	0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x6e, 0x69, 0x74, 0x72, 0x69, 0x63, 0x74, 0x65, 0x63, 0x68,
+	0x2f, 0x6e, 0x69, 0x74, 0x72, 0x69, 0x63, 0x2f, 0x63, 0x6f, 0x72, 0x65, 0x2f, 0x70, 0x6b, 0x67,
+	0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x61, 0x70, 0x69, 0x73, 0x2f, 0x76, 0x31, 0x3b, 0x61,
+	0x70, 0x69, 0x73, 0x70, 0x62, 0xaa, 0x02, 0x14, 0x4e, 0x69, 0x74, 0x72, 0x69, 0x63, 0x2e, 0x50,
+	0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x41, 0x70, 0x69, 0x73, 0x2e, 0x76, 0x31, 0xca, 0x02, 0x14, 0x4e,
+	0x69, 0x74, 0x72, 0x69, 0x63, 0x5c, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x5c, 0x41, 0x70, 0x69, 0x73,
+	0x5c, 0x56, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -931,50 +1215,58 @@ func file_nitric_proto_apis_v1_apis_proto_rawDescGZIP() []byte {
 	return file_nitric_proto_apis_v1_apis_proto_rawDescData
 }
 
-var file_nitric_proto_apis_v1_apis_proto_msgTypes = make([]protoimpl.MessageInfo, 17)
+var file_nitric_proto_apis_v1_apis_proto_msgTypes = make([]protoimpl.MessageInfo, 21)
 var file_nitric_proto_apis_v1_apis_proto_goTypes = []interface{}{
-	(*ApiDetailsRequest)(nil),    // 0: nitric.proto.apis.v1.ApiDetailsRequest
-	(*ApiDetailsResponse)(nil),   // 1: nitric.proto.apis.v1.ApiDetailsResponse
-	(*ClientMessage)(nil),        // 2: nitric.proto.apis.v1.ClientMessage
-	(*HeaderValue)(nil),          // 3: nitric.proto.apis.v1.HeaderValue
-	(*QueryValue)(nil),           // 4: nitric.proto.apis.v1.QueryValue
-	(*HttpRequest)(nil),          // 5: nitric.proto.apis.v1.HttpRequest
-	(*HttpResponse)(nil),         // 6: nitric.proto.apis.v1.HttpResponse
-	(*ServerMessage)(nil),        // 7: nitric.proto.apis.v1.ServerMessage
-	(*RegistrationResponse)(nil), // 8: nitric.proto.apis.v1.RegistrationResponse
-	(*ApiWorkerScopes)(nil),      // 9: nitric.proto.apis.v1.ApiWorkerScopes
-	(*ApiWorkerOptions)(nil),     // 10: nitric.proto.apis.v1.ApiWorkerOptions
-	(*RegistrationRequest)(nil),  // 11: nitric.proto.apis.v1.RegistrationRequest
-	nil,                          // 12: nitric.proto.apis.v1.HttpRequest.HeadersEntry
-	nil,                          // 13: nitric.proto.apis.v1.HttpRequest.QueryParamsEntry
-	nil,                          // 14: nitric.proto.apis.v1.HttpRequest.PathParamsEntry
-	nil,                          // 15: nitric.proto.apis.v1.HttpResponse.HeadersEntry
-	nil,                          // 16: nitric.proto.apis.v1.ApiWorkerOptions.SecurityEntry
+	(*ApiDetailsRequest)(nil),     // 0: nitric.proto.apis.v1.ApiDetailsRequest
+	(*ApiDetailsResponse)(nil),    // 1: nitric.proto.apis.v1.ApiDetailsResponse
+	(*ClientMessage)(nil),         // 2: nitric.proto.apis.v1.ClientMessage
+	(*HeaderValue)(nil),           // 3: nitric.proto.apis.v1.HeaderValue
+	(*QueryValue)(nil),            // 4: nitric.proto.apis.v1.QueryValue
+	(*HttpRequest)(nil),           // 5: nitric.proto.apis.v1.HttpRequest
+	(*HttpRequestBodyChunk)(nil),  // 6: nitric.proto.apis.v1.HttpRequestBodyChunk
+	(*HttpResponse)(nil),          // 7: nitric.proto.apis.v1.HttpResponse
+	(*HttpResponseBodyChunk)(nil), // 8: nitric.proto.apis.v1.HttpResponseBodyChunk
+	(*ServerMessage)(nil),         // 9: nitric.proto.apis.v1.ServerMessage
+	(*CancelRequest)(nil),         // 10: nitric.proto.apis.v1.CancelRequest
+	(*RegistrationResponse)(nil),  // 11: nitric.proto.apis.v1.RegistrationResponse
+	(*ApiWorkerScopes)(nil),       // 12: nitric.proto.apis.v1.ApiWorkerScopes
+	(*ApiWorkerOptions)(nil),      // 13: nitric.proto.apis.v1.ApiWorkerOptions
+	(*RegistrationRequest)(nil),   // 14: nitric.proto.apis.v1.RegistrationRequest
+	nil,                           // 15: nitric.proto.apis.v1.HttpRequest.HeadersEntry
+	nil,                           // 16: nitric.proto.apis.v1.HttpRequest.QueryParamsEntry
+	nil,                           // 17: nitric.proto.apis.v1.HttpRequest.PathParamsEntry
+	nil,                           // 18: nitric.proto.apis.v1.HttpResponse.HeadersEntry
+	nil,                           // 19: nitric.proto.apis.v1.ServerMessage.TraceContextEntry
+	nil,                           // 20: nitric.proto.apis.v1.ApiWorkerOptions.SecurityEntry
 }
 var file_nitric_proto_apis_v1_apis_proto_depIdxs = []int32{
-	11, // 0: nitric.proto.apis.v1.ClientMessage.registration_request:type_name -> nitric.proto.apis.v1.RegistrationRequest
-	6,  // 1: nitric.proto.apis.v1.ClientMessage.http_response:type_name -> nitric.proto.apis.v1.HttpResponse
-	12, // 2: nitric.proto.apis.v1.HttpRequest.headers:type_name -> nitric.proto.apis.v1.HttpRequest.HeadersEntry
-	13, // 3: nitric.proto.apis.v1.HttpRequest.query_params:type_name -> nitric.proto.apis.v1.HttpRequest.QueryParamsEntry
-	14, // 4: nitric.proto.apis.v1.HttpRequest.path_params:type_name -> nitric.proto.apis.v1.HttpRequest.PathParamsEntry
-	15, // 5: nitric.proto.apis.v1.HttpResponse.headers:type_name -> nitric.proto.apis.v1.HttpResponse.HeadersEntry
-	8,  // 6: nitric.proto.apis.v1.ServerMessage.registration_response:type_name -> nitric.proto.apis.v1.RegistrationResponse
-	5,  // 7: nitric.proto.apis.v1.ServerMessage.http_request:type_name -> nitric.proto.apis.v1.HttpRequest
-	16, // 8: nitric.proto.apis.v1.ApiWorkerOptions.security:type_name -> nitric.proto.apis.v1.ApiWorkerOptions.SecurityEntry
-	10, // 9: nitric.proto.apis.v1.RegistrationRequest.options:type_name -> nitric.proto.apis.v1.ApiWorkerOptions
-	3,  // 10: nitric.proto.apis.v1.HttpRequest.HeadersEntry.value:type_name -> nitric.proto.apis.v1.HeaderValue
-	4,  // 11: nitric.proto.apis.v1.HttpRequest.QueryParamsEntry.value:type_name -> nitric.proto.apis.v1.QueryValue
-	3,  // 12: nitric.proto.apis.v1.HttpResponse.HeadersEntry.value:type_name -> nitric.proto.apis.v1.HeaderValue
-	9,  // 13: nitric.proto.apis.v1.ApiWorkerOptions.SecurityEntry.value:type_name -> nitric.proto.apis.v1.ApiWorkerScopes
-	2,  // 14: nitric.proto.apis.v1.Api.Serve:input_type -> nitric.proto.apis.v1.ClientMessage
-	0,  // 15: nitric.proto.apis.v1.Api.ApiDetails:input_type -> nitric.proto.apis.v1.ApiDetailsRequest
-	7,  // 16: nitric.proto.apis.v1.Api.Serve:output_type -> nitric.proto.apis.v1.ServerMessage
-	1,  // 17: nitric.proto.apis.v1.Api.ApiDetails:output_type -> nitric.proto.apis.v1.ApiDetailsResponse
-	16, // [16:18] is the sub-list for method output_type
-	14, // [14:16] is the sub-list for method input_type
-	14, // [14:14] is the sub-list for extension type_name
-	14, // [14:14] is the sub-list for extension extendee
-	0,  // [0:14] is the sub-list for field type_name
+	14, // 0: nitric.proto.apis.v1.ClientMessage.registration_request:type_name -> nitric.proto.apis.v1.RegistrationRequest
+	7,  // 1: nitric.proto.apis.v1.ClientMessage.http_response:type_name -> nitric.proto.apis.v1.HttpResponse
+	8,  // 2: nitric.proto.apis.v1.ClientMessage.http_response_body_chunk:type_name -> nitric.proto.apis.v1.HttpResponseBodyChunk
+	15, // 3: nitric.proto.apis.v1.HttpRequest.headers:type_name -> nitric.proto.apis.v1.HttpRequest.HeadersEntry
+	16, // 4: nitric.proto.apis.v1.HttpRequest.query_params:type_name -> nitric.proto.apis.v1.HttpRequest.QueryParamsEntry
+	17, // 5: nitric.proto.apis.v1.HttpRequest.path_params:type_name -> nitric.proto.apis.v1.HttpRequest.PathParamsEntry
+	18, // 6: nitric.proto.apis.v1.HttpResponse.headers:type_name -> nitric.proto.apis.v1.HttpResponse.HeadersEntry
+	11, // 7: nitric.proto.apis.v1.ServerMessage.registration_response:type_name -> nitric.proto.apis.v1.RegistrationResponse
+	5,  // 8: nitric.proto.apis.v1.ServerMessage.http_request:type_name -> nitric.proto.apis.v1.HttpRequest
+	10, // 9: nitric.proto.apis.v1.ServerMessage.cancel_request:type_name -> nitric.proto.apis.v1.CancelRequest
+	6,  // 10: nitric.proto.apis.v1.ServerMessage.http_request_body_chunk:type_name -> nitric.proto.apis.v1.HttpRequestBodyChunk
+	19, // 11: nitric.proto.apis.v1.ServerMessage.trace_context:type_name -> nitric.proto.apis.v1.ServerMessage.TraceContextEntry
+	20, // 12: nitric.proto.apis.v1.ApiWorkerOptions.security:type_name -> nitric.proto.apis.v1.ApiWorkerOptions.SecurityEntry
+	13, // 13: nitric.proto.apis.v1.RegistrationRequest.options:type_name -> nitric.proto.apis.v1.ApiWorkerOptions
+	3,  // 14: nitric.proto.apis.v1.HttpRequest.HeadersEntry.value:type_name -> nitric.proto.apis.v1.HeaderValue
+	4,  // 15: nitric.proto.apis.v1.HttpRequest.QueryParamsEntry.value:type_name -> nitric.proto.apis.v1.QueryValue
+	3,  // 16: nitric.proto.apis.v1.HttpResponse.HeadersEntry.value:type_name -> nitric.proto.apis.v1.HeaderValue
+	12, // 17: nitric.proto.apis.v1.ApiWorkerOptions.SecurityEntry.value:type_name -> nitric.proto.apis.v1.ApiWorkerScopes
+	2,  // 18: nitric.proto.apis.v1.Api.Serve:input_type -> nitric.proto.apis.v1.ClientMessage
+	0,  // 19: nitric.proto.apis.v1.Api.ApiDetails:input_type -> nitric.proto.apis.v1.ApiDetailsRequest
+	9,  // 20: nitric.proto.apis.v1.Api.Serve:output_type -> nitric.proto.apis.v1.ServerMessage
+	1,  // 21: nitric.proto.apis.v1.Api.ApiDetails:output_type -> nitric.proto.apis.v1.ApiDetailsResponse
+	20, // [20:22] is the sub-list for method output_type
+	18, // [18:20] is the sub-list for method input_type
+	18, // [18:18] is the sub-list for extension type_name
+	18, // [18:18] is the sub-list for extension extendee
+	0,  // [0:18] is the sub-list for field type_name
 }
 
 func init() { file_nitric_proto_apis_v1_apis_proto_init() }
@@ -1056,7 +1348,7 @@ func file_nitric_proto_apis_v1_apis_proto_init() {
 			}
 		}
 		file_nitric_proto_apis_v1_apis_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*HttpResponse); i {
+			switch v := v.(*HttpRequestBodyChunk); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1068,7 +1360,7 @@ func file_nitric_proto_apis_v1_apis_proto_init() {
 			}
 		}
 		file_nitric_proto_apis_v1_apis_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ServerMessage); i {
+			switch v := v.(*HttpResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1080,7 +1372,7 @@ func file_nitric_proto_apis_v1_apis_proto_init() {
 			}
 		}
 		file_nitric_proto_apis_v1_apis_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RegistrationResponse); i {
+			switch v := v.(*HttpResponseBodyChunk); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1092,7 +1384,7 @@ func file_nitric_proto_apis_v1_apis_proto_init() {
 			}
 		}
 		file_nitric_proto_apis_v1_apis_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ApiWorkerScopes); i {
+			switch v := v.(*ServerMessage); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1104,7 +1396,7 @@ func file_nitric_proto_apis_v1_apis_proto_init() {
 			}
 		}
 		file_nitric_proto_apis_v1_apis_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ApiWorkerOptions); i {
+			switch v := v.(*CancelRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1116,6 +1408,42 @@ func file_nitric_proto_apis_v1_apis_proto_init() {
 			}
 		}
 		file_nitric_proto_apis_v1_apis_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RegistrationResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_nitric_proto_apis_v1_apis_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ApiWorkerScopes); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_nitric_proto_apis_v1_apis_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ApiWorkerOptions); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_nitric_proto_apis_v1_apis_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*RegistrationRequest); i {
 			case 0:
 				return &v.state
@@ -1131,10 +1459,13 @@ func file_nitric_proto_apis_v1_apis_proto_init() {
 	file_nitric_proto_apis_v1_apis_proto_msgTypes[2].OneofWrappers = []interface{}{
 		(*ClientMessage_RegistrationRequest)(nil),
 		(*ClientMessage_HttpResponse)(nil),
+		(*ClientMessage_HttpResponseBodyChunk)(nil),
 	}
-	file_nitric_proto_apis_v1_apis_proto_msgTypes[7].OneofWrappers = []interface{}{
+	file_nitric_proto_apis_v1_apis_proto_msgTypes[9].OneofWrappers = []interface{}{
 		(*ServerMessage_RegistrationResponse)(nil),
 		(*ServerMessage_HttpRequest)(nil),
+		(*ServerMessage_CancelRequest)(nil),
+		(*ServerMessage_HttpRequestBodyChunk)(nil),
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
@@ -1142,7 +1473,7 @@ func file_nitric_proto_apis_v1_apis_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_nitric_proto_apis_v1_apis_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   17,
+			NumMessages:   21,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
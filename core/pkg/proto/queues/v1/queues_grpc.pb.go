@@ -28,6 +28,10 @@ type QueuesClient interface {
 	Dequeue(ctx context.Context, in *QueueDequeueRequest, opts ...grpc.CallOption) (*QueueDequeueResponse, error)
 	// Complete an message previously popped from a queue
 	Complete(ctx context.Context, in *QueueCompleteRequest, opts ...grpc.CallOption) (*QueueCompleteResponse, error)
+	// Extend the lease on a message previously popped from a queue
+	ExtendLease(ctx context.Context, in *QueueExtendLeaseRequest, opts ...grpc.CallOption) (*QueueExtendLeaseResponse, error)
+	// Release a message previously popped from a queue, making it available to be dequeued again
+	Release(ctx context.Context, in *QueueReleaseRequest, opts ...grpc.CallOption) (*QueueReleaseResponse, error)
 }
 
 type queuesClient struct {
@@ -65,6 +69,24 @@ func (c *queuesClient) Complete(ctx context.Context, in *QueueCompleteRequest, o
 	return out, nil
 }
 
+func (c *queuesClient) ExtendLease(ctx context.Context, in *QueueExtendLeaseRequest, opts ...grpc.CallOption) (*QueueExtendLeaseResponse, error) {
+	out := new(QueueExtendLeaseResponse)
+	err := c.cc.Invoke(ctx, "/nitric.proto.queues.v1.Queues/ExtendLease", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queuesClient) Release(ctx context.Context, in *QueueReleaseRequest, opts ...grpc.CallOption) (*QueueReleaseResponse, error) {
+	out := new(QueueReleaseResponse)
+	err := c.cc.Invoke(ctx, "/nitric.proto.queues.v1.Queues/Release", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueuesServer is the server API for Queues service.
 // All implementations should embed UnimplementedQueuesServer
 // for forward compatibility
@@ -75,6 +97,10 @@ type QueuesServer interface {
 	Dequeue(context.Context, *QueueDequeueRequest) (*QueueDequeueResponse, error)
 	// Complete an message previously popped from a queue
 	Complete(context.Context, *QueueCompleteRequest) (*QueueCompleteResponse, error)
+	// Extend the lease on a message previously popped from a queue
+	ExtendLease(context.Context, *QueueExtendLeaseRequest) (*QueueExtendLeaseResponse, error)
+	// Release a message previously popped from a queue, making it available to be dequeued again
+	Release(context.Context, *QueueReleaseRequest) (*QueueReleaseResponse, error)
 }
 
 // UnimplementedQueuesServer should be embedded to have forward compatible implementations.
@@ -90,6 +116,12 @@ func (UnimplementedQueuesServer) Dequeue(context.Context, *QueueDequeueRequest)
 func (UnimplementedQueuesServer) Complete(context.Context, *QueueCompleteRequest) (*QueueCompleteResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Complete not implemented")
 }
+func (UnimplementedQueuesServer) ExtendLease(context.Context, *QueueExtendLeaseRequest) (*QueueExtendLeaseResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExtendLease not implemented")
+}
+func (UnimplementedQueuesServer) Release(context.Context, *QueueReleaseRequest) (*QueueReleaseResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Release not implemented")
+}
 
 // UnsafeQueuesServer may be embedded to opt out of forward compatibility for this service.
 // Use of this interface is not recommended, as added methods to QueuesServer will
@@ -156,6 +188,42 @@ func _Queues_Complete_Handler(srv interface{}, ctx context.Context, dec func(int
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Queues_ExtendLease_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueueExtendLeaseRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueuesServer).ExtendLease(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/nitric.proto.queues.v1.Queues/ExtendLease",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueuesServer).ExtendLease(ctx, req.(*QueueExtendLeaseRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Queues_Release_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueueReleaseRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueuesServer).Release(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/nitric.proto.queues.v1.Queues/Release",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueuesServer).Release(ctx, req.(*QueueReleaseRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Queues_ServiceDesc is the grpc.ServiceDesc for Queues service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -175,6 +243,14 @@ var Queues_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "Complete",
 			Handler:    _Queues_Complete_Handler,
 		},
+		{
+			MethodName: "ExtendLease",
+			Handler:    _Queues_ExtendLease_Handler,
+		},
+		{
+			MethodName: "Release",
+			Handler:    _Queues_Release_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "nitric/proto/queues/v1/queues.proto",
@@ -133,3 +133,203 @@ var Schedules_ServiceDesc = grpc.ServiceDesc{
 	},
 	Metadata: "nitric/proto/schedules/v1/schedules.proto",
 }
+
+// ScheduleManagerClient is the client API for ScheduleManager service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type ScheduleManagerClient interface {
+	// Trigger a run of a schedule now, outside of its cadence
+	Trigger(ctx context.Context, in *ScheduleTriggerRequest, opts ...grpc.CallOption) (*ScheduleTriggerResponse, error)
+	// Pause a schedule, no runs are triggered until it's resumed
+	Pause(ctx context.Context, in *SchedulePauseRequest, opts ...grpc.CallOption) (*SchedulePauseResponse, error)
+	// Resume a paused schedule
+	Resume(ctx context.Context, in *ScheduleResumeRequest, opts ...grpc.CallOption) (*ScheduleResumeResponse, error)
+	// Describe the state and next fire times of a schedule
+	Describe(ctx context.Context, in *ScheduleDescribeRequest, opts ...grpc.CallOption) (*ScheduleDescribeResponse, error)
+}
+
+type scheduleManagerClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewScheduleManagerClient(cc grpc.ClientConnInterface) ScheduleManagerClient {
+	return &scheduleManagerClient{cc}
+}
+
+func (c *scheduleManagerClient) Trigger(ctx context.Context, in *ScheduleTriggerRequest, opts ...grpc.CallOption) (*ScheduleTriggerResponse, error) {
+	out := new(ScheduleTriggerResponse)
+	err := c.cc.Invoke(ctx, "/nitric.proto.schedules.v1.ScheduleManager/Trigger", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *scheduleManagerClient) Pause(ctx context.Context, in *SchedulePauseRequest, opts ...grpc.CallOption) (*SchedulePauseResponse, error) {
+	out := new(SchedulePauseResponse)
+	err := c.cc.Invoke(ctx, "/nitric.proto.schedules.v1.ScheduleManager/Pause", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *scheduleManagerClient) Resume(ctx context.Context, in *ScheduleResumeRequest, opts ...grpc.CallOption) (*ScheduleResumeResponse, error) {
+	out := new(ScheduleResumeResponse)
+	err := c.cc.Invoke(ctx, "/nitric.proto.schedules.v1.ScheduleManager/Resume", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *scheduleManagerClient) Describe(ctx context.Context, in *ScheduleDescribeRequest, opts ...grpc.CallOption) (*ScheduleDescribeResponse, error) {
+	out := new(ScheduleDescribeResponse)
+	err := c.cc.Invoke(ctx, "/nitric.proto.schedules.v1.ScheduleManager/Describe", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// ScheduleManagerServer is the server API for ScheduleManager service.
+// All implementations should embed UnimplementedScheduleManagerServer
+// for forward compatibility
+type ScheduleManagerServer interface {
+	// Trigger a run of a schedule now, outside of its cadence
+	Trigger(context.Context, *ScheduleTriggerRequest) (*ScheduleTriggerResponse, error)
+	// Pause a schedule, no runs are triggered until it's resumed
+	Pause(context.Context, *SchedulePauseRequest) (*SchedulePauseResponse, error)
+	// Resume a paused schedule
+	Resume(context.Context, *ScheduleResumeRequest) (*ScheduleResumeResponse, error)
+	// Describe the state and next fire times of a schedule
+	Describe(context.Context, *ScheduleDescribeRequest) (*ScheduleDescribeResponse, error)
+}
+
+// UnimplementedScheduleManagerServer should be embedded to have forward compatible implementations.
+type UnimplementedScheduleManagerServer struct {
+}
+
+func (UnimplementedScheduleManagerServer) Trigger(context.Context, *ScheduleTriggerRequest) (*ScheduleTriggerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Trigger not implemented")
+}
+func (UnimplementedScheduleManagerServer) Pause(context.Context, *SchedulePauseRequest) (*SchedulePauseResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Pause not implemented")
+}
+func (UnimplementedScheduleManagerServer) Resume(context.Context, *ScheduleResumeRequest) (*ScheduleResumeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Resume not implemented")
+}
+func (UnimplementedScheduleManagerServer) Describe(context.Context, *ScheduleDescribeRequest) (*ScheduleDescribeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Describe not implemented")
+}
+
+// UnsafeScheduleManagerServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to ScheduleManagerServer will
+// result in compilation errors.
+type UnsafeScheduleManagerServer interface {
+	mustEmbedUnimplementedScheduleManagerServer()
+}
+
+func RegisterScheduleManagerServer(s grpc.ServiceRegistrar, srv ScheduleManagerServer) {
+	s.RegisterService(&ScheduleManager_ServiceDesc, srv)
+}
+
+func _ScheduleManager_Trigger_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ScheduleTriggerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ScheduleManagerServer).Trigger(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/nitric.proto.schedules.v1.ScheduleManager/Trigger",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ScheduleManagerServer).Trigger(ctx, req.(*ScheduleTriggerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ScheduleManager_Pause_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SchedulePauseRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ScheduleManagerServer).Pause(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/nitric.proto.schedules.v1.ScheduleManager/Pause",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ScheduleManagerServer).Pause(ctx, req.(*SchedulePauseRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ScheduleManager_Resume_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ScheduleResumeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ScheduleManagerServer).Resume(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/nitric.proto.schedules.v1.ScheduleManager/Resume",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ScheduleManagerServer).Resume(ctx, req.(*ScheduleResumeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ScheduleManager_Describe_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ScheduleDescribeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ScheduleManagerServer).Describe(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/nitric.proto.schedules.v1.ScheduleManager/Describe",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ScheduleManagerServer).Describe(ctx, req.(*ScheduleDescribeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// ScheduleManager_ServiceDesc is the grpc.ServiceDesc for ScheduleManager service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var ScheduleManager_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "nitric.proto.schedules.v1.ScheduleManager",
+	HandlerType: (*ScheduleManagerServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Trigger",
+			Handler:    _ScheduleManager_Trigger_Handler,
+		},
+		{
+			MethodName: "Pause",
+			Handler:    _ScheduleManager_Pause_Handler,
+		},
+		{
+			MethodName: "Resume",
+			Handler:    _ScheduleManager_Resume_Handler,
+		},
+		{
+			MethodName: "Describe",
+			Handler:    _ScheduleManager_Describe_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "nitric/proto/schedules/v1/schedules.proto",
+}
@@ -0,0 +1,191 @@
+// Copyright 2021 Nitric Technologies Pty Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net"
+	nethttp "net/http"
+	"time"
+
+	"github.com/nitrictech/nitric/core/pkg/workers"
+)
+
+// The time to wait for requests to complete when stopping the admin and metrics servers
+const adminShutdownTimeout = time.Second
+
+// AdminCheck is the result of a single readiness check
+type AdminCheck struct {
+	Ready  bool   `json:"ready"`
+	Detail string `json:"detail,omitempty"`
+}
+
+// AdminReadiness reports whether the server is ready to handle triggers, with the result of each check
+type AdminReadiness struct {
+	Ready  bool                  `json:"ready"`
+	Checks map[string]AdminCheck `json:"checks"`
+}
+
+// AdminWorkers reports the workers registered with the server
+type AdminWorkers struct {
+	Workers  int                  `json:"workers"`
+	InFlight int                  `json:"inFlight"`
+	Triggers []workers.WorkerInfo `json:"triggers"`
+}
+
+// Workers returns the workers registered for each trigger across all worker plugins
+func (s *NitricServer) Workers() []workers.WorkerInfo {
+	info := []workers.WorkerInfo{}
+	info = append(info, s.ApiPlugin.Workers()...)
+	info = append(info, s.HttpPlugin.Workers()...)
+	info = append(info, s.SchedulesPlugin.Workers()...)
+	info = append(info, s.TopicsListenerPlugin.Workers()...)
+	info = append(info, s.StorageListenerPlugin.Workers()...)
+	info = append(info, s.WebsocketListenerPlugin.Workers()...)
+	info = append(info, s.JobHandlerPlugin.Workers()...)
+
+	return info
+}
+
+// Readiness checks that the minimum number of workers are registered, the gateway has started and the user process is running
+func (s *NitricServer) Readiness() AdminReadiness {
+	workerCount := s.WorkerCount()
+
+	checks := map[string]AdminCheck{
+		"workers": {
+			Ready:  workerCount >= s.MinWorkers,
+			Detail: fmt.Sprintf("%d of %d required workers available", workerCount, s.MinWorkers),
+		},
+		"gateway": {
+			Ready: s.gatewayRunning.Load(),
+		},
+	}
+
+	if len(s.ChildCommand) > 0 {
+		checks["process"] = AdminCheck{
+			Ready: s.processManager.UserProcessRunning(),
+		}
+	}
+
+	readiness := AdminReadiness{
+		Ready:  true,
+		Checks: checks,
+	}
+
+	for _, check := range checks {
+		readiness.Ready = readiness.Ready && check.Ready
+	}
+
+	return readiness
+}
+
+func writeAdminResponse(w nethttp.ResponseWriter, status int, body any) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+
+	if err := json.NewEncoder(w).Encode(body); err != nil {
+		log.Error("error writing admin response", "error", err)
+	}
+}
+
+func (s *NitricServer) adminHandler() nethttp.Handler {
+	mux := nethttp.NewServeMux()
+
+	// liveness only requires the server to respond
+	mux.HandleFunc("GET /healthz", func(w nethttp.ResponseWriter, r *nethttp.Request) {
+		writeAdminResponse(w, nethttp.StatusOK, map[string]string{"status": "ok"})
+	})
+
+	mux.HandleFunc("GET /readyz", func(w nethttp.ResponseWriter, r *nethttp.Request) {
+		readiness := s.Readiness()
+
+		status := nethttp.StatusOK
+		if !readiness.Ready {
+			status = nethttp.StatusServiceUnavailable
+		}
+
+		writeAdminResponse(w, status, readiness)
+	})
+
+	mux.HandleFunc("GET /workers", func(w nethttp.ResponseWriter, r *nethttp.Request) {
+		writeAdminResponse(w, nethttp.StatusOK, AdminWorkers{
+			Workers:  s.WorkerCount(),
+			InFlight: s.InFlightCount(),
+			Triggers: s.Workers(),
+		})
+	})
+
+	return mux
+}
+
+// startAdminServer serves the admin endpoints on the admin address, if one has been configured
+func (s *NitricServer) startAdminServer() error {
+	if s.AdminAddress == "" {
+		return nil
+	}
+
+	srv, err := serveHttp("admin", s.AdminAddress, s.adminHandler())
+	if err != nil {
+		return err
+	}
+
+	s.adminServer = srv
+
+	return nil
+}
+
+func (s *NitricServer) stopAdminServer() {
+	shutdownHttp("admin", s.adminServer)
+}
+
+// serveHttp serves the handler on the address in the background, until the returned server is shut down
+func serveHttp(name string, address string, handler nethttp.Handler) (*nethttp.Server, error) {
+	lis, err := net.Listen("tcp", address)
+	if err != nil {
+		return nil, fmt.Errorf("could not listen on configured %s address: %w", name, err)
+	}
+
+	srv := &nethttp.Server{
+		Handler:           handler,
+		ReadHeaderTimeout: 5 * time.Second,
+	}
+
+	go func() {
+		log.Debug("endpoints listening", "endpoints", name, "address", lis.Addr().String())
+
+		if err := srv.Serve(lis); err != nil && !errors.Is(err, nethttp.ErrServerClosed) {
+			log.Error("endpoints stopped serving", "endpoints", name, "error", err)
+		}
+	}()
+
+	return srv, nil
+}
+
+// shutdownHttp stops a server started with serveHttp, waiting for in-progress requests to complete
+func shutdownHttp(name string, srv *nethttp.Server) {
+	if srv == nil {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), adminShutdownTimeout)
+	defer cancel()
+
+	if err := srv.Shutdown(ctx); err != nil {
+		log.Warn("endpoints did not stop cleanly", "endpoints", name, "error", err)
+	}
+}
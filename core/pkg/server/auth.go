@@ -0,0 +1,102 @@
+// Copyright 2021 Nitric Technologies Pty Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/subtle"
+	"encoding/hex"
+	"fmt"
+	"strings"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	"github.com/nitrictech/nitric/core/pkg/env"
+)
+
+// ServiceTokenEnv is the environment variable the service token is passed to the child process in
+const ServiceTokenEnv = "NITRIC_SERVICE_TOKEN"
+
+// The metadata key the service token is sent in by clients, as a bearer token
+const serviceTokenMetadataKey = "authorization"
+
+// GenerateServiceToken generates a random token for authenticating calls to the server
+func GenerateServiceToken() (string, error) {
+	token := make([]byte, 32)
+	if _, err := rand.Read(token); err != nil {
+		return "", err
+	}
+
+	return hex.EncodeToString(token), nil
+}
+
+// ServiceTokenFromEnv generates a service token when SERVICE_TOKEN_AUTH is enabled, otherwise it returns an empty token
+func ServiceTokenFromEnv() (string, error) {
+	tokenAuth, err := env.SERVICE_TOKEN_AUTH.Bool()
+	if err != nil {
+		return "", fmt.Errorf("invalid SERVICE_TOKEN_AUTH, expected true or false: %w", err)
+	}
+
+	if !tokenAuth {
+		return "", nil
+	}
+
+	token, err := GenerateServiceToken()
+	if err != nil {
+		return "", fmt.Errorf("unable to generate service token: %w", err)
+	}
+
+	return token, nil
+}
+
+// checkServiceToken returns an Unauthenticated error unless the call carries the service token
+func checkServiceToken(ctx context.Context, token string) error {
+	md, _ := metadata.FromIncomingContext(ctx)
+
+	for _, value := range md.Get(serviceTokenMetadataKey) {
+		bearer, ok := strings.CutPrefix(value, "Bearer ")
+		if ok && subtle.ConstantTimeCompare([]byte(bearer), []byte(token)) == 1 {
+			return nil
+		}
+	}
+
+	return status.Error(codes.Unauthenticated, "missing or invalid service token")
+}
+
+// serviceTokenUnaryInterceptor rejects unary calls that don't carry the service token
+func serviceTokenUnaryInterceptor(token string) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		if err := checkServiceToken(ctx, token); err != nil {
+			return nil, err
+		}
+
+		return handler(ctx, req)
+	}
+}
+
+// serviceTokenStreamInterceptor rejects streams, such as worker connections, that don't carry the service token
+func serviceTokenStreamInterceptor(token string) grpc.StreamServerInterceptor {
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		if err := checkServiceToken(ss.Context(), token); err != nil {
+			return err
+		}
+
+		return handler(srv, ss)
+	}
+}
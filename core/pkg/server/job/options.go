@@ -15,6 +15,9 @@
 package job
 
 import (
+	"crypto/tls"
+
+	"github.com/nitrictech/nitric/core/pkg/decorators"
 	batchpb "github.com/nitrictech/nitric/core/pkg/proto/batch/v1"
 	kvstorepb "github.com/nitrictech/nitric/core/pkg/proto/kvstore/v1"
 	queuespb "github.com/nitrictech/nitric/core/pkg/proto/queues/v1"
@@ -23,6 +26,7 @@ import (
 	storagepb "github.com/nitrictech/nitric/core/pkg/proto/storage/v1"
 	topicspb "github.com/nitrictech/nitric/core/pkg/proto/topics/v1"
 	websocketspb "github.com/nitrictech/nitric/core/pkg/proto/websockets/v1"
+	"google.golang.org/grpc"
 )
 
 type nitricJobServerOption = func(*NitricJobServer)
@@ -74,3 +78,55 @@ func WithBatchPlugin(srv batchpb.BatchServer) nitricJobServerOption {
 		o.batchServer = srv
 	}
 }
+
+// WithServiceAddress serves the runtime services on the address, either a TCP address or a unix:// socket
+func WithServiceAddress(address string) nitricJobServerOption {
+	return func(o *NitricJobServer) {
+		o.serviceAddress = address
+	}
+}
+
+// WithServiceToken requires calls to the runtime services to carry the token as a bearer token in their authorization metadata,
+// the token is passed to the job command in the NITRIC_SERVICE_TOKEN environment variable.
+func WithServiceToken(token string) nitricJobServerOption {
+	return func(o *NitricJobServer) {
+		o.serviceToken = token
+	}
+}
+
+// WithServiceTLSConfig serves the runtime services over TLS, set ClientAuth and ClientCAs in the config to require client certificates (mTLS)
+func WithServiceTLSConfig(config *tls.Config) nitricJobServerOption {
+	return func(o *NitricJobServer) {
+		o.serviceTLSConfig = config
+	}
+}
+
+// WithGrpcServerOptions adds options to the gRPC server serving the runtime plugins
+func WithGrpcServerOptions(opts ...grpc.ServerOption) nitricJobServerOption {
+	return func(o *NitricJobServer) {
+		o.grpcServerOptions = append(o.grpcServerOptions, opts...)
+	}
+}
+
+// WithUnaryInterceptors adds interceptors around every unary plugin server call, they're called in the order they're added
+func WithUnaryInterceptors(interceptors ...grpc.UnaryServerInterceptor) nitricJobServerOption {
+	return WithGrpcServerOptions(grpc.ChainUnaryInterceptor(interceptors...))
+}
+
+// WithStreamInterceptors adds interceptors around every streaming plugin server call, they're called in the order they're added
+func WithStreamInterceptors(interceptors ...grpc.StreamServerInterceptor) nitricJobServerOption {
+	return WithGrpcServerOptions(grpc.ChainStreamInterceptor(interceptors...))
+}
+
+// WithDecorators wraps the plugin server of type T with the given decorators, e.g.
+//
+//	job.WithDecorators(func(inner storagepb.StorageServer) storagepb.StorageServer { ... })
+//
+// decorators are called in the order they're added and wrap the plugin server directly.
+func WithDecorators[T any](ds ...decorators.Decorator[T]) nitricJobServerOption {
+	return func(o *NitricJobServer) {
+		for _, d := range ds {
+			o.decorators = append(o.decorators, d)
+		}
+	}
+}
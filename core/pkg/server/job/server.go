@@ -15,13 +15,15 @@
 package job
 
 import (
+	"crypto/tls"
 	"fmt"
 	"log"
-	"net"
 	"os"
 	"os/exec"
 	"strings"
 
+	"github.com/nitrictech/nitric/core/pkg/decorators"
+	"github.com/nitrictech/nitric/core/pkg/env"
 	batchpb "github.com/nitrictech/nitric/core/pkg/proto/batch/v1"
 	kvstorepb "github.com/nitrictech/nitric/core/pkg/proto/kvstore/v1"
 	queuespb "github.com/nitrictech/nitric/core/pkg/proto/queues/v1"
@@ -30,6 +32,7 @@ import (
 	storagepb "github.com/nitrictech/nitric/core/pkg/proto/storage/v1"
 	topicspb "github.com/nitrictech/nitric/core/pkg/proto/topics/v1"
 	websocketspb "github.com/nitrictech/nitric/core/pkg/proto/websockets/v1"
+	"github.com/nitrictech/nitric/core/pkg/server"
 	"google.golang.org/grpc"
 )
 
@@ -48,24 +51,57 @@ type NitricJobServer struct {
 	kvStoreServer   kvstorepb.KvStoreServer
 	websocketServer websocketspb.WebsocketServer
 	batchServer     batchpb.BatchServer
+
+	// The address the runtime services are served on, either a TCP address or unix:// socket, defaults to SERVICE_ADDRESS
+	serviceAddress string
+	// The token calls to the runtime services must carry, generated when SERVICE_TOKEN_AUTH is enabled
+	serviceToken string
+	// Serves the runtime services over TLS, loaded from the SERVICE_TLS_* environment variables when not set
+	serviceTLSConfig *tls.Config
+
+	grpcServerOptions []grpc.ServerOption
+	// Plugin server decorators, each a decorators.Decorator of the plugin server type it wraps
+	decorators []any
 }
 
 func (j *NitricJobServer) Run() error {
-	// Start the gRPC server for runtime services
-	grpcServer := grpc.NewServer()
-
-	topicspb.RegisterTopicsServer(grpcServer, j.topicServer)
-	storagepb.RegisterStorageServer(grpcServer, j.storageServer)
-	queuespb.RegisterQueuesServer(grpcServer, j.queueServer)
-	secretspb.RegisterSecretManagerServer(grpcServer, j.secretServer)
-	sqlpb.RegisterSqlServer(grpcServer, j.sqlServer)
-	kvstorepb.RegisterKvStoreServer(grpcServer, j.kvStoreServer)
-	websocketspb.RegisterWebsocketServer(grpcServer, j.websocketServer)
-	batchpb.RegisterBatchServer(grpcServer, j.batchServer)
-
-	lis, err := net.Listen("tcp", "127.0.0.1:")
+	if j.serviceAddress == "" {
+		j.serviceAddress = env.SERVICE_ADDRESS.String()
+	}
+
+	if j.serviceToken == "" {
+		token, err := server.ServiceTokenFromEnv()
+		if err != nil {
+			return err
+		}
+
+		j.serviceToken = token
+	}
+
+	if j.serviceTLSConfig == nil {
+		tlsConfig, err := server.ServiceTLSConfigFromEnv()
+		if err != nil {
+			return err
+		}
+
+		j.serviceTLSConfig = tlsConfig
+	}
+
+	// Start the gRPC server for runtime services, unauthenticated calls are rejected before any other interceptors are called
+	grpcServer := grpc.NewServer(append(server.ServiceTransportOptions(j.serviceToken, j.serviceTLSConfig), j.grpcServerOptions...)...)
+
+	topicspb.RegisterTopicsServer(grpcServer, decorators.Apply(j.topicServer, j.decorators))
+	storagepb.RegisterStorageServer(grpcServer, decorators.Apply(j.storageServer, j.decorators))
+	queuespb.RegisterQueuesServer(grpcServer, decorators.Apply(j.queueServer, j.decorators))
+	secretspb.RegisterSecretManagerServer(grpcServer, decorators.Apply(j.secretServer, j.decorators))
+	sqlpb.RegisterSqlServer(grpcServer, decorators.Apply(j.sqlServer, j.decorators))
+	kvstorepb.RegisterKvStoreServer(grpcServer, decorators.Apply(j.kvStoreServer, j.decorators))
+	websocketspb.RegisterWebsocketServer(grpcServer, decorators.Apply(j.websocketServer, j.decorators))
+	batchpb.RegisterBatchServer(grpcServer, decorators.Apply(j.batchServer, j.decorators))
+
+	lis, err := server.Listen(j.serviceAddress)
 	if err != nil {
-		return err
+		return fmt.Errorf("could not listen on configured service address: %w", err)
 	}
 
 	// Start the grpc services
@@ -87,7 +123,10 @@ func (j *NitricJobServer) Run() error {
 
 	cmdEnv := append([]string{}, os.Environ()...)
 
-	cmdEnv = append(cmdEnv, fmt.Sprintf("SERVICE_ADDRESS=%s", lis.Addr().String()))
+	cmdEnv = append(cmdEnv, fmt.Sprintf("SERVICE_ADDRESS=%s", server.ListenerServiceAddress(lis)))
+	if j.serviceToken != "" {
+		cmdEnv = append(cmdEnv, fmt.Sprintf("%s=%s", server.ServiceTokenEnv, j.serviceToken))
+	}
 	// copy the current environment variables
 	cmd.Env = cmdEnv
 
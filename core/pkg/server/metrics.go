@@ -0,0 +1,49 @@
+// Copyright 2021 Nitric Technologies Pty Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	nethttp "net/http"
+
+	"github.com/nitrictech/nitric/core/pkg/metrics"
+)
+
+// startMetricsServer serves the Prometheus scrape endpoint on the metrics address, if one has been configured
+func (s *NitricServer) startMetricsServer() error {
+	if s.MetricsAddress == "" {
+		return nil
+	}
+
+	handler, err := metrics.NewPrometheusHandler()
+	if err != nil {
+		return err
+	}
+
+	mux := nethttp.NewServeMux()
+	mux.Handle("GET /metrics", handler)
+
+	srv, err := serveHttp("metrics", s.MetricsAddress, mux)
+	if err != nil {
+		return err
+	}
+
+	s.metricsServer = srv
+
+	return nil
+}
+
+func (s *NitricServer) stopMetricsServer() {
+	shutdownHttp("metrics", s.metricsServer)
+}
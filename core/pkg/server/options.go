@@ -15,11 +15,18 @@
 package server
 
 import (
+	"crypto/tls"
+
+	"google.golang.org/grpc"
+
+	"github.com/nitrictech/nitric/core/pkg/decorators"
 	"github.com/nitrictech/nitric/core/pkg/gateway"
+	pm "github.com/nitrictech/nitric/core/pkg/process"
 	batchpb "github.com/nitrictech/nitric/core/pkg/proto/batch/v1"
 	kvstorepb "github.com/nitrictech/nitric/core/pkg/proto/kvstore/v1"
 	queuespb "github.com/nitrictech/nitric/core/pkg/proto/queues/v1"
 	resourcespb "github.com/nitrictech/nitric/core/pkg/proto/resources/v1"
+	schedulespb "github.com/nitrictech/nitric/core/pkg/proto/schedules/v1"
 	secretspb "github.com/nitrictech/nitric/core/pkg/proto/secrets/v1"
 	sqlpb "github.com/nitrictech/nitric/core/pkg/proto/sql/v1"
 	storagepb "github.com/nitrictech/nitric/core/pkg/proto/storage/v1"
@@ -60,6 +67,12 @@ func WithBatchPlugin(bp batchpb.BatchServer) ServerOption {
 	}
 }
 
+func WithScheduleManagerPlugin(sm schedulespb.ScheduleManagerServer) ServerOption {
+	return func(opts *NitricServer) {
+		opts.ScheduleManagerPlugin = sm
+	}
+}
+
 func WithTopicsPlugin(tp topicspb.TopicsServer) ServerOption {
 	return func(opts *NitricServer) {
 		opts.TopicsPlugin = tp
@@ -144,6 +157,21 @@ func WithServiceAddress(address string) ServerOption {
 	}
 }
 
+// WithServiceToken requires calls to the services to carry the token as a bearer token in their authorization metadata,
+// the token is passed to the child process in the NITRIC_SERVICE_TOKEN environment variable.
+func WithServiceToken(token string) ServerOption {
+	return func(opts *NitricServer) {
+		opts.ServiceToken = token
+	}
+}
+
+// WithServiceTLSConfig serves the services over TLS, set ClientAuth and ClientCAs in the config to require client certificates (mTLS)
+func WithServiceTLSConfig(config *tls.Config) ServerOption {
+	return func(opts *NitricServer) {
+		opts.ServiceTLSConfig = config
+	}
+}
+
 // WithMinWorkers - Set the minimum number of workers that need to be available.
 // this option is ignored if the MIN_WORKERS environment variable is set
 func WithMinWorkers(minWorkers int) ServerOption {
@@ -152,6 +180,62 @@ func WithMinWorkers(minWorkers int) ServerOption {
 	}
 }
 
+// WithAdminAddress enables the admin health and introspection endpoints on the given address
+func WithAdminAddress(address string) ServerOption {
+	return func(opts *NitricServer) {
+		opts.AdminAddress = address
+	}
+}
+
+// WithMetricsAddress enables the Prometheus metrics scrape endpoint on the given address
+func WithMetricsAddress(address string) ServerOption {
+	return func(opts *NitricServer) {
+		opts.MetricsAddress = address
+	}
+}
+
+// WithProvider sets the name of the provider implementing the plugin servers, e.g. aws
+func WithProvider(provider string) ServerOption {
+	return func(opts *NitricServer) {
+		opts.Provider = provider
+	}
+}
+
+// WithGrpcServerOptions adds options to the gRPC server, they're applied after the default options
+func WithGrpcServerOptions(grpcOpts ...grpc.ServerOption) ServerOption {
+	return func(opts *NitricServer) {
+		opts.GrpcServerOptions = append(opts.GrpcServerOptions, grpcOpts...)
+	}
+}
+
+// WithUnaryInterceptors adds interceptors around every unary plugin server call, they're called in the order they're added
+func WithUnaryInterceptors(interceptors ...grpc.UnaryServerInterceptor) ServerOption {
+	return func(opts *NitricServer) {
+		opts.UnaryInterceptors = append(opts.UnaryInterceptors, interceptors...)
+	}
+}
+
+// WithStreamInterceptors adds interceptors around every streaming plugin server call, they're called in the order they're added
+func WithStreamInterceptors(interceptors ...grpc.StreamServerInterceptor) ServerOption {
+	return func(opts *NitricServer) {
+		opts.StreamInterceptors = append(opts.StreamInterceptors, interceptors...)
+	}
+}
+
+// WithDecorators wraps the plugin server of type T with the given decorators, e.g.
+//
+//	server.WithDecorators(func(inner storagepb.StorageServer) storagepb.StorageServer { ... })
+//
+// decorators are called in the order they're added and wrap the plugin server directly, after the nitric
+// validation and compat decorators, so they only see requests that have already been validated.
+func WithDecorators[T any](ds ...decorators.Decorator[T]) ServerOption {
+	return func(opts *NitricServer) {
+		for _, d := range ds {
+			opts.decorators = append(opts.decorators, d)
+		}
+	}
+}
+
 func WithChildCommand(command []string) ServerOption {
 	return func(opts *NitricServer) {
 		opts.ChildCommand = command
@@ -164,8 +248,20 @@ func WithPreCommands(commands [][]string) ServerOption {
 	}
 }
 
+func WithPreProcesses(processes ...pm.ProcessSpec) ServerOption {
+	return func(opts *NitricServer) {
+		opts.PreProcesses = append(opts.PreProcesses, processes...)
+	}
+}
+
 func WithChildTimeoutSeconds(timeout int) ServerOption {
 	return func(opts *NitricServer) {
 		opts.ChildTimeoutSeconds = timeout
 	}
 }
+
+func WithDrainTimeoutSeconds(timeout int) ServerOption {
+	return func(opts *NitricServer) {
+		opts.DrainTimeoutSeconds = timeout
+	}
+}
@@ -28,8 +28,6 @@ func Run(m *NitricServer) {
 	signal.Notify(term, os.Interrupt, syscall.SIGTERM)
 	signal.Notify(term, os.Interrupt, syscall.SIGINT)
 
-	logger.SetLogLevel(logger.INFO)
-
 	errChan := make(chan error)
 
 	// Start the runtime server
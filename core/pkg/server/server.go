@@ -15,11 +15,13 @@
 package server
 
 import (
+	"crypto/tls"
 	"errors"
 	"fmt"
 	"math"
-	"net"
+	nethttp "net/http"
 	"os"
+	"sync/atomic"
 	"time"
 
 	"google.golang.org/grpc"
@@ -28,6 +30,7 @@ import (
 	"github.com/nitrictech/nitric/core/pkg/env"
 	"github.com/nitrictech/nitric/core/pkg/gateway"
 	"github.com/nitrictech/nitric/core/pkg/logger"
+	"github.com/nitrictech/nitric/core/pkg/metrics"
 	pm "github.com/nitrictech/nitric/core/pkg/process"
 	apispb "github.com/nitrictech/nitric/core/pkg/proto/apis/v1"
 	batchpb "github.com/nitrictech/nitric/core/pkg/proto/batch/v1"
@@ -43,6 +46,8 @@ import (
 	topicspb "github.com/nitrictech/nitric/core/pkg/proto/topics/v1"
 	websocketspb "github.com/nitrictech/nitric/core/pkg/proto/websockets/v1"
 	"github.com/nitrictech/nitric/core/pkg/server/runtime"
+	"github.com/nitrictech/nitric/core/pkg/tracing"
+	"github.com/nitrictech/nitric/core/pkg/workers"
 	"github.com/nitrictech/nitric/core/pkg/workers/apis"
 	"github.com/nitrictech/nitric/core/pkg/workers/http"
 	"github.com/nitrictech/nitric/core/pkg/workers/jobs"
@@ -52,37 +57,68 @@ import (
 	"github.com/nitrictech/nitric/core/pkg/workers/websockets"
 )
 
+var log = logger.Component("server")
+
 type NitricServer struct {
 	processManager pm.ProcessManager
 	grpcServer     *grpc.Server
+	adminServer    *nethttp.Server
+	metricsServer  *nethttp.Server
+	// true once the gateway is ready to handle requests, until it stops
+	gatewayRunning atomic.Bool
 
 	// Options
+	// The address the services listen on, either host:port or a unix domain socket, e.g. unix:///var/run/nitric.sock
 	ServiceAddress string
+	// The token calls to the services must carry, passed to the child process, disabled when empty
+	ServiceToken string
+	// The TLS configuration the services are served with, the services are served without TLS when nil
+	ServiceTLSConfig *tls.Config
+	// The address of the admin health and introspection endpoints, disabled when empty
+	AdminAddress string
+	// The address of the Prometheus metrics scrape endpoint, disabled when empty
+	MetricsAddress string
+	// The name of the provider implementing the plugin servers, recorded with plugin call metrics
+	Provider string
 	// The command that will be used to invoke the child process
 	ChildCommand []string
 	// Commands that will be started before all others
 	PreCommands [][]string
+	// Supervised processes that will be started before all others, with restart policies and readiness probes
+	PreProcesses []pm.ProcessSpec
 
 	// The total time to wait for the child process to be available in seconds
 	ChildTimeoutSeconds int
 
+	// The total time to wait for in-flight requests and child processes to complete when stopping in seconds
+	DrainTimeoutSeconds int
+
 	// The minimum number of workers that need to be available
 	MinWorkers int
 
+	// Additional gRPC server options, applied after the default options
+	GrpcServerOptions []grpc.ServerOption
+	// Interceptors called around every plugin server call, after the tracing and metrics interceptors
+	UnaryInterceptors  []grpc.UnaryServerInterceptor
+	StreamInterceptors []grpc.StreamServerInterceptor
+	// Plugin server decorators, each a decorators.Decorator of the plugin server type it wraps
+	decorators []any
+
 	// The provider adapter gateway
 	GatewayPlugin gateway.GatewayService
 
 	ResourcesPlugin resourcespb.ResourcesServer
 
 	// Resource access plugins
-	KeyValuePlugin      kvstorepb.KvStoreServer
-	TopicsPlugin        topicspb.TopicsServer
-	StoragePlugin       storagepb.StorageServer
-	SecretManagerPlugin secretspb.SecretManagerServer
-	WebsocketPlugin     websocketspb.WebsocketServer
-	QueuesPlugin        queuespb.QueuesServer
-	SqlPlugin           sqlpb.SqlServer
-	BatchPlugin         batchpb.BatchServer
+	KeyValuePlugin        kvstorepb.KvStoreServer
+	TopicsPlugin          topicspb.TopicsServer
+	StoragePlugin         storagepb.StorageServer
+	SecretManagerPlugin   secretspb.SecretManagerServer
+	WebsocketPlugin       websocketspb.WebsocketServer
+	QueuesPlugin          queuespb.QueuesServer
+	SqlPlugin             sqlpb.SqlServer
+	BatchPlugin           batchpb.BatchServer
+	ScheduleManagerPlugin schedulespb.ScheduleManagerServer
 
 	// Worker plugins
 	ApiPlugin               apis.ApiRequestHandler
@@ -104,6 +140,33 @@ func (s *NitricServer) WorkerCount() int {
 		s.JobHandlerPlugin.WorkerCount()
 }
 
+// InFlightCount returns the number of requests currently awaiting a response from workers
+func (s *NitricServer) InFlightCount() int {
+	return workers.InFlight(s.Workers())
+}
+
+func (s *NitricServer) waitForInFlightRequests(deadline time.Time) error {
+	ticker := time.NewTicker(time.Duration(5) * time.Millisecond)
+
+	// stop the ticker on exit
+	defer ticker.Stop()
+
+	for {
+		if s.InFlightCount() == 0 {
+			break
+		}
+
+		// wait for the next tick
+		time := <-ticker.C
+
+		if time.After(deadline) {
+			return fmt.Errorf("%d requests still in-flight, timed out waiting for them to complete", s.InFlightCount())
+		}
+	}
+
+	return nil
+}
+
 func (s *NitricServer) waitForMinimumWorkers(timeout int) error {
 	waitUntil := time.Now().Add(time.Duration(timeout) * time.Second)
 	ticker := time.NewTicker(time.Duration(5) * time.Millisecond)
@@ -129,6 +192,9 @@ func (s *NitricServer) waitForMinimumWorkers(timeout int) error {
 
 type ServerStartOptions func(m *NitricServer)
 
+// WithGrpcServer replaces the default gRPC server, its default options and interceptors aren't applied,
+// use WithGrpcServerOptions and WithUnaryInterceptors to extend the default server instead.
+// Start returns an error if a service token or service TLS config is also set.
 func WithGrpcServer(s *grpc.Server) ServerStartOptions {
 	return func(m *NitricServer) {
 		m.grpcServer = s
@@ -137,12 +203,22 @@ func WithGrpcServer(s *grpc.Server) ServerStartOptions {
 
 // Start the server
 func (s *NitricServer) Start(startOpts ...ServerStartOptions) error {
+	for _, opt := range startOpts {
+		opt(s)
+	}
+
+	// a replacement gRPC server would silently serve without the configured authentication and transport security
+	if s.grpcServer != nil && (s.ServiceToken != "" || s.ServiceTLSConfig != nil) {
+		return fmt.Errorf("a service token and service TLS config can't be used with WithGrpcServer, configure them on the replacement gRPC server instead")
+	}
+
 	if err := s.processManager.StartPreProcesses(); err != nil {
 		return err
 	}
 
-	for _, opt := range startOpts {
-		opt(s)
+	// the meter provider is registered before the plugin servers are called
+	if err := s.startMetricsServer(); err != nil {
+		return err
 	}
 
 	maxWorkers, err := env.MAX_WORKERS.Int()
@@ -159,6 +235,19 @@ func (s *NitricServer) Start(startOpts ...ServerStartOptions) error {
 			grpc.MaxConcurrentStreams(uint32(maxWorkers)), //#nosec G115 -- max workers checked for potential out of range or overflow errors
 		}
 
+		// unauthenticated calls are rejected before any other interceptors are called
+		opts = append(opts, ServiceTransportOptions(s.ServiceToken, s.ServiceTLSConfig)...)
+
+		opts = append(opts,
+			// continue the traces of SDK calls to the plugin servers and record their metrics
+			grpc.ChainUnaryInterceptor(tracing.UnaryServerInterceptor(), metrics.UnaryServerInterceptor(s.Provider)),
+			grpc.ChainStreamInterceptor(tracing.StreamServerInterceptor(), metrics.StreamServerInterceptor(s.Provider)),
+			grpc.ChainUnaryInterceptor(s.UnaryInterceptors...),
+			grpc.ChainStreamInterceptor(s.StreamInterceptors...),
+		)
+
+		opts = append(opts, s.GrpcServerOptions...)
+
 		s.grpcServer = grpc.NewServer(opts...)
 	}
 
@@ -198,46 +287,62 @@ func (s *NitricServer) Start(startOpts ...ServerStartOptions) error {
 	}
 	batchpb.RegisterJobServer(s.grpcServer, s.JobHandlerPlugin)
 
+	// the admin endpoints are started once the worker plugins are available, so workers can be reported as they register
+	if err := s.startAdminServer(); err != nil {
+		return err
+	}
+
 	// Load & Register the service plugins
-	secretsServerWithValidation := decorators.SecretsServerWithValidation(s.SecretManagerPlugin)
-	keyvalueServerWithCompat := decorators.KeyValueServerWithCompat(s.KeyValuePlugin)
+	// user decorators wrap the raw plugins, so they only see requests that passed the nitric validation and compat decorators
+	secretsServerWithValidation := decorators.SecretsServerWithValidation(decorators.Apply(s.SecretManagerPlugin, s.decorators))
+	keyvalueServerWithCompat := decorators.KeyValueServerWithCompat(decorators.Apply(s.KeyValuePlugin, s.decorators))
+	topicsServerWithValidation := decorators.TopicsServerWithValidation(decorators.Apply(s.TopicsPlugin, s.decorators))
+	queuesServerWithValidation := decorators.QueuesServerWithValidation(decorators.Apply(s.QueuesPlugin, s.decorators))
+	scheduleManagerServerWithValidation := decorators.ScheduleManagerServerWithValidation(decorators.Apply(s.ScheduleManagerPlugin, s.decorators))
+	storageServerWithValidation := decorators.StorageServerWithValidation(decorators.Apply(s.StoragePlugin, s.decorators))
 
 	kvstorepb.RegisterKvStoreServer(s.grpcServer, keyvalueServerWithCompat)
 	keyvaluepb.RegisterKeyValueServer(s.grpcServer, keyvalueServerWithCompat)
-	topicspb.RegisterTopicsServer(s.grpcServer, s.TopicsPlugin)
-	storagepb.RegisterStorageServer(s.grpcServer, s.StoragePlugin)
+	topicspb.RegisterTopicsServer(s.grpcServer, topicsServerWithValidation)
+	storagepb.RegisterStorageServer(s.grpcServer, storageServerWithValidation)
 	secretspb.RegisterSecretManagerServer(s.grpcServer, secretsServerWithValidation)
-	resourcespb.RegisterResourcesServer(s.grpcServer, s.ResourcesPlugin)
-	websocketspb.RegisterWebsocketServer(s.grpcServer, s.WebsocketPlugin)
-	queuespb.RegisterQueuesServer(s.grpcServer, s.QueuesPlugin)
-	sqlpb.RegisterSqlServer(s.grpcServer, s.SqlPlugin)
-	batchpb.RegisterBatchServer(s.grpcServer, s.BatchPlugin)
-
-	lis, err := net.Listen("tcp", s.ServiceAddress)
+	resourcespb.RegisterResourcesServer(s.grpcServer, decorators.Apply(s.ResourcesPlugin, s.decorators))
+	websocketspb.RegisterWebsocketServer(s.grpcServer, decorators.Apply(s.WebsocketPlugin, s.decorators))
+	queuespb.RegisterQueuesServer(s.grpcServer, queuesServerWithValidation)
+	sqlpb.RegisterSqlServer(s.grpcServer, decorators.Apply(s.SqlPlugin, s.decorators))
+	batchpb.RegisterBatchServer(s.grpcServer, decorators.Apply(s.BatchPlugin, s.decorators))
+	schedulespb.RegisterScheduleManagerServer(s.grpcServer, scheduleManagerServerWithValidation)
+
+	lis, err := Listen(s.ServiceAddress)
 	if err != nil {
 		return fmt.Errorf("could not listen on configured service address: %w", err)
 	}
 
-	logger.Debug("Registered Gateway Plugin")
+	log.Debug("registered gateway plugin")
 
 	// Start the gRPC server
 	go (func() {
-		logger.Debugf("Services listening on: %s", s.ServiceAddress)
+		log.Debug("services listening", "address", s.ServiceAddress)
 		err := s.grpcServer.Serve(lis)
 		if err != nil {
-			logger.Errorf("grpc serve %v", err)
+			log.Error("grpc serve", "error", err)
 		}
 	})()
 
 	// Start our child process
 	// This will block until our child process is ready to accept incoming connections
-	if err := s.processManager.StartUserProcess(fmt.Sprintf("SERVICE_ADDRESS=%s", lis.Addr().String())); err != nil {
+	childEnv := []string{fmt.Sprintf("SERVICE_ADDRESS=%s", ListenerServiceAddress(lis))}
+	if s.ServiceToken != "" {
+		childEnv = append(childEnv, fmt.Sprintf("%s=%s", ServiceTokenEnv, s.ServiceToken))
+	}
+
+	if err := s.processManager.StartUserProcess(childEnv...); err != nil {
 		return err
 	}
 
 	// Wait for the minimum number of active workers to be available before beginning the gateway
 	// This ensures workers have registered and can handle triggers as soon the gateway is ready, if a minimum > 1 has been set
-	logger.Debug("Waiting for active workers")
+	log.Debug("waiting for active workers")
 	err = s.waitForMinimumWorkers(s.ChildTimeoutSeconds)
 	if err != nil {
 		return err
@@ -247,9 +352,9 @@ func (s *NitricServer) Start(startOpts ...ServerStartOptions) error {
 
 	// Start the gateway
 	go func(errch chan error) {
-		logger.Debugf("Starting Gateway, %d workers currently available", s.WorkerCount())
+		log.Debug("starting gateway", "workers", s.WorkerCount())
 
-		errch <- s.GatewayPlugin.Start(&gateway.GatewayStartOpts{
+		err := s.GatewayPlugin.Start(&gateway.GatewayStartOpts{
 			ApiPlugin:               s.ApiPlugin,
 			HttpPlugin:              s.HttpPlugin,
 			SchedulesPlugin:         s.SchedulesPlugin,
@@ -257,7 +362,14 @@ func (s *NitricServer) Start(startOpts ...ServerStartOptions) error {
 			StorageListenerPlugin:   s.StorageListenerPlugin,
 			WebsocketListenerPlugin: s.WebsocketListenerPlugin,
 			JobHandlerPlugin:        s.JobHandlerPlugin,
+			OnStarted: func() {
+				s.gatewayRunning.Store(true)
+			},
 		})
+
+		s.gatewayRunning.Store(false)
+
+		errch <- err
 	}(gatewayErrchan)
 
 	processErrchan := make(chan error)
@@ -283,10 +395,56 @@ func (s *NitricServer) Start(startOpts ...ServerStartOptions) error {
 	return exitErr
 }
 
+// Stop the server, draining in-flight requests before stopping the child processes
+//
+//	new triggers are no longer accepted once the gateway is stopped, requests already sent to workers are then given
+//	until the drain timeout to complete. Child processes still running after the drain timeout are killed.
 func (s *NitricServer) Stop() {
-	_ = s.GatewayPlugin.Stop()
-	s.grpcServer.Stop()
-	s.processManager.StopAll()
+	deadline := time.Now().Add(time.Duration(s.DrainTimeoutSeconds) * time.Second)
+
+	// gateways may wait for the triggers they're handling to complete before stopping
+	gatewayStopped := make(chan struct{})
+	go func() {
+		_ = s.GatewayPlugin.Stop()
+		close(gatewayStopped)
+	}()
+
+	select {
+	case <-gatewayStopped:
+	case <-time.After(time.Until(deadline)):
+		log.Warn("gateway did not stop within the drain timeout")
+	}
+
+	if s.grpcServer == nil {
+		s.processManager.StopAll(time.Until(deadline))
+		s.stopAdminServer()
+		s.stopMetricsServer()
+		return
+	}
+
+	log.Debug("draining in-flight requests", "in_flight", s.InFlightCount())
+	if err := s.waitForInFlightRequests(deadline); err != nil {
+		log.Warn("stopping before all requests completed", "error", err)
+	}
+
+	// Worker connection streams remain open until the child processes exit,
+	// so the gRPC server is stopped gracefully while the child processes are stopped
+	grpcStopped := make(chan struct{})
+	go func() {
+		s.grpcServer.GracefulStop()
+		close(grpcStopped)
+	}()
+
+	s.processManager.StopAll(time.Until(deadline))
+
+	select {
+	case <-grpcStopped:
+	case <-time.After(time.Until(deadline)):
+		s.grpcServer.Stop()
+	}
+
+	s.stopAdminServer()
+	s.stopMetricsServer()
 }
 
 // New - Create a new nitric server
@@ -306,9 +464,35 @@ func New(opts ...ServerOption) (*NitricServer, error) {
 		m.ServiceAddress = env.SERVICE_ADDRESS.String()
 	}
 
+	if m.ServiceToken == "" {
+		token, err := ServiceTokenFromEnv()
+		if err != nil {
+			return nil, err
+		}
+
+		m.ServiceToken = token
+	}
+
+	if m.ServiceTLSConfig == nil {
+		tlsConfig, err := ServiceTLSConfigFromEnv()
+		if err != nil {
+			return nil, err
+		}
+
+		m.ServiceTLSConfig = tlsConfig
+	}
+
+	if m.AdminAddress == "" {
+		m.AdminAddress = env.ADMIN_ADDRESS.String()
+	}
+
+	if m.MetricsAddress == "" {
+		m.MetricsAddress = env.METRICS_ADDRESS.String()
+	}
+
 	minWorkersEnv, err := env.MIN_WORKERS.Int()
 	if err == nil && m.MinWorkers < 0 {
-		logger.Debugf("MIN_WORKERS environment variable set to %d", minWorkersEnv)
+		log.Debug("MIN_WORKERS environment variable set", "min_workers", minWorkersEnv)
 		m.MinWorkers = minWorkersEnv
 	}
 
@@ -319,6 +503,15 @@ func New(opts ...ServerOption) (*NitricServer, error) {
 		return nil, fmt.Errorf("invalid WORKER_TIMEOUT: %w", err)
 	}
 
+	if m.DrainTimeoutSeconds < 1 {
+		drainTimeout, err := env.DRAIN_TIMEOUT.Int()
+		if err != nil {
+			return nil, fmt.Errorf("invalid DRAIN_TIMEOUT: %w", err)
+		}
+
+		m.DrainTimeoutSeconds = drainTimeout
+	}
+
 	if m.ChildCommand == nil {
 		if len(os.Args) > 1 {
 			m.ChildCommand = os.Args[1:]
@@ -329,7 +522,7 @@ func New(opts ...ServerOption) (*NitricServer, error) {
 		return nil, errors.New("missing gateway plugin, Gateway plugin must not be nil")
 	}
 
-	m.processManager = pm.NewProcessManager(m.ChildCommand, m.PreCommands)
+	m.processManager = pm.NewProcessManager(m.ChildCommand, append(m.PreProcesses, pm.PreCommandSpecs(m.PreCommands)...))
 
 	return m, nil
 }
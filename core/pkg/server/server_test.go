@@ -15,18 +15,70 @@
 package server_test
 
 import (
+	"context"
+	"encoding/json"
 	"fmt"
 	"net"
 	"net/http"
 	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
 
 	"github.com/golang/mock/gomock"
 	mock_gateway "github.com/nitrictech/nitric/core/mocks/gateway"
+	"github.com/nitrictech/nitric/core/pkg/gateway"
+	schedulespb "github.com/nitrictech/nitric/core/pkg/proto/schedules/v1"
+	storagepb "github.com/nitrictech/nitric/core/pkg/proto/storage/v1"
 	server "github.com/nitrictech/nitric/core/pkg/server"
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
 )
 
+// scheduleManager records the schedules it's asked to trigger
+type scheduleManager struct {
+	schedulespb.UnimplementedScheduleManagerServer
+
+	lock      sync.Mutex
+	triggered []string
+}
+
+func (s *scheduleManager) Trigger(ctx context.Context, req *schedulespb.ScheduleTriggerRequest) (*schedulespb.ScheduleTriggerResponse, error) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	s.triggered = append(s.triggered, req.GetScheduleName())
+
+	return &schedulespb.ScheduleTriggerResponse{}, nil
+}
+
+type storageServer struct {
+	storagepb.UnimplementedStorageServer
+}
+
+func (s *storageServer) WriteStream(stream storagepb.Storage_WriteStreamServer) error {
+	if _, err := stream.Recv(); err != nil {
+		return err
+	}
+
+	return stream.SendAndClose(&storagepb.StorageWriteStreamResponse{})
+}
+
+// readOnlyStorage is a decorator rejecting writes to the storage plugin
+type readOnlyStorage struct {
+	storagepb.StorageServer
+}
+
+func (r *readOnlyStorage) Write(ctx context.Context, req *storagepb.StorageWriteRequest) (*storagepb.StorageWriteResponse, error) {
+	return nil, fmt.Errorf("storage is read only")
+}
+
 var _ = Describe("Nitric Server", func() {
 	Context("Starting the server", func() {
 		When("The Gateway plugin is available and working", func() {
@@ -69,6 +121,19 @@ var _ = Describe("Nitric Server", func() {
 				Expect(err.Error()).To(ContainSubstring("could not listen"))
 			})
 		})
+
+		When("A replacement gRPC server is used with a service token", func() {
+			ctrl := gomock.NewController(GinkgoT())
+			mockGateway := mock_gateway.NewMockGatewayService(ctrl)
+
+			srv, _ := server.New(server.WithMinWorkers(0), server.WithGatewayPlugin(mockGateway), server.WithServiceToken("secret"))
+
+			It("Should return an error without starting the gateway", func() {
+				err := srv.Start(server.WithGrpcServer(grpc.NewServer()))
+				Expect(err).Should(HaveOccurred())
+				Expect(err.Error()).To(ContainSubstring("WithGrpcServer"))
+			})
+		})
 	})
 
 	Context("Starting the child process", func() {
@@ -125,4 +190,488 @@ var _ = Describe("Nitric Server", func() {
 			})
 		})
 	})
+
+	Context("Stopping the server", func() {
+		BeforeEach(func() {
+			os.Args = []string{}
+		})
+
+		When("The child process doesn't exit within the drain timeout", func() {
+			var mb *server.NitricServer
+
+			BeforeEach(func() {
+				ctrl := gomock.NewController(GinkgoT())
+				mockGateway := mock_gateway.NewMockGatewayService(ctrl)
+				mockGateway.EXPECT().Start(gomock.Any()).AnyTimes().Return(nil)
+				mockGateway.EXPECT().Stop().AnyTimes().Return(nil)
+
+				mb, _ = server.New(
+					server.WithChildCommand([]string{"sh", "-c", "trap '' TERM; exec sleep 30"}),
+					server.WithGatewayPlugin(mockGateway),
+					server.WithServiceAddress("localhost:9007"),
+					server.WithMinWorkers(0),
+					server.WithDrainTimeoutSeconds(1),
+				)
+			})
+
+			It("Should kill the child process once the drain timeout has elapsed", func() {
+				Expect(mb.Start()).To(Succeed())
+				// give the shell time to ignore SIGTERM before stopping it
+				time.Sleep(500 * time.Millisecond)
+
+				stopStart := time.Now()
+				mb.Stop()
+
+				Expect(time.Since(stopStart)).To(BeNumerically(">=", time.Second))
+				Expect(time.Since(stopStart)).To(BeNumerically("<", 5*time.Second))
+			})
+		})
+	})
+
+	Context("Serving the admin endpoints", func() {
+		BeforeEach(func() {
+			os.Args = []string{}
+		})
+
+		When("An admin address is configured", func() {
+			var mb *server.NitricServer
+			var gatewayListening chan struct{}
+
+			BeforeEach(func() {
+				gatewayStopped := make(chan struct{})
+				gatewayListening = make(chan struct{})
+
+				ctrl := gomock.NewController(GinkgoT())
+				mockGateway := mock_gateway.NewMockGatewayService(ctrl)
+				mockGateway.EXPECT().Start(gomock.Any()).AnyTimes().DoAndReturn(func(opts *gateway.GatewayStartOpts) error {
+					select {
+					case <-gatewayListening:
+						opts.Started()
+					case <-gatewayStopped:
+						return nil
+					}
+					<-gatewayStopped
+					return nil
+				})
+				mockGateway.EXPECT().Stop().AnyTimes().DoAndReturn(func() error {
+					close(gatewayStopped)
+					return nil
+				})
+
+				mb, _ = server.New(
+					server.WithChildCommand([]string{"sleep", "30"}),
+					server.WithGatewayPlugin(mockGateway),
+					server.WithServiceAddress("localhost:9009"),
+					server.WithAdminAddress("localhost:9010"),
+					server.WithMinWorkers(0),
+					server.WithDrainTimeoutSeconds(1),
+				)
+
+				go func(srv *server.NitricServer) {
+					_ = srv.Start()
+				}(mb)
+			})
+
+			AfterEach(func() {
+				mb.Stop()
+			})
+
+			It("Should report liveness", func() {
+				Eventually(func() (int, error) {
+					resp, err := http.Get("http://localhost:9010/healthz")
+					if err != nil {
+						return 0, err
+					}
+					defer resp.Body.Close()
+
+					return resp.StatusCode, nil
+				}, "5s").Should(Equal(http.StatusOK))
+			})
+
+			It("Should report readiness once the gateway has started", func() {
+				var readiness server.AdminReadiness
+
+				Eventually(func() (int, error) {
+					resp, err := http.Get("http://localhost:9010/readyz")
+					if err != nil {
+						return 0, err
+					}
+					defer resp.Body.Close()
+
+					return resp.StatusCode, json.NewDecoder(resp.Body).Decode(&readiness)
+				}, "5s").Should(Equal(http.StatusServiceUnavailable))
+
+				Expect(readiness.Ready).To(BeFalse())
+				Expect(readiness.Checks["gateway"].Ready).To(BeFalse())
+
+				close(gatewayListening)
+
+				Eventually(func() (int, error) {
+					resp, err := http.Get("http://localhost:9010/readyz")
+					if err != nil {
+						return 0, err
+					}
+					defer resp.Body.Close()
+
+					return resp.StatusCode, json.NewDecoder(resp.Body).Decode(&readiness)
+				}, "5s").Should(Equal(http.StatusOK))
+
+				Expect(readiness.Ready).To(BeTrue())
+				Expect(readiness.Checks).To(HaveKey("gateway"))
+				Expect(readiness.Checks).To(HaveKey("process"))
+			})
+
+			It("Should report the registered workers", func() {
+				var workers server.AdminWorkers
+
+				Eventually(func() error {
+					resp, err := http.Get("http://localhost:9010/workers")
+					if err != nil {
+						return err
+					}
+					defer resp.Body.Close()
+
+					return json.NewDecoder(resp.Body).Decode(&workers)
+				}, "5s").Should(Succeed())
+
+				Expect(workers.Workers).To(Equal(0))
+				Expect(workers.Triggers).To(BeEmpty())
+			})
+		})
+	})
+
+	Context("Registering plugin decorators and interceptors", func() {
+		BeforeEach(func() {
+			os.Args = []string{}
+		})
+
+		When("A decorator and an interceptor are registered", func() {
+			var mb *server.NitricServer
+			var methodsLock sync.Mutex
+			var methods []string
+
+			BeforeEach(func() {
+				gatewayStopped := make(chan struct{})
+				methods = []string{}
+
+				ctrl := gomock.NewController(GinkgoT())
+				mockGateway := mock_gateway.NewMockGatewayService(ctrl)
+				mockGateway.EXPECT().Start(gomock.Any()).AnyTimes().DoAndReturn(func(_ any) error {
+					<-gatewayStopped
+					return nil
+				})
+				mockGateway.EXPECT().Stop().AnyTimes().DoAndReturn(func() error {
+					close(gatewayStopped)
+					return nil
+				})
+
+				mb, _ = server.New(
+					server.WithChildCommand([]string{"sleep", "30"}),
+					server.WithGatewayPlugin(mockGateway),
+					server.WithServiceAddress("localhost:9011"),
+					server.WithMinWorkers(0),
+					server.WithDrainTimeoutSeconds(1),
+					server.WithStoragePlugin(&storageServer{}),
+					server.WithDecorators(func(inner storagepb.StorageServer) storagepb.StorageServer {
+						return &readOnlyStorage{StorageServer: inner}
+					}),
+					server.WithUnaryInterceptors(func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+						methodsLock.Lock()
+						methods = append(methods, info.FullMethod)
+						methodsLock.Unlock()
+
+						return handler(ctx, req)
+					}),
+				)
+
+				go func(srv *server.NitricServer) {
+					_ = srv.Start()
+				}(mb)
+			})
+
+			AfterEach(func() {
+				mb.Stop()
+			})
+
+			It("Should call the plugin through the interceptor and decorator", func() {
+				conn, err := grpc.NewClient("localhost:9011", grpc.WithTransportCredentials(insecure.NewCredentials()))
+				Expect(err).ToNot(HaveOccurred())
+				defer conn.Close()
+
+				client := storagepb.NewStorageClient(conn)
+
+				Eventually(func() error {
+					_, err := client.Write(context.TODO(), &storagepb.StorageWriteRequest{BucketName: "bucket", Key: "key"})
+					return err
+				}, "5s").Should(MatchError(ContainSubstring("storage is read only")))
+
+				methodsLock.Lock()
+				defer methodsLock.Unlock()
+				Expect(methods).To(ContainElement("/nitric.proto.storage.v1.Storage/Write"))
+			})
+
+			It("Should only call the decorator with validated requests", func() {
+				conn, err := grpc.NewClient("localhost:9011", grpc.WithTransportCredentials(insecure.NewCredentials()))
+				Expect(err).ToNot(HaveOccurred())
+				defer conn.Close()
+
+				client := storagepb.NewStorageClient(conn)
+
+				Eventually(func() codes.Code {
+					_, err := client.Write(context.TODO(), &storagepb.StorageWriteRequest{
+						BucketName: "bucket",
+						Key:        "key",
+						Metadata:   map[string]string{"Content-Owner": "me"},
+					})
+					return status.Code(err)
+				}, "5s").Should(Equal(codes.InvalidArgument))
+			})
+
+			It("Should validate the byte range of streamed reads", func() {
+				conn, err := grpc.NewClient("localhost:9011", grpc.WithTransportCredentials(insecure.NewCredentials()))
+				Expect(err).ToNot(HaveOccurred())
+				defer conn.Close()
+
+				client := storagepb.NewStorageClient(conn)
+
+				Eventually(func() codes.Code {
+					stream, err := client.ReadStream(context.TODO(), &storagepb.StorageReadStreamRequest{BucketName: "bucket", Key: "key", Offset: -1})
+					if err != nil {
+						return status.Code(err)
+					}
+
+					_, err = stream.Recv()
+					return status.Code(err)
+				}, "5s").Should(Equal(codes.InvalidArgument))
+
+				stream, err := client.ReadStream(context.TODO(), &storagepb.StorageReadStreamRequest{BucketName: "bucket", Key: "key", Offset: 10, Length: 5})
+				Expect(err).ToNot(HaveOccurred())
+
+				_, err = stream.Recv()
+				Expect(status.Code(err)).To(Equal(codes.Unimplemented))
+			})
+
+			It("Should validate the metadata keys of streamed writes", func() {
+				conn, err := grpc.NewClient("localhost:9011", grpc.WithTransportCredentials(insecure.NewCredentials()))
+				Expect(err).ToNot(HaveOccurred())
+				defer conn.Close()
+
+				client := storagepb.NewStorageClient(conn)
+
+				writeHeader := func(metadata map[string]string) error {
+					stream, err := client.WriteStream(context.TODO())
+					if err != nil {
+						return err
+					}
+
+					err = stream.Send(&storagepb.StorageWriteStreamRequest{
+						Content: &storagepb.StorageWriteStreamRequest_Header{
+							Header: &storagepb.StorageWriteStreamHeader{BucketName: "bucket", Key: "key", Metadata: metadata},
+						},
+					})
+					if err != nil {
+						return err
+					}
+
+					_, err = stream.CloseAndRecv()
+					return err
+				}
+
+				Eventually(func() codes.Code {
+					return status.Code(writeHeader(map[string]string{"Content-Owner": "me"}))
+				}, "5s").Should(Equal(codes.InvalidArgument))
+
+				Expect(writeHeader(map[string]string{"content_owner": "me"})).To(Succeed())
+			})
+
+			It("Should validate the page size of listings", func() {
+				conn, err := grpc.NewClient("localhost:9011", grpc.WithTransportCredentials(insecure.NewCredentials()))
+				Expect(err).ToNot(HaveOccurred())
+				defer conn.Close()
+
+				client := storagepb.NewStorageClient(conn)
+
+				Eventually(func() codes.Code {
+					_, err := client.ListBlobs(context.TODO(), &storagepb.StorageListBlobsRequest{BucketName: "bucket", PageSize: -1})
+					return status.Code(err)
+				}, "5s").Should(Equal(codes.InvalidArgument))
+
+				_, err = client.ListBlobs(context.TODO(), &storagepb.StorageListBlobsRequest{BucketName: "bucket", PageSize: 10})
+				Expect(status.Code(err)).To(Equal(codes.Unimplemented))
+			})
+
+			It("Should not move an item onto itself", func() {
+				conn, err := grpc.NewClient("localhost:9011", grpc.WithTransportCredentials(insecure.NewCredentials()))
+				Expect(err).ToNot(HaveOccurred())
+				defer conn.Close()
+
+				client := storagepb.NewStorageClient(conn)
+
+				Eventually(func() codes.Code {
+					_, err := client.Move(context.TODO(), &storagepb.StorageMoveRequest{
+						SourceBucketName:      "incoming",
+						SourceKey:             "key",
+						DestinationBucketName: "incoming",
+						DestinationKey:        "key",
+					})
+					return status.Code(err)
+				}, "5s").Should(Equal(codes.InvalidArgument))
+
+				_, err = client.Move(context.TODO(), &storagepb.StorageMoveRequest{
+					SourceBucketName:      "incoming",
+					SourceKey:             "key",
+					DestinationBucketName: "processed",
+					DestinationKey:        "key",
+				})
+				Expect(status.Code(err)).To(Equal(codes.Unimplemented))
+			})
+		})
+	})
+
+	Context("Managing schedules", func() {
+		BeforeEach(func() {
+			os.Args = []string{}
+		})
+
+		When("A schedule manager plugin is registered", func() {
+			var mb *server.NitricServer
+			var manager *scheduleManager
+
+			BeforeEach(func() {
+				gatewayStopped := make(chan struct{})
+				manager = &scheduleManager{}
+
+				ctrl := gomock.NewController(GinkgoT())
+				mockGateway := mock_gateway.NewMockGatewayService(ctrl)
+				mockGateway.EXPECT().Start(gomock.Any()).AnyTimes().DoAndReturn(func(_ any) error {
+					<-gatewayStopped
+					return nil
+				})
+				mockGateway.EXPECT().Stop().AnyTimes().DoAndReturn(func() error {
+					close(gatewayStopped)
+					return nil
+				})
+
+				mb, _ = server.New(
+					server.WithChildCommand([]string{"sleep", "30"}),
+					server.WithGatewayPlugin(mockGateway),
+					server.WithServiceAddress("localhost:9012"),
+					server.WithMinWorkers(0),
+					server.WithDrainTimeoutSeconds(1),
+					server.WithScheduleManagerPlugin(manager),
+				)
+
+				go func(srv *server.NitricServer) {
+					_ = srv.Start()
+				}(mb)
+			})
+
+			AfterEach(func() {
+				mb.Stop()
+			})
+
+			It("Should validate requests before calling the plugin", func() {
+				conn, err := grpc.NewClient("localhost:9012", grpc.WithTransportCredentials(insecure.NewCredentials()))
+				Expect(err).ToNot(HaveOccurred())
+				defer conn.Close()
+
+				client := schedulespb.NewScheduleManagerClient(conn)
+
+				Eventually(func() codes.Code {
+					_, err := client.Trigger(context.TODO(), &schedulespb.ScheduleTriggerRequest{})
+					return status.Code(err)
+				}, "5s").Should(Equal(codes.InvalidArgument))
+
+				_, err = client.Describe(context.TODO(), &schedulespb.ScheduleDescribeRequest{ScheduleName: "nightly", NextFireTimeCount: 1000})
+				Expect(status.Code(err)).To(Equal(codes.InvalidArgument))
+
+				_, err = client.Trigger(context.TODO(), &schedulespb.ScheduleTriggerRequest{ScheduleName: "nightly"})
+				Expect(err).ToNot(HaveOccurred())
+
+				_, err = client.Pause(context.TODO(), &schedulespb.SchedulePauseRequest{ScheduleName: "nightly"})
+				Expect(status.Code(err)).To(Equal(codes.Unimplemented))
+
+				manager.lock.Lock()
+				defer manager.lock.Unlock()
+				Expect(manager.triggered).To(Equal([]string{"nightly"}))
+			})
+		})
+	})
+
+	Context("Securing the services", func() {
+		BeforeEach(func() {
+			os.Args = []string{}
+		})
+
+		When("A unix socket address and service token are configured", func() {
+			var mb *server.NitricServer
+			var dir string
+
+			BeforeEach(func() {
+				var err error
+				dir, err = os.MkdirTemp("", "nitric-server")
+				Expect(err).ToNot(HaveOccurred())
+
+				gatewayStopped := make(chan struct{})
+
+				ctrl := gomock.NewController(GinkgoT())
+				mockGateway := mock_gateway.NewMockGatewayService(ctrl)
+				mockGateway.EXPECT().Start(gomock.Any()).AnyTimes().DoAndReturn(func(_ any) error {
+					<-gatewayStopped
+					return nil
+				})
+				mockGateway.EXPECT().Stop().AnyTimes().DoAndReturn(func() error {
+					close(gatewayStopped)
+					return nil
+				})
+
+				mb, _ = server.New(
+					server.WithChildCommand([]string{"sh", "-c", fmt.Sprintf("echo \"$SERVICE_ADDRESS $NITRIC_SERVICE_TOKEN\" > %s; exec sleep 30", filepath.Join(dir, "env"))}),
+					server.WithGatewayPlugin(mockGateway),
+					server.WithServiceAddress("unix://"+filepath.Join(dir, "nitric.sock")),
+					server.WithServiceToken("secret"),
+					server.WithMinWorkers(0),
+					server.WithDrainTimeoutSeconds(1),
+					server.WithStoragePlugin(&storageServer{}),
+				)
+
+				go func(srv *server.NitricServer) {
+					_ = srv.Start()
+				}(mb)
+			})
+
+			AfterEach(func() {
+				mb.Stop()
+				os.RemoveAll(dir)
+			})
+
+			It("Should pass the socket address and token to the child process", func() {
+				Eventually(func() (string, error) {
+					childEnv, err := os.ReadFile(filepath.Join(dir, "env"))
+					return strings.TrimSpace(string(childEnv)), err
+				}, "5s").Should(Equal("unix://" + filepath.Join(dir, "nitric.sock") + " secret"))
+
+				info, err := os.Stat(filepath.Join(dir, "nitric.sock"))
+				Expect(err).ToNot(HaveOccurred())
+				Expect(info.Mode().Perm()).To(Equal(os.FileMode(0o600)))
+			})
+
+			It("Should only accept calls carrying the service token", func() {
+				conn, err := grpc.NewClient("unix://"+filepath.Join(dir, "nitric.sock"), grpc.WithTransportCredentials(insecure.NewCredentials()))
+				Expect(err).ToNot(HaveOccurred())
+				defer conn.Close()
+
+				client := storagepb.NewStorageClient(conn)
+
+				Eventually(func() codes.Code {
+					_, err := client.Read(context.TODO(), &storagepb.StorageReadRequest{BucketName: "bucket", Key: "key"})
+					return status.Code(err)
+				}, "5s").Should(Equal(codes.Unauthenticated))
+
+				ctx := metadata.AppendToOutgoingContext(context.TODO(), "authorization", "Bearer secret")
+				_, err = client.Read(ctx, &storagepb.StorageReadRequest{BucketName: "bucket", Key: "key"})
+				Expect(status.Code(err)).To(Equal(codes.Unimplemented))
+			})
+		})
+	})
 })
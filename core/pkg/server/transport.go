@@ -0,0 +1,137 @@
+// Copyright 2021 Nitric Technologies Pty Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"net"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+
+	"github.com/nitrictech/nitric/core/pkg/env"
+)
+
+// unixScheme prefixes service addresses of unix domain sockets, e.g. unix:///var/run/nitric.sock
+const unixScheme = "unix://"
+
+// Listen on the service address, either a TCP address or a unix domain socket
+//
+//	unix domain sockets are only accessible by the user running the server, stale sockets from previous runs are removed.
+func Listen(address string) (net.Listener, error) {
+	if !strings.HasPrefix(address, unixScheme) {
+		return net.Listen("tcp", address)
+	}
+
+	path := strings.TrimPrefix(address, unixScheme)
+	if path == "" {
+		return nil, fmt.Errorf("unix service address %q is missing a socket path", address)
+	}
+
+	if err := os.MkdirAll(filepath.Dir(path), 0o700); err != nil {
+		return nil, err
+	}
+
+	if err := os.Remove(path); err != nil && !os.IsNotExist(err) {
+		return nil, fmt.Errorf("unable to remove existing socket %s: %w", path, err)
+	}
+
+	lis, err := net.Listen("unix", path)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := os.Chmod(path, 0o600); err != nil {
+		_ = lis.Close()
+		return nil, err
+	}
+
+	return lis, nil
+}
+
+// ListenerServiceAddress returns the SERVICE_ADDRESS the child process connects to the listener with
+func ListenerServiceAddress(lis net.Listener) string {
+	if lis.Addr().Network() == "unix" {
+		return unixScheme + lis.Addr().String()
+	}
+
+	return lis.Addr().String()
+}
+
+// ServiceTLSConfigFromEnv loads the TLS config from the SERVICE_TLS_* environment variables, it's nil when SERVICE_TLS_CERT_FILE isn't set
+func ServiceTLSConfigFromEnv() (*tls.Config, error) {
+	if env.SERVICE_TLS_CERT_FILE.String() == "" {
+		return nil, nil
+	}
+
+	return LoadServiceTLSConfig(env.SERVICE_TLS_CERT_FILE.String(), env.SERVICE_TLS_KEY_FILE.String(), env.SERVICE_TLS_CLIENT_CA_FILE.String())
+}
+
+// ServiceTransportOptions returns the gRPC server options that serve the services over TLS and reject calls without the service token,
+// unauthenticated calls are rejected before any interceptors added after these options are called.
+func ServiceTransportOptions(token string, tlsConfig *tls.Config) []grpc.ServerOption {
+	opts := []grpc.ServerOption{}
+
+	if tlsConfig != nil {
+		opts = append(opts, grpc.Creds(credentials.NewTLS(tlsConfig)))
+	}
+
+	if token != "" {
+		opts = append(opts,
+			grpc.ChainUnaryInterceptor(serviceTokenUnaryInterceptor(token)),
+			grpc.ChainStreamInterceptor(serviceTokenStreamInterceptor(token)),
+		)
+	}
+
+	return opts
+}
+
+// LoadServiceTLSConfig loads the certificate the server is identified by,
+// when clientCAFile is set clients must present a certificate signed by one of its CAs (mTLS).
+func LoadServiceTLSConfig(certFile string, keyFile string, clientCAFile string) (*tls.Config, error) {
+	cert, err := tls.LoadX509KeyPair(certFile, keyFile)
+	if err != nil {
+		return nil, fmt.Errorf("unable to load service certificate: %w", err)
+	}
+
+	config := &tls.Config{
+		Certificates: []tls.Certificate{cert},
+		MinVersion:   tls.VersionTLS12,
+	}
+
+	if clientCAFile == "" {
+		return config, nil
+	}
+
+	caPem, err := os.ReadFile(clientCAFile)
+	if err != nil {
+		return nil, fmt.Errorf("unable to read service client CA: %w", err)
+	}
+
+	clientCAs := x509.NewCertPool()
+	if !clientCAs.AppendCertsFromPEM(caPem) {
+		return nil, fmt.Errorf("no certificates found in service client CA %s", clientCAFile)
+	}
+
+	config.ClientCAs = clientCAs
+	config.ClientAuth = tls.RequireAndVerifyClientCert
+
+	return config, nil
+}
@@ -0,0 +1,80 @@
+// Copyright 2021 Nitric Technologies Pty Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tracing
+
+import (
+	"context"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/trace"
+)
+
+const instrumentationName = "github.com/nitrictech/nitric/core"
+
+// Propagator propagates W3C trace context and baggage between triggers, workers and plugin servers
+var Propagator propagation.TextMapPropagator = propagation.NewCompositeTextMapPropagator(
+	propagation.TraceContext{},
+	propagation.Baggage{},
+)
+
+// Tracer returns the tracer used for nitric server spans, from the globally registered tracer provider
+func Tracer() trace.Tracer {
+	return otel.Tracer(instrumentationName)
+}
+
+// Fields returns the names of the headers or attributes used to propagate trace context
+func Fields() []string {
+	return Propagator.Fields()
+}
+
+// Extract returns a context continuing the trace propagated in the given headers or attributes
+func Extract(ctx context.Context, carrier map[string]string) context.Context {
+	if len(carrier) == 0 {
+		return ctx
+	}
+
+	return Propagator.Extract(ctx, propagation.MapCarrier(carrier))
+}
+
+// ExtractHeaders returns a context continuing the trace propagated in the given HTTP headers
+func ExtractHeaders(ctx context.Context, headers map[string][]string) context.Context {
+	return Propagator.Extract(ctx, propagation.HeaderCarrier(headers))
+}
+
+// Inject returns the trace context of ctx as headers or attributes, so it can be continued by the receiver
+func Inject(ctx context.Context) map[string]string {
+	carrier := propagation.MapCarrier{}
+	Propagator.Inject(ctx, carrier)
+
+	return carrier
+}
+
+// StartTriggerSpan starts a span around the dispatch of a trigger to workers
+func StartTriggerSpan(ctx context.Context, name string, attributes ...attribute.KeyValue) (context.Context, trace.Span) {
+	return Tracer().Start(ctx, name, trace.WithSpanKind(trace.SpanKindConsumer), trace.WithAttributes(attributes...))
+}
+
+// EndSpan ends the span, recording the error if the operation failed
+func EndSpan(span trace.Span, err error) {
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+
+	span.End()
+}
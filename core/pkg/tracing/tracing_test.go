@@ -0,0 +1,86 @@
+// Copyright 2021 Nitric Technologies Pty Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tracing_test
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"go.opentelemetry.io/otel/trace"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+
+	"github.com/nitrictech/nitric/core/pkg/tracing"
+)
+
+const (
+	traceId     = "4bf92f3577b34da6a3ce929d0e0e4736"
+	traceparent = "00-" + traceId + "-00f067aa0ba902b7-01"
+)
+
+var _ = Describe("Tracing", func() {
+	When("trace context is propagated in attributes", func() {
+		It("should continue the trace", func() {
+			ctx := tracing.Extract(context.TODO(), map[string]string{"traceparent": traceparent, "baggage": "tenant=acme"})
+
+			Expect(trace.SpanContextFromContext(ctx).TraceID().String()).To(Equal(traceId))
+
+			carrier := tracing.Inject(ctx)
+			Expect(carrier).To(HaveKeyWithValue("traceparent", traceparent))
+			Expect(carrier).To(HaveKeyWithValue("baggage", "tenant=acme"))
+		})
+	})
+
+	When("trace context is propagated in HTTP headers", func() {
+		It("should continue the trace", func() {
+			ctx := tracing.ExtractHeaders(context.TODO(), map[string][]string{"Traceparent": {traceparent}})
+
+			Expect(trace.SpanContextFromContext(ctx).TraceID().String()).To(Equal(traceId))
+		})
+	})
+
+	When("there is no trace context", func() {
+		It("should not propagate any", func() {
+			Expect(tracing.Inject(context.TODO())).To(BeEmpty())
+		})
+	})
+
+	When("a trigger span is started", func() {
+		It("should be propagated to workers in the same trace", func() {
+			ctx := tracing.Extract(context.TODO(), map[string]string{"traceparent": traceparent})
+
+			ctx, span := tracing.StartTriggerSpan(ctx, "topic updates")
+			defer tracing.EndSpan(span, nil)
+
+			Expect(tracing.Inject(ctx)["traceparent"]).To(ContainSubstring(traceId))
+		})
+	})
+
+	When("an SDK calls a plugin server", func() {
+		It("should continue the trace from the call metadata", func() {
+			ctx := metadata.NewIncomingContext(context.TODO(), metadata.Pairs("traceparent", traceparent))
+
+			var handlerCtx context.Context
+			_, err := tracing.UnaryServerInterceptor()(ctx, nil, &grpc.UnaryServerInfo{FullMethod: "/nitric.proto.topics.v1.Topics/Publish"}, func(ctx context.Context, req interface{}) (interface{}, error) {
+				handlerCtx = ctx
+				return nil, nil
+			})
+
+			Expect(err).ToNot(HaveOccurred())
+			Expect(trace.SpanContextFromContext(handlerCtx).TraceID().String()).To(Equal(traceId))
+		})
+	})
+})
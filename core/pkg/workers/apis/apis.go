@@ -15,79 +15,113 @@
 package apis
 
 import (
+	"bytes"
 	"context"
 	"fmt"
+	"io"
 	"slices"
 	"strings"
 	"sync"
+	"sync/atomic"
+	"time"
 
+	"github.com/nitrictech/nitric/core/pkg/env"
 	apispb "github.com/nitrictech/nitric/core/pkg/proto/apis/v1"
+	"github.com/nitrictech/nitric/core/pkg/tracing"
 	"github.com/nitrictech/nitric/core/pkg/workers"
+	"go.opentelemetry.io/otel/attribute"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 )
 
 type WorkerConnection = workers.WorkerRequestBroker[*apispb.ServerMessage, *apispb.ClientMessage]
 
+// RouteWorker handles requests for a route, load balancing them across all of the worker connections that registered the same route and methods
 type RouteWorker struct {
 	routeMatcher string
 	methods      []string
-	connection   *WorkerConnection
+	connections  []*WorkerConnection
+	// connections that accept streamed request bodies and may stream response bodies
+	streamBodies map[*WorkerConnection]bool
+	// the route tree node the route ends at
+	node *routeNode
+	// cursor used to rotate between equally loaded connections
+	next atomic.Uint64
 }
 
-// slashSplitter - used to split strings, with the same output regardless of leading or trailing slashes
-// e.g - strings.FieldsFunc("/one/two/three/", f) == strings.FieldsFunc("/one/two/three", f) == strings.FieldsFunc("one/two/three", f) == ["one" "two" "three"]
-func slashSplitter(c rune) bool {
-	return c == '/'
-}
+// hasMethods returns true if the worker handles exactly the given set of methods
+func (r *RouteWorker) hasMethods(methods []string) bool {
+	if len(r.methods) != len(methods) {
+		return false
+	}
 
-type ApiRequestHandler interface {
-	apispb.ApiServer
-	HandleRequest(apiName string, request *apispb.ServerMessage) (*apispb.ClientMessage, error)
-	WorkerCount() int
-}
+	for _, method := range methods {
+		if !slices.Contains(r.methods, method) {
+			return false
+		}
+	}
 
-// SplitPath - splits a path into its component parts, ignoring leading or trailing slashes.
-// e.g - SplitPath("/one/two/three/") == SplitPath("/one/two/three") == SplitPath("one/two/three") == ["one" "two" "three"]
-func splitPath(p string) []string {
-	return strings.FieldsFunc(p, slashSplitter)
+	return true
 }
 
-func extractPathParams(route string, requestPath string) (map[string]string, error) {
-	requestPathSegments := splitPath(requestPath)
-	pathSegments := splitPath(route)
-	params := make(map[string]string)
-
-	if len(requestPathSegments) != len(pathSegments) {
-		return nil, fmt.Errorf("path template mismatch")
+// nextConnection selects the connection with the fewest in-flight requests, rotating between connections with equal load
+func (r *RouteWorker) nextConnection() *WorkerConnection {
+	if len(r.connections) == 0 {
+		return nil
 	}
 
-	for i, p := range pathSegments {
-		if !strings.HasPrefix(p, ":") && p != requestPathSegments[i] {
-			return nil, fmt.Errorf("path template mismatch")
-		} else if strings.HasPrefix(p, ":") {
-			params[strings.Replace(p, ":", "", 1)] = requestPathSegments[i]
+	start := int(r.next.Add(1) % uint64(len(r.connections)))
+
+	var selected *WorkerConnection
+	for i := range r.connections {
+		conn := r.connections[(start+i)%len(r.connections)]
+		if selected == nil || conn.InFlight() < selected.InFlight() {
+			selected = conn
 		}
 	}
 
-	return params, nil
+	return selected
 }
 
-func (r *RouteWorker) isSupportedRequest(httpRequest *apispb.HttpRequest) bool {
-	if !slices.Contains[[]string](r.methods, httpRequest.GetMethod()) {
-		return false
+func (r *RouteWorker) inFlight() int {
+	total := 0
+	for _, conn := range r.connections {
+		total += conn.InFlight()
 	}
 
-	_, err := extractPathParams(r.routeMatcher, httpRequest.GetPath())
+	return total
+}
 
-	return err == nil
+type ApiRequestHandler interface {
+	apispb.ApiServer
+	HandleRequest(ctx context.Context, apiName string, request *apispb.ServerMessage) (*apispb.ClientMessage, error)
+	// HandleStreamingRequest forwards a request, reading its body from body, and returns the response with a reader for its body.
+	//
+	//	the response body must be closed once it has been read.
+	HandleStreamingRequest(ctx context.Context, apiName string, request *apispb.ServerMessage, body io.Reader) (*apispb.HttpResponse, io.ReadCloser, error)
+	WorkerCount() int
+	Workers() []workers.WorkerInfo
 }
 
 type ApiName = string
 
+// startRequestTrigger starts instrumenting the dispatch of an API request to a worker, propagating its span to the worker
+func startRequestTrigger(ctx context.Context, apiName string, request *apispb.ServerMessage) (context.Context, *workers.Trigger) {
+	ctx, trigger := workers.StartTrigger(ctx, "api", apiName, request.GetId(),
+		attribute.String("nitric.api", apiName),
+		attribute.String("http.request.method", request.GetHttpRequest().GetMethod()),
+		attribute.String("url.path", request.GetHttpRequest().GetPath()),
+	)
+
+	request.TraceContext = tracing.Inject(ctx)
+
+	return ctx, trigger
+}
+
 type RouteWorkerManager struct {
-	routeWorkerMap map[ApiName][]*RouteWorker
-	lock           sync.RWMutex
+	routers map[ApiName]*apiRouter
+	lock    sync.RWMutex
+	timeout time.Duration
 }
 
 var _ apispb.ApiServer = &RouteWorkerManager{}
@@ -97,46 +131,77 @@ func (s *RouteWorkerManager) WorkerCount() int {
 	defer s.lock.RUnlock()
 
 	total := 0
-	for _, workers := range s.routeWorkerMap {
-		total += len(workers)
+	for _, router := range s.routers {
+		for _, rw := range router.routes {
+			total += len(rw.connections)
+		}
 	}
 
 	return total
 }
 
+// Workers returns the workers registered for each API route
+func (s *RouteWorkerManager) Workers() []workers.WorkerInfo {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+
+	info := []workers.WorkerInfo{}
+	for apiName, router := range s.routers {
+		for _, rw := range router.routes {
+			info = append(info, workers.WorkerInfo{
+				Kind:     "api",
+				Name:     apiName,
+				Target:   fmt.Sprintf("%s %s", strings.Join(rw.methods, ","), rw.routeMatcher),
+				Workers:  len(rw.connections),
+				InFlight: rw.inFlight(),
+			})
+		}
+	}
+
+	return workers.SortWorkerInfo(info)
+}
+
 func (a *RouteWorkerManager) ApiDetails(ctx context.Context, req *apispb.ApiDetailsRequest) (*apispb.ApiDetailsResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "Not Implemented")
 }
 
 // registerRouteHandler registers a worker by the routes and methods it handles.
-func (s *RouteWorkerManager) registerRouteHandler(apiName string, path string, methods []string, worker *WorkerConnection) (*RouteWorker, error) {
+//
+//	workers registering the same route and methods share the requests for that route.
+func (s *RouteWorkerManager) registerRouteHandler(apiName string, path string, methods []string, streamBodies bool, worker *WorkerConnection) (*RouteWorker, error) {
 	s.lock.Lock()
 	defer s.lock.Unlock()
 
-	if _, exists := s.routeWorkerMap[apiName]; !exists {
-		s.routeWorkerMap[apiName] = []*RouteWorker{}
+	if _, exists := s.routers[apiName]; !exists {
+		s.routers[apiName] = newApiRouter()
 	}
 
-	rw := &RouteWorker{
-		routeMatcher: path,
-		methods:      methods,
-		connection:   worker,
+	routeWorker, err := s.routers[apiName].add(path, methods, worker)
+	if err != nil {
+		return nil, err
+	}
+
+	if streamBodies {
+		routeWorker.streamBodies[worker] = true
 	}
-	s.routeWorkerMap[apiName] = append(s.routeWorkerMap[apiName], rw)
 
-	return rw, nil
+	return routeWorker, nil
 }
 
-func (s *RouteWorkerManager) unregisterRouteHandler(apiName string, worker *RouteWorker) {
+func (s *RouteWorkerManager) unregisterRouteHandler(apiName string, worker *RouteWorker, connection *WorkerConnection) {
 	s.lock.Lock()
 	defer s.lock.Unlock()
 
-	s.routeWorkerMap[apiName] = slices.DeleteFunc[[]*RouteWorker](s.routeWorkerMap[apiName], func(rw *RouteWorker) bool {
-		return rw == worker
-	})
+	router, ok := s.routers[apiName]
+	if !ok {
+		return
+	}
+
+	delete(worker.streamBodies, connection)
+	router.remove(worker, connection)
 
-	if len(s.routeWorkerMap[apiName]) == 0 {
-		delete(s.routeWorkerMap, apiName)
+	if len(router.routes) == 0 {
+		delete(s.routers, apiName)
 	}
 }
 
@@ -150,19 +215,20 @@ func (s *RouteWorkerManager) Serve(stream apispb.Api_ServeServer) error {
 		return fmt.Errorf("first request must be an init request")
 	}
 
-	wrkr := workers.NewWorkerRequestBroker[*apispb.ServerMessage, *apispb.ClientMessage](stream)
+	wrkr := workers.NewWorkerRequestBroker[*apispb.ServerMessage, *apispb.ClientMessage](stream, newCancelMessage)
 
 	// Get routing info
 	apiName := initRequest.GetRegistrationRequest().Api
 	path := initRequest.GetRegistrationRequest().Path
 	methods := initRequest.GetRegistrationRequest().Methods
+	streamBodies := initRequest.GetRegistrationRequest().StreamBodies
 
-	routeWorker, err := s.registerRouteHandler(apiName, path, methods, wrkr)
+	routeWorker, err := s.registerRouteHandler(apiName, path, methods, streamBodies, wrkr)
 	if err != nil {
 		return err
 	}
 
-	defer s.unregisterRouteHandler(apiName, routeWorker)
+	defer s.unregisterRouteHandler(apiName, routeWorker, wrkr)
 
 	// send ack of registration
 	err = stream.Send(&apispb.ServerMessage{
@@ -177,52 +243,86 @@ func (s *RouteWorkerManager) Serve(stream apispb.Api_ServeServer) error {
 	return wrkr.Run()
 }
 
-func (s *RouteWorkerManager) HandleRequest(apiName string, request *apispb.ServerMessage) (*apispb.ClientMessage, error) {
+func newCancelMessage(id workers.RequestIdentifier) *apispb.ServerMessage {
+	return &apispb.ServerMessage{
+		Id: id,
+		Content: &apispb.ServerMessage_CancelRequest{
+			CancelRequest: &apispb.CancelRequest{},
+		},
+	}
+}
+
+// findConnection returns the route worker that handles the request, the connection selected to receive it and whether that connection streams bodies
+//
+//	routes with static segments are preferred over those with params, which are preferred over wildcards,
+//	requests are then shared between every worker connection that registered the matched route.
+func (s *RouteWorkerManager) findConnection(apiName string, httpRequest *apispb.HttpRequest) (*RouteWorker, *WorkerConnection, bool, error) {
 	s.lock.RLock()
 	defer s.lock.RUnlock()
 
-	if request.Id == "" {
-		request.Id = workers.GenerateUniqueId()
-	}
-
-	possibleHandlers, ok := s.routeWorkerMap[apiName]
+	router, ok := s.routers[apiName]
 	if !ok {
-		return nil, fmt.Errorf("no routes registered for api %s", apiName)
+		return nil, nil, false, &RouteNotFoundError{
+			ApiName: apiName,
+			Method:  httpRequest.GetMethod(),
+			Path:    httpRequest.GetPath(),
+		}
 	}
 
-	// Handlers are applied using Highlander rules (THERE CAN BE ONLY ONE!!!)
-	var theOneTrueHandler *RouteWorker = nil
-	for _, handler := range possibleHandlers {
-		if handler.isSupportedRequest(request.GetHttpRequest()) {
-			// Praise the one true handler 🙌
-			theOneTrueHandler = handler
-			break
-		}
+	routeWorker, err := router.find(apiName, httpRequest.GetMethod(), httpRequest.GetPath())
+	if err != nil {
+		return nil, nil, false, err
 	}
 
-	if theOneTrueHandler == nil {
-		return nil, fmt.Errorf("no worker registered for Api %s on route: %s - %s", apiName, request.GetHttpRequest().GetMethod(), request.GetHttpRequest().GetPath())
+	connection := routeWorker.nextConnection()
+
+	return routeWorker, connection, routeWorker.streamBodies[connection], nil
+}
+
+// setPathParams extracts the path params of the route from the request path, unless they're already provided
+func setPathParams(routeWorker *RouteWorker, httpRequest *apispb.HttpRequest) error {
+	if len(httpRequest.GetPathParams()) > 0 {
+		return nil
 	}
 
-	if request.GetHttpRequest().GetPathParams() == nil || len(request.GetHttpRequest().GetPathParams()) < 1 {
-		pathParams, err := extractPathParams(theOneTrueHandler.routeMatcher, request.GetHttpRequest().GetPath())
-		if err != nil {
-			return nil, err
-		}
-		request.GetHttpRequest().PathParams = pathParams
+	pathParams, err := extractPathParams(routeWorker.routeMatcher, httpRequest.GetPath())
+	if err != nil {
+		return err
 	}
 
-	resp, err := theOneTrueHandler.connection.Send(request)
+	httpRequest.PathParams = pathParams
+
+	return nil
+}
+
+// HandleRequest forwards a request with a buffered body, buffering the response body of workers that stream it
+func (s *RouteWorkerManager) HandleRequest(ctx context.Context, apiName string, request *apispb.ServerMessage) (*apispb.ClientMessage, error) {
+	httpResponse, body, err := s.HandleStreamingRequest(ctx, apiName, request, bytes.NewReader(request.GetHttpRequest().GetBody()))
 	if err != nil {
 		return nil, err
 	}
+	defer body.Close()
+
+	data, err := io.ReadAll(body)
+	if err != nil {
+		return nil, fmt.Errorf("error reading response body: %w", err)
+	}
 
-	return *resp, nil
+	httpResponse.Body = data
+	httpResponse.StreamedBody = false
+
+	return &apispb.ClientMessage{
+		Id: request.Id,
+		Content: &apispb.ClientMessage_HttpResponse{
+			HttpResponse: httpResponse,
+		},
+	}, nil
 }
 
 func New() *RouteWorkerManager {
 	return &RouteWorkerManager{
-		routeWorkerMap: map[string][]*RouteWorker{},
-		lock:           sync.RWMutex{},
+		routers: map[string]*apiRouter{},
+		lock:    sync.RWMutex{},
+		timeout: workers.TriggerTimeout(env.API_TIMEOUT),
 	}
 }
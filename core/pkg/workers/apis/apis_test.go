@@ -0,0 +1,602 @@
+// Copyright 2021 Nitric Technologies Pty Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package apis_test
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	apispb "github.com/nitrictech/nitric/core/pkg/proto/apis/v1"
+	"github.com/nitrictech/nitric/core/pkg/workers"
+	"github.com/nitrictech/nitric/core/pkg/workers/apis"
+)
+
+// mockRouteWorker is an in memory Api_ServeServer stream, standing in for an SDK serving a single route
+type mockRouteWorker struct {
+	grpc.ServerStream
+
+	toServer chan *apispb.ClientMessage
+	ready    chan struct{}
+	once     sync.Once
+	recvs    int
+
+	lock     sync.Mutex
+	hold     bool
+	requests []*apispb.ServerMessage
+	cancels  []string
+	// the request body received in HttpRequestBodyChunk messages
+	body []byte
+}
+
+func newMockRouteWorker(api string, path string, methods ...string) *mockRouteWorker {
+	return newMockRouteWorkerWithRegistration(&apispb.RegistrationRequest{
+		Api:     api,
+		Path:    path,
+		Methods: methods,
+	})
+}
+
+// newStreamingRouteWorker creates a worker that accepts streamed request bodies and streams its response body
+func newStreamingRouteWorker(api string, path string, methods ...string) *mockRouteWorker {
+	return newMockRouteWorkerWithRegistration(&apispb.RegistrationRequest{
+		Api:          api,
+		Path:         path,
+		Methods:      methods,
+		StreamBodies: true,
+	})
+}
+
+func newMockRouteWorkerWithRegistration(registration *apispb.RegistrationRequest) *mockRouteWorker {
+	w := &mockRouteWorker{
+		toServer: make(chan *apispb.ClientMessage, 10),
+		ready:    make(chan struct{}),
+	}
+
+	w.toServer <- &apispb.ClientMessage{
+		Content: &apispb.ClientMessage_RegistrationRequest{
+			RegistrationRequest: registration,
+		},
+	}
+
+	return w
+}
+
+// respondWithStream responds to a request with a streamed body, echoing the received request body
+func (w *mockRouteWorker) respondWithStream(id string) {
+	w.toServer <- &apispb.ClientMessage{
+		Id: id,
+		Content: &apispb.ClientMessage_HttpResponse{
+			HttpResponse: &apispb.HttpResponse{
+				Status:       200,
+				StreamedBody: true,
+			},
+		},
+	}
+
+	for _, chunk := range [][]byte{[]byte("echo: "), w.body} {
+		w.toServer <- &apispb.ClientMessage{
+			Id: id,
+			Content: &apispb.ClientMessage_HttpResponseBodyChunk{
+				HttpResponseBodyChunk: &apispb.HttpResponseBodyChunk{
+					Data: chunk,
+				},
+			},
+		}
+	}
+
+	w.toServer <- &apispb.ClientMessage{
+		Id: id,
+		Content: &apispb.ClientMessage_HttpResponseBodyChunk{
+			HttpResponseBodyChunk: &apispb.HttpResponseBodyChunk{
+				End: true,
+			},
+		},
+	}
+}
+
+func (w *mockRouteWorker) Send(msg *apispb.ServerMessage) error {
+	w.lock.Lock()
+	defer w.lock.Unlock()
+
+	if msg.GetCancelRequest() != nil {
+		w.cancels = append(w.cancels, msg.GetId())
+	}
+
+	if chunk := msg.GetHttpRequestBodyChunk(); chunk != nil {
+		w.body = append(w.body, chunk.GetData()...)
+
+		if chunk.GetEnd() && !w.hold {
+			go w.respondWithStream(msg.GetId())
+		}
+
+		return nil
+	}
+
+	if msg.GetHttpRequest() == nil {
+		return nil
+	}
+
+	w.requests = append(w.requests, msg)
+
+	if !w.hold && !msg.GetHttpRequest().GetStreamedBody() {
+		w.toServer <- &apispb.ClientMessage{
+			Id: msg.GetId(),
+			Content: &apispb.ClientMessage_HttpResponse{
+				HttpResponse: &apispb.HttpResponse{
+					Status: 200,
+				},
+			},
+		}
+	}
+
+	return nil
+}
+
+func (w *mockRouteWorker) Recv() (*apispb.ClientMessage, error) {
+	// the second receive is made by the request broker, after which it's ready to send requests
+	w.recvs++
+	if w.recvs > 1 {
+		w.once.Do(func() { close(w.ready) })
+	}
+
+	msg, ok := <-w.toServer
+	if !ok {
+		return nil, io.EOF
+	}
+
+	return msg, nil
+}
+
+func (w *mockRouteWorker) setHold(hold bool) {
+	w.lock.Lock()
+	defer w.lock.Unlock()
+
+	w.hold = hold
+}
+
+func (w *mockRouteWorker) requestCount() int {
+	w.lock.Lock()
+	defer w.lock.Unlock()
+
+	return len(w.requests)
+}
+
+func (w *mockRouteWorker) lastRequest() *apispb.HttpRequest {
+	w.lock.Lock()
+	defer w.lock.Unlock()
+
+	if len(w.requests) == 0 {
+		return nil
+	}
+
+	return w.requests[len(w.requests)-1].GetHttpRequest()
+}
+
+func (w *mockRouteWorker) receivedBody() []byte {
+	w.lock.Lock()
+	defer w.lock.Unlock()
+
+	return w.body
+}
+
+func (w *mockRouteWorker) cancelled() []string {
+	w.lock.Lock()
+	defer w.lock.Unlock()
+
+	return w.cancels
+}
+
+func (w *mockRouteWorker) close() {
+	close(w.toServer)
+}
+
+// slowBody is an endless request body, counting how often it's read
+type slowBody struct {
+	reads atomic.Int64
+}
+
+func (b *slowBody) Read(p []byte) (int, error) {
+	b.reads.Add(1)
+	time.Sleep(time.Millisecond)
+
+	return copy(p, "a"), nil
+}
+
+// blockedBody is a request body that blocks until it's released, e.g. a client that stopped sending its body
+type blockedBody struct {
+	released chan struct{}
+}
+
+func (b *blockedBody) Read(p []byte) (int, error) {
+	<-b.released
+	return 0, io.EOF
+}
+
+func serveWorkers(manager *apis.RouteWorkerManager, routeWorkers ...*mockRouteWorker) {
+	for _, w := range routeWorkers {
+		go func(w *mockRouteWorker) {
+			_ = manager.Serve(w)
+		}(w)
+
+		Eventually(w.ready).Should(BeClosed())
+	}
+}
+
+func newHttpRequest(method string, path string) *apispb.ServerMessage {
+	return &apispb.ServerMessage{
+		Content: &apispb.ServerMessage_HttpRequest{
+			HttpRequest: &apispb.HttpRequest{
+				Method: method,
+				Path:   path,
+			},
+		},
+	}
+}
+
+var _ = Describe("RouteWorkerManager", func() {
+	var manager *apis.RouteWorkerManager
+
+	BeforeEach(func() {
+		manager = apis.New()
+	})
+
+	When("several workers serve the same route", func() {
+		var first, second *mockRouteWorker
+
+		BeforeEach(func() {
+			first = newMockRouteWorker("test", "/users/:id", "GET")
+			second = newMockRouteWorker("test", "/users/:id", "GET")
+			serveWorkers(manager, first, second)
+		})
+
+		AfterEach(func() {
+			first.close()
+			second.close()
+		})
+
+		It("should count every worker", func() {
+			Expect(manager.WorkerCount()).To(Equal(2))
+		})
+
+		It("should report the route with both workers", func() {
+			Expect(manager.Workers()).To(ConsistOf(workers.WorkerInfo{
+				Kind:    "api",
+				Name:    "test",
+				Target:  "GET /users/:id",
+				Workers: 2,
+			}))
+		})
+
+		It("should share requests between the workers", func() {
+			for i := 0; i < 4; i++ {
+				resp, err := manager.HandleRequest(context.Background(), "test", newHttpRequest("GET", "/users/1"))
+				Expect(err).ShouldNot(HaveOccurred())
+				Expect(resp.GetHttpResponse().GetStatus()).To(Equal(int32(200)))
+			}
+
+			Expect(first.requestCount()).To(Equal(2))
+			Expect(second.requestCount()).To(Equal(2))
+		})
+
+		It("should prefer the worker with the fewest in-flight requests", func() {
+			first.setHold(true)
+			second.setHold(true)
+
+			ctx, cancel := context.WithCancel(context.Background())
+			errs := make(chan error, 1)
+			go func() {
+				_, err := manager.HandleRequest(ctx, "test", newHttpRequest("GET", "/users/1"))
+				errs <- err
+			}()
+
+			Eventually(func() int { return first.requestCount() + second.requestCount() }).Should(Equal(1))
+			Expect(workers.InFlight(manager.Workers())).To(Equal(1))
+
+			busy, idle := first, second
+			if second.requestCount() == 1 {
+				busy, idle = second, first
+			}
+			idle.setHold(false)
+
+			for i := 0; i < 3; i++ {
+				_, err := manager.HandleRequest(context.Background(), "test", newHttpRequest("GET", "/users/1"))
+				Expect(err).ShouldNot(HaveOccurred())
+			}
+
+			Expect(busy.requestCount()).To(Equal(1))
+			Expect(idle.requestCount()).To(Equal(3))
+
+			By("cancelling the held request")
+			cancel()
+			Eventually(errs).Should(Receive(MatchError(status.Error(codes.Canceled, "request cancelled before the worker responded"))))
+			Eventually(busy.cancelled).Should(HaveLen(1))
+			Expect(workers.InFlight(manager.Workers())).To(Equal(0))
+		})
+
+		It("should return DeadlineExceeded when the workers don't respond in time", func() {
+			first.setHold(true)
+			second.setHold(true)
+
+			ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+			defer cancel()
+
+			_, err := manager.HandleRequest(ctx, "test", newHttpRequest("GET", "/users/1"))
+			Expect(status.Code(err)).To(Equal(codes.DeadlineExceeded))
+		})
+	})
+
+	When("routes overlap", func() {
+		var param, static, wildcard *mockRouteWorker
+
+		BeforeEach(func() {
+			// registered in the least specific order, to show registration order doesn't matter
+			wildcard = newMockRouteWorker("test", "/files/*path", "GET")
+			param = newMockRouteWorker("test", "/users/:id", "GET", "DELETE")
+			static = newMockRouteWorker("test", "/users/me", "GET")
+			serveWorkers(manager, wildcard, param, static)
+		})
+
+		AfterEach(func() {
+			wildcard.close()
+			param.close()
+			static.close()
+		})
+
+		It("should prefer static segments over params", func() {
+			_, err := manager.HandleRequest(context.Background(), "test", newHttpRequest("GET", "/users/me"))
+			Expect(err).ShouldNot(HaveOccurred())
+
+			_, err = manager.HandleRequest(context.Background(), "test", newHttpRequest("GET", "/users/123"))
+			Expect(err).ShouldNot(HaveOccurred())
+
+			Expect(static.requestCount()).To(Equal(1))
+			Expect(param.requestCount()).To(Equal(1))
+			Expect(param.lastRequest().GetPathParams()).To(Equal(map[string]string{"id": "123"}))
+		})
+
+		It("should fall back to params when the static route doesn't handle the method", func() {
+			_, err := manager.HandleRequest(context.Background(), "test", newHttpRequest("DELETE", "/users/me"))
+			Expect(err).ShouldNot(HaveOccurred())
+
+			Expect(static.requestCount()).To(Equal(0))
+			Expect(param.lastRequest().GetPathParams()).To(Equal(map[string]string{"id": "me"}))
+		})
+
+		It("should match the remainder of the path with a wildcard", func() {
+			_, err := manager.HandleRequest(context.Background(), "test", newHttpRequest("GET", "/files/docs/2024/report.pdf"))
+			Expect(err).ShouldNot(HaveOccurred())
+
+			Expect(wildcard.lastRequest().GetPathParams()).To(Equal(map[string]string{"path": "docs/2024/report.pdf"}))
+		})
+
+		It("should ignore trailing slashes", func() {
+			_, err := manager.HandleRequest(context.Background(), "test", newHttpRequest("GET", "/users/me/"))
+			Expect(err).ShouldNot(HaveOccurred())
+
+			Expect(static.requestCount()).To(Equal(1))
+		})
+
+		It("should return a MethodNotAllowedError when the route exists without the method", func() {
+			_, err := manager.HandleRequest(context.Background(), "test", newHttpRequest("POST", "/users/me"))
+
+			var methodNotAllowed *apis.MethodNotAllowedError
+			Expect(errors.As(err, &methodNotAllowed)).To(BeTrue())
+			Expect(methodNotAllowed.AllowedMethods).To(Equal([]string{"GET"}))
+		})
+
+		It("should only allow the methods of the matched route", func() {
+			_, err := manager.HandleRequest(context.Background(), "test", newHttpRequest("POST", "/users/123"))
+
+			var methodNotAllowed *apis.MethodNotAllowedError
+			Expect(errors.As(err, &methodNotAllowed)).To(BeTrue())
+			Expect(methodNotAllowed.AllowedMethods).To(Equal([]string{"DELETE", "GET"}))
+		})
+
+		It("should return a RouteNotFoundError when no route matches", func() {
+			_, err := manager.HandleRequest(context.Background(), "test", newHttpRequest("GET", "/orders/1"))
+
+			var routeNotFound *apis.RouteNotFoundError
+			Expect(errors.As(err, &routeNotFound)).To(BeTrue())
+
+			_, err = manager.HandleRequest(context.Background(), "unknown", newHttpRequest("GET", "/users/me"))
+			Expect(errors.As(err, &routeNotFound)).To(BeTrue())
+		})
+	})
+
+	It("should reject routes with a wildcard before the last segment", func() {
+		w := newMockRouteWorker("test", "/files/*path/meta", "GET")
+		defer w.close()
+
+		Expect(manager.Serve(w)).Should(HaveOccurred())
+	})
+
+	When("request bodies are streamed", func() {
+		It("should stream the request and response bodies with workers that accept streamed bodies", func() {
+			w := newStreamingRouteWorker("test", "/upload", "POST")
+			defer w.close()
+			serveWorkers(manager, w)
+
+			// larger than a single body chunk
+			body := bytes.Repeat([]byte("a"), 200*1024)
+
+			resp, respBody, err := manager.HandleStreamingRequest(context.Background(), "test", newHttpRequest("POST", "/upload"), bytes.NewReader(body))
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(resp.GetStatus()).To(Equal(int32(200)))
+			Expect(w.lastRequest().GetStreamedBody()).To(BeTrue())
+			Expect(w.lastRequest().GetBody()).To(BeEmpty())
+
+			received, err := io.ReadAll(respBody)
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(respBody.Close()).To(Succeed())
+
+			Expect(w.receivedBody()).To(Equal(body))
+			Expect(received).To(Equal(append([]byte("echo: "), body...)))
+			Expect(workers.InFlight(manager.Workers())).To(Equal(0))
+			Expect(w.cancelled()).To(BeEmpty())
+		})
+
+		It("should send the body in full to workers that don't accept streamed bodies", func() {
+			w := newMockRouteWorker("test", "/upload", "POST")
+			defer w.close()
+			serveWorkers(manager, w)
+
+			resp, respBody, err := manager.HandleStreamingRequest(context.Background(), "test", newHttpRequest("POST", "/upload"), strings.NewReader("hello"))
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(resp.GetStatus()).To(Equal(int32(200)))
+			Expect(respBody.Close()).To(Succeed())
+
+			Expect(w.lastRequest().GetStreamedBody()).To(BeFalse())
+			Expect(w.lastRequest().GetBody()).To(Equal([]byte("hello")))
+		})
+
+		It("should buffer the streamed response of requests with a buffered body", func() {
+			w := newStreamingRouteWorker("test", "/upload", "POST")
+			defer w.close()
+			serveWorkers(manager, w)
+
+			request := newHttpRequest("POST", "/upload")
+			request.GetHttpRequest().Body = []byte("hello")
+
+			resp, err := manager.HandleRequest(context.Background(), "test", request)
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(resp.GetHttpResponse().GetStatus()).To(Equal(int32(200)))
+			Expect(resp.GetHttpResponse().GetStreamedBody()).To(BeFalse())
+			Expect(resp.GetHttpResponse().GetBody()).To(Equal([]byte("echo: hello")))
+			Expect(w.receivedBody()).To(Equal([]byte("hello")))
+		})
+
+		It("should stop reading the request body before returning", func() {
+			w := newStreamingRouteWorker("test", "/upload", "POST")
+			w.setHold(true)
+			defer w.close()
+			serveWorkers(manager, w)
+
+			ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+			defer cancel()
+
+			body := &slowBody{}
+			_, _, err := manager.HandleStreamingRequest(ctx, "test", newHttpRequest("POST", "/upload"), body)
+			Expect(status.Code(err)).To(Equal(codes.DeadlineExceeded))
+
+			// at most a read already in progress when the request returned completes
+			reads := body.reads.Load()
+			Consistently(body.reads.Load, 20*time.Millisecond).Should(BeNumerically("<=", reads+1))
+		})
+
+		It("should not wait for a request body that is never sent once the request is done", func() {
+			w := newStreamingRouteWorker("test", "/upload", "POST")
+			w.setHold(true)
+			defer w.close()
+			serveWorkers(manager, w)
+
+			ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+			defer cancel()
+
+			body := &blockedBody{released: make(chan struct{})}
+			defer close(body.released)
+
+			errs := make(chan error, 1)
+			go func() {
+				_, _, err := manager.HandleStreamingRequest(ctx, "test", newHttpRequest("POST", "/upload"), body)
+				errs <- err
+			}()
+
+			var err error
+			Eventually(errs, time.Second).Should(Receive(&err))
+			Expect(status.Code(err)).To(Equal(codes.DeadlineExceeded))
+		})
+
+		It("should reject buffered bodies larger than the limit of a LimitedBody", func() {
+			w := newMockRouteWorker("test", "/upload", "POST")
+			defer w.close()
+			serveWorkers(manager, w)
+
+			body := &apis.LimitedBody{Reader: strings.NewReader("hello world"), MaxBufferedSize: 5}
+
+			_, _, err := manager.HandleStreamingRequest(context.Background(), "test", newHttpRequest("POST", "/upload"), body)
+
+			var tooLarge *apis.RequestBodyTooLargeError
+			Expect(errors.As(err, &tooLarge)).To(BeTrue())
+			Expect(tooLarge.MaxBufferedSize).To(Equal(5))
+			Expect(w.requestCount()).To(Equal(0))
+		})
+
+		It("should stream a LimitedBody in full to workers that accept streamed bodies", func() {
+			w := newStreamingRouteWorker("test", "/upload", "POST")
+			defer w.close()
+			serveWorkers(manager, w)
+
+			body := &apis.LimitedBody{Reader: strings.NewReader("hello world"), MaxBufferedSize: 5}
+
+			_, respBody, err := manager.HandleStreamingRequest(context.Background(), "test", newHttpRequest("POST", "/upload"), body)
+			Expect(err).ShouldNot(HaveOccurred())
+
+			received, err := io.ReadAll(respBody)
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(respBody.Close()).To(Succeed())
+			Expect(received).To(Equal([]byte("echo: hello world")))
+		})
+
+		It("should reject streamed response bodies from workers that don't accept streamed bodies", func() {
+			w := newMockRouteWorker("test", "/upload", "POST")
+			w.setHold(true)
+			defer w.close()
+			serveWorkers(manager, w)
+
+			request := newHttpRequest("POST", "/upload")
+			request.Id = "streamed-response"
+
+			go func() {
+				defer GinkgoRecover()
+				Eventually(w.requestCount).Should(Equal(1))
+
+				w.toServer <- &apispb.ClientMessage{
+					Id: request.Id,
+					Content: &apispb.ClientMessage_HttpResponse{
+						HttpResponse: &apispb.HttpResponse{Status: 200, StreamedBody: true},
+					},
+				}
+			}()
+
+			_, _, err := manager.HandleStreamingRequest(context.Background(), "test", request, strings.NewReader("hello"))
+			Expect(err).To(MatchError(ContainSubstring("didn't register with stream_bodies")))
+		})
+
+		It("should cancel the request when the response body is closed before it's read", func() {
+			w := newStreamingRouteWorker("test", "/upload", "POST")
+			defer w.close()
+			serveWorkers(manager, w)
+
+			_, respBody, err := manager.HandleStreamingRequest(context.Background(), "test", newHttpRequest("POST", "/upload"), strings.NewReader("hello"))
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(respBody.Close()).To(Succeed())
+
+			Expect(w.cancelled()).To(HaveLen(1))
+			Expect(workers.InFlight(manager.Workers())).To(Equal(0))
+		})
+	})
+})
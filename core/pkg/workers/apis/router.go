@@ -0,0 +1,313 @@
+// Copyright 2021 Nitric Technologies Pty Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package apis
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+)
+
+const (
+	paramPrefix    = ":"
+	wildcardPrefix = "*"
+)
+
+// RouteNotFoundError is returned when no worker is registered for a request path
+type RouteNotFoundError struct {
+	ApiName string
+	Method  string
+	Path    string
+}
+
+func (e *RouteNotFoundError) Error() string {
+	return fmt.Sprintf("no worker registered for Api %s on route: %s - %s", e.ApiName, e.Method, e.Path)
+}
+
+// RequestBodyTooLargeError is returned when the body of a request for a worker that doesn't stream bodies exceeds the size it can be buffered to
+type RequestBodyTooLargeError struct {
+	MaxBufferedSize int
+}
+
+func (e *RequestBodyTooLargeError) Error() string {
+	return fmt.Sprintf("request body exceeds the maximum size of %d bytes for workers that don't stream bodies", e.MaxBufferedSize)
+}
+
+// MethodNotAllowedError is returned when workers are registered for a request path, but none of them handle the request method
+type MethodNotAllowedError struct {
+	ApiName        string
+	Method         string
+	Path           string
+	AllowedMethods []string
+}
+
+func (e *MethodNotAllowedError) Error() string {
+	return fmt.Sprintf("method %s not allowed for Api %s on route: %s, allowed methods: %s", e.Method, e.ApiName, e.Path, strings.Join(e.AllowedMethods, ", "))
+}
+
+// slashSplitter - used to split strings, with the same output regardless of leading or trailing slashes
+// e.g - strings.FieldsFunc("/one/two/three/", f) == strings.FieldsFunc("/one/two/three", f) == strings.FieldsFunc("one/two/three", f) == ["one" "two" "three"]
+func slashSplitter(c rune) bool {
+	return c == '/'
+}
+
+// SplitPath - splits a path into its component parts, ignoring leading or trailing slashes.
+// e.g - SplitPath("/one/two/three/") == SplitPath("/one/two/three") == SplitPath("one/two/three") == ["one" "two" "three"]
+func splitPath(p string) []string {
+	return strings.FieldsFunc(p, slashSplitter)
+}
+
+// extractPathParams returns the values of the param and wildcard segments of a route for the given request path.
+//
+//	a trailing wildcard segment (e.g. *path) greedily captures the remainder of the request path.
+func extractPathParams(route string, requestPath string) (map[string]string, error) {
+	requestPathSegments := splitPath(requestPath)
+	pathSegments := splitPath(route)
+	params := make(map[string]string)
+
+	for i, p := range pathSegments {
+		if strings.HasPrefix(p, wildcardPrefix) {
+			if name := strings.TrimPrefix(p, wildcardPrefix); name != "" {
+				params[name] = strings.Join(requestPathSegments[min(i, len(requestPathSegments)):], "/")
+			}
+
+			return params, nil
+		}
+
+		if i >= len(requestPathSegments) {
+			return nil, fmt.Errorf("path template mismatch")
+		}
+
+		if strings.HasPrefix(p, paramPrefix) {
+			params[strings.TrimPrefix(p, paramPrefix)] = requestPathSegments[i]
+		} else if p != requestPathSegments[i] {
+			return nil, fmt.Errorf("path template mismatch")
+		}
+	}
+
+	if len(requestPathSegments) != len(pathSegments) {
+		return nil, fmt.Errorf("path template mismatch")
+	}
+
+	return params, nil
+}
+
+// routeNode is a single path segment in the route tree of an API
+type routeNode struct {
+	static   map[string]*routeNode
+	param    *routeNode
+	wildcard *routeNode
+	// route workers for routes ending at this segment, in registration order
+	routes []*RouteWorker
+}
+
+func newRouteNode() *routeNode {
+	return &routeNode{
+		static: map[string]*routeNode{},
+	}
+}
+
+// child returns the node for a route segment, creating it if needed
+func (n *routeNode) child(segment string) *routeNode {
+	switch {
+	case strings.HasPrefix(segment, wildcardPrefix):
+		if n.wildcard == nil {
+			n.wildcard = newRouteNode()
+		}
+		return n.wildcard
+	case strings.HasPrefix(segment, paramPrefix):
+		if n.param == nil {
+			n.param = newRouteNode()
+		}
+		return n.param
+	default:
+		if _, ok := n.static[segment]; !ok {
+			n.static[segment] = newRouteNode()
+		}
+		return n.static[segment]
+	}
+}
+
+// routeFor returns the first route ending at this node that handles the method
+func (n *routeNode) routeFor(method string) *RouteWorker {
+	for _, rw := range n.routes {
+		if slices.Contains(rw.methods, method) {
+			return rw
+		}
+	}
+
+	return nil
+}
+
+// allowedMethods returns the sorted methods of the routes ending at this node
+func (n *routeNode) allowedMethods() []string {
+	allowed := []string{}
+	for _, rw := range n.routes {
+		for _, m := range rw.methods {
+			if !slices.Contains(allowed, m) {
+				allowed = append(allowed, m)
+			}
+		}
+	}
+	slices.Sort(allowed)
+
+	return allowed
+}
+
+// match finds the route for the method and remaining path segments, preferring static segments over params and params over wildcards.
+func (n *routeNode) match(method string, segments []string) *RouteWorker {
+	if len(segments) == 0 {
+		if rw := n.routeFor(method); rw != nil {
+			return rw
+		}
+	} else {
+		if child, ok := n.static[segments[0]]; ok {
+			if rw := child.match(method, segments[1:]); rw != nil {
+				return rw
+			}
+		}
+
+		if n.param != nil {
+			if rw := n.param.match(method, segments[1:]); rw != nil {
+				return rw
+			}
+		}
+	}
+
+	// wildcards are always the last segment of a route, so they match the remainder of the path, including an empty remainder
+	if n.wildcard != nil {
+		return n.wildcard.routeFor(method)
+	}
+
+	return nil
+}
+
+// matchPath finds the most specific node with routes matching the remaining path segments, regardless of their methods
+func (n *routeNode) matchPath(segments []string) *routeNode {
+	if len(segments) == 0 {
+		if len(n.routes) > 0 {
+			return n
+		}
+	} else {
+		if child, ok := n.static[segments[0]]; ok {
+			if node := child.matchPath(segments[1:]); node != nil {
+				return node
+			}
+		}
+
+		if n.param != nil {
+			if node := n.param.matchPath(segments[1:]); node != nil {
+				return node
+			}
+		}
+	}
+
+	if n.wildcard != nil && len(n.wildcard.routes) > 0 {
+		return n.wildcard
+	}
+
+	return nil
+}
+
+// apiRouter matches requests for a single API to the route workers registered for it
+type apiRouter struct {
+	root   *routeNode
+	routes []*RouteWorker
+}
+
+func newApiRouter() *apiRouter {
+	return &apiRouter{
+		root:   newRouteNode(),
+		routes: []*RouteWorker{},
+	}
+}
+
+// add a worker connection for a route, workers registering the same route and methods share a single route worker.
+func (r *apiRouter) add(path string, methods []string, connection *WorkerConnection) (*RouteWorker, error) {
+	segments := splitPath(path)
+
+	node := r.root
+	for i, segment := range segments {
+		if strings.HasPrefix(segment, wildcardPrefix) && i != len(segments)-1 {
+			return nil, fmt.Errorf("invalid route %s, wildcard segments must be the last segment of the route", path)
+		}
+
+		node = node.child(segment)
+	}
+
+	routeMatcher := "/" + strings.Join(segments, "/")
+
+	for _, rw := range node.routes {
+		if rw.routeMatcher == routeMatcher && rw.hasMethods(methods) {
+			rw.connections = append(rw.connections, connection)
+			return rw, nil
+		}
+	}
+
+	rw := &RouteWorker{
+		routeMatcher: routeMatcher,
+		methods:      methods,
+		connections:  []*WorkerConnection{connection},
+		streamBodies: map[*WorkerConnection]bool{},
+		node:         node,
+	}
+	node.routes = append(node.routes, rw)
+	r.routes = append(r.routes, rw)
+
+	return rw, nil
+}
+
+// remove a worker connection from a route, the route is removed once it has no remaining connections
+func (r *apiRouter) remove(routeWorker *RouteWorker, connection *WorkerConnection) {
+	routeWorker.connections = slices.DeleteFunc(routeWorker.connections, func(wc *WorkerConnection) bool {
+		return wc == connection
+	})
+
+	if len(routeWorker.connections) > 0 {
+		return
+	}
+
+	isRouteWorker := func(rw *RouteWorker) bool {
+		return rw == routeWorker
+	}
+
+	routeWorker.node.routes = slices.DeleteFunc(routeWorker.node.routes, isRouteWorker)
+	r.routes = slices.DeleteFunc(r.routes, isRouteWorker)
+}
+
+// find the route worker for a request, returning a RouteNotFoundError or MethodNotAllowedError if there is none
+func (r *apiRouter) find(apiName string, method string, path string) (*RouteWorker, error) {
+	segments := splitPath(path)
+
+	if rw := r.root.match(method, segments); rw != nil {
+		return rw, nil
+	}
+
+	// the allowed methods are those of the route the path matches, not every route considered while matching
+	if node := r.root.matchPath(segments); node != nil {
+		return nil, &MethodNotAllowedError{
+			ApiName:        apiName,
+			Method:         method,
+			Path:           path,
+			AllowedMethods: node.allowedMethods(),
+		}
+	}
+
+	return nil, &RouteNotFoundError{
+		ApiName: apiName,
+		Method:  method,
+		Path:    path,
+	}
+}
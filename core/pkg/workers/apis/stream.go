@@ -0,0 +1,276 @@
+// Copyright 2021 Nitric Technologies Pty Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package apis
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+
+	"github.com/nitrictech/nitric/core/pkg/logger"
+	apispb "github.com/nitrictech/nitric/core/pkg/proto/apis/v1"
+	"github.com/nitrictech/nitric/core/pkg/workers"
+)
+
+// bodyChunkSize is the maximum size of each streamed request body chunk, well below the default gRPC message size limit
+const bodyChunkSize = 64 * 1024
+
+type requestStream = workers.RequestStream[*apispb.ServerMessage, *apispb.ClientMessage]
+
+var log = logger.Component("workers")
+
+// HandleStreamingRequest forwards a request, reading its body from body, and returns the response with a reader for its body.
+//
+//	workers that registered with stream_bodies receive the body as HttpRequestBodyChunk messages and may respond with HttpResponseBodyChunk messages,
+//	other workers receive the body in full in the HttpRequest.
+func (s *RouteWorkerManager) HandleStreamingRequest(ctx context.Context, apiName string, request *apispb.ServerMessage, body io.Reader) (resp *apispb.HttpResponse, _ io.ReadCloser, err error) {
+	if request.Id == "" {
+		request.Id = workers.GenerateUniqueId()
+	}
+
+	// the trigger ends once the response has been received, the response body may still be streaming
+	ctx, trigger := startRequestTrigger(ctx, apiName, request)
+	defer func() {
+		if resp != nil {
+			trigger.SetHttpStatus(int(resp.GetStatus()))
+		}
+		trigger.End(err)
+	}()
+
+	routeWorker, connection, streamBodies, err := s.findConnection(apiName, request.GetHttpRequest())
+	if err != nil {
+		return nil, nil, err
+	}
+
+	trigger.SetHttpRoute(routeWorker.routeMatcher)
+
+	if err := setPathParams(routeWorker, request.GetHttpRequest()); err != nil {
+		return nil, nil, err
+	}
+
+	if !streamBodies {
+		return s.handleBufferedRequest(ctx, connection, request, body)
+	}
+
+	ctx, cancel := workers.WithTriggerTimeout(ctx, s.timeout)
+
+	request.GetHttpRequest().Body = nil
+	request.GetHttpRequest().StreamedBody = true
+
+	stream, err := connection.Stream(ctx, request)
+	if err != nil {
+		cancel()
+		return nil, nil, err
+	}
+
+	// the body is read by the caller's server, so it mustn't be read once the request is complete,
+	// unless the request is done before the body could be sent, e.g. because the worker isn't reading it
+	bodySent := make(chan struct{})
+	go func() {
+		defer close(bodySent)
+		sendRequestBody(ctx, stream, request.Id, body)
+	}()
+
+	msg, err := stream.Recv()
+	if err != nil {
+		stream.Cancel()
+		waitForRequestBody(ctx, bodySent)
+		cancel()
+		return nil, nil, err
+	}
+
+	httpResponse := msg.GetHttpResponse()
+	if httpResponse == nil {
+		stream.Cancel()
+		waitForRequestBody(ctx, bodySent)
+		cancel()
+		return nil, nil, fmt.Errorf("received invalid response type from worker")
+	}
+
+	if !httpResponse.StreamedBody {
+		stream.Close()
+		waitForRequestBody(ctx, bodySent)
+		cancel()
+		return httpResponse, io.NopCloser(bytes.NewReader(httpResponse.Body)), nil
+	}
+
+	return httpResponse, &responseBodyReader{
+		ctx:      ctx,
+		id:       request.Id,
+		stream:   stream,
+		bodySent: bodySent,
+		cancel:   cancel,
+	}, nil
+}
+
+// handleBufferedRequest sends the request body in full, for workers that don't accept streamed bodies
+func (s *RouteWorkerManager) handleBufferedRequest(ctx context.Context, connection *WorkerConnection, request *apispb.ServerMessage, body io.Reader) (*apispb.HttpResponse, io.ReadCloser, error) {
+	data, err := readBufferedBody(body)
+	if err != nil {
+		return nil, nil, fmt.Errorf("error reading request body: %w", err)
+	}
+
+	request.GetHttpRequest().Body = data
+	request.GetHttpRequest().StreamedBody = false
+
+	ctx, cancel := workers.WithTriggerTimeout(ctx, s.timeout)
+	defer cancel()
+
+	resp, err := connection.Send(ctx, request)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	httpResponse := (*resp).GetHttpResponse()
+	if httpResponse == nil {
+		return nil, nil, fmt.Errorf("received invalid response type from worker")
+	}
+
+	if httpResponse.GetStreamedBody() {
+		return nil, nil, fmt.Errorf("received streamed response body from a worker that didn't register with stream_bodies")
+	}
+
+	return httpResponse, io.NopCloser(bytes.NewReader(httpResponse.Body)), nil
+}
+
+// sendRequestBody sends the request body to the worker in chunks, ending with a chunk marked as the end of the body
+func sendRequestBody(ctx context.Context, stream *requestStream, id workers.RequestIdentifier, body io.Reader) {
+	for ctx.Err() == nil {
+		// a new buffer for each chunk, as the message may be retained after it's sent
+		buf := make([]byte, bodyChunkSize)
+		n, err := body.Read(buf)
+
+		if n > 0 {
+			if sendErr := stream.Send(newRequestBodyChunk(id, buf[:n], false)); sendErr != nil {
+				// the request is already complete or cancelled
+				return
+			}
+		}
+
+		if err == io.EOF {
+			_ = stream.Send(newRequestBodyChunk(id, nil, true))
+			return
+		}
+
+		if err != nil {
+			log.ErrorContext(ctx, "error reading request body", "error", err)
+			stream.Cancel()
+			return
+		}
+	}
+}
+
+// waitForRequestBody waits until the request body is no longer being sent to the worker, or the request is done
+func waitForRequestBody(ctx context.Context, bodySent <-chan struct{}) {
+	select {
+	case <-bodySent:
+	case <-ctx.Done():
+	}
+}
+
+// LimitedBody is a request body that is streamed in full to workers that registered with stream_bodies,
+// but can only be buffered up to MaxBufferedSize bytes for other workers, e.g. the body of an HTTP request to the gateway.
+type LimitedBody struct {
+	io.Reader
+	MaxBufferedSize int
+}
+
+// readBufferedBody reads a request body in full, returning a RequestBodyTooLargeError if it exceeds the limit of a LimitedBody
+func readBufferedBody(body io.Reader) ([]byte, error) {
+	limited, ok := body.(*LimitedBody)
+	if !ok || limited.MaxBufferedSize <= 0 {
+		return io.ReadAll(body)
+	}
+
+	data, err := io.ReadAll(io.LimitReader(limited.Reader, int64(limited.MaxBufferedSize)+1))
+	if err != nil {
+		return nil, err
+	}
+
+	if len(data) > limited.MaxBufferedSize {
+		return nil, &RequestBodyTooLargeError{MaxBufferedSize: limited.MaxBufferedSize}
+	}
+
+	return data, nil
+}
+
+func newRequestBodyChunk(id workers.RequestIdentifier, data []byte, end bool) *apispb.ServerMessage {
+	return &apispb.ServerMessage{
+		Id: id,
+		Content: &apispb.ServerMessage_HttpRequestBodyChunk{
+			HttpRequestBodyChunk: &apispb.HttpRequestBodyChunk{
+				Data: data,
+				End:  end,
+			},
+		},
+	}
+}
+
+// responseBodyReader reads a streamed response body from the HttpResponseBodyChunk messages sent by the worker
+type responseBodyReader struct {
+	ctx    context.Context
+	id     workers.RequestIdentifier
+	stream *requestStream
+	// closed once the request body is no longer being sent
+	bodySent <-chan struct{}
+	cancel   context.CancelFunc
+	buf      []byte
+	end      bool
+}
+
+var _ io.ReadCloser = &responseBodyReader{}
+
+func (r *responseBodyReader) Read(p []byte) (int, error) {
+	for len(r.buf) == 0 {
+		if r.end {
+			return 0, io.EOF
+		}
+
+		msg, err := r.stream.Recv()
+		if err != nil {
+			return 0, err
+		}
+
+		chunk := msg.GetHttpResponseBodyChunk()
+		if chunk == nil {
+			return 0, fmt.Errorf("received invalid response type from worker while reading the body of request %s", r.id)
+		}
+
+		r.buf = chunk.Data
+		r.end = chunk.End
+	}
+
+	n := copy(p, r.buf)
+	r.buf = r.buf[n:]
+
+	return n, nil
+}
+
+// Close the response body, the worker is notified of the cancellation if the body wasn't read in full
+//
+//	returns once the request body is no longer being read, or the request is done.
+func (r *responseBodyReader) Close() error {
+	if r.end {
+		r.stream.Close()
+	} else {
+		r.stream.Cancel()
+	}
+
+	waitForRequestBody(r.ctx, r.bodySent)
+	r.cancel()
+
+	return nil
+}
@@ -15,25 +15,31 @@
 package http
 
 import (
+	"context"
 	"fmt"
 	"net"
 	"sync"
+	"sync/atomic"
 	"time"
 
 	httppb "github.com/nitrictech/nitric/core/pkg/proto/http/v1"
+	"github.com/nitrictech/nitric/core/pkg/workers"
 	"github.com/valyala/fasthttp"
+	"go.opentelemetry.io/otel/attribute"
 )
 
 type HttpServer struct {
-	Output chan error
-	host   string
-	lock   sync.Mutex
+	Output   chan error
+	host     string
+	lock     sync.Mutex
+	inFlight atomic.Int64
 }
 
 type HttpRequestHandler interface {
 	httppb.HttpServer
 	HandleRequest(request *fasthttp.Request) (*fasthttp.Response, error)
 	WorkerCount() int
+	Workers() []workers.WorkerInfo
 }
 
 // IsPortOpen returns true if the port is open, false otherwise
@@ -69,14 +75,37 @@ func IsPortOpenWithRetry(host string, connectionTimeout time.Duration, maxRetrie
 	return <-done
 }
 
+// getHost returns the address of the registered HTTP server, or an empty string if none is registered
+func (h *HttpServer) getHost() string {
+	h.lock.Lock()
+	defer h.lock.Unlock()
+
+	return h.host
+}
+
 func (h *HttpServer) WorkerCount() int {
-	if h.host != "" {
+	if h.getHost() != "" {
 		return 1
 	}
 
 	return 0
 }
 
+// Workers returns the proxied HTTP server, if one has been registered
+func (h *HttpServer) Workers() []workers.WorkerInfo {
+	host := h.getHost()
+	if host == "" {
+		return []workers.WorkerInfo{}
+	}
+
+	return []workers.WorkerInfo{{
+		Kind:     "http",
+		Name:     host,
+		Workers:  1,
+		InFlight: int(h.inFlight.Load()),
+	}}
+}
+
 const (
 	// Dial timeout for initial server check
 	initialStartTimeout = 5 * time.Second
@@ -87,9 +116,7 @@ const (
 )
 
 func (h *HttpServer) Proxy(stream httppb.Http_ProxyServer) error {
-	h.lock.Lock()
-	defer h.lock.Unlock()
-	if h.host != "" {
+	if h.getHost() != "" {
 		return fmt.Errorf("http server already registered")
 	}
 
@@ -113,7 +140,15 @@ func (h *HttpServer) Proxy(stream httppb.Http_ProxyServer) error {
 		return fmt.Errorf("host %s failed to respond within %s", host, initialStartTimeout.String())
 	}
 
+	// the lock is only held to register the host, so the server can be inspected while it's proxied
+	h.lock.Lock()
+	if h.host != "" {
+		h.lock.Unlock()
+		return fmt.Errorf("http server already registered")
+	}
 	h.host = host
+	h.lock.Unlock()
+
 	for {
 		_, err = stream.Recv()
 		if err != nil {
@@ -121,28 +156,43 @@ func (h *HttpServer) Proxy(stream httppb.Http_ProxyServer) error {
 		}
 	}
 
+	h.lock.Lock()
 	h.host = ""
+	h.lock.Unlock()
+
 	h.Output <- fmt.Errorf("HTTP server %s is not available", host)
 	return err
 }
 
 // HandleRequest forwards proxy request to the underlying HTTP server
-func (srv *HttpServer) HandleRequest(request *fasthttp.Request) (*fasthttp.Response, error) {
-	if srv.host == "" {
+func (srv *HttpServer) HandleRequest(request *fasthttp.Request) (_ *fasthttp.Response, err error) {
+	host := srv.getHost()
+	if host == "" {
 		return nil, fmt.Errorf("http server not registered")
 	}
 
+	srv.inFlight.Add(1)
+	defer srv.inFlight.Add(-1)
+
+	_, trigger := workers.StartTrigger(context.Background(), "http", host, workers.GenerateUniqueId(),
+		attribute.String("http.request.method", string(request.Header.Method())),
+		attribute.String("url.path", string(request.URI().Path())),
+	)
+	defer func() { trigger.End(err) }()
+
 	requestCopy := &fasthttp.Request{}
 	var response fasthttp.Response
 
 	request.CopyTo(requestCopy)
-	requestCopy.URI().SetHost(srv.host)
+	requestCopy.URI().SetHost(host)
 	requestCopy.URI().SetScheme("http")
 
 	if err := fasthttp.Do(requestCopy, &response); err != nil {
 		return nil, err
 	}
 
+	trigger.SetHttpStatus(response.StatusCode())
+
 	return &response, nil
 }
 
@@ -0,0 +1,53 @@
+// Copyright 2021 Nitric Technologies Pty Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package workers
+
+import (
+	"cmp"
+	"slices"
+)
+
+// WorkerInfo describes the workers registered for a single trigger, e.g. an API route or a topic subscription
+type WorkerInfo struct {
+	// The kind of trigger, e.g. api, subscription or schedule
+	Kind string `json:"kind"`
+	// The name of the resource the workers are registered for, e.g. the API or topic name
+	Name string `json:"name"`
+	// The trigger within the resource, e.g. the route and methods of an API route
+	Target string `json:"target,omitempty"`
+	// The number of workers registered for the trigger
+	Workers int `json:"workers"`
+	// The number of requests currently awaiting a response from the workers
+	InFlight int `json:"inFlight"`
+}
+
+// SortWorkerInfo sorts worker info by name and target, so it's reported in a consistent order
+func SortWorkerInfo(info []WorkerInfo) []WorkerInfo {
+	slices.SortFunc(info, func(a, b WorkerInfo) int {
+		return cmp.Or(cmp.Compare(a.Name, b.Name), cmp.Compare(a.Target, b.Target))
+	})
+
+	return info
+}
+
+// InFlight returns the total number of requests awaiting a response from the workers described by info
+func InFlight(info []WorkerInfo) int {
+	total := 0
+	for _, i := range info {
+		total += i.InFlight
+	}
+
+	return total
+}
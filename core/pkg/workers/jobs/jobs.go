@@ -15,12 +15,17 @@
 package jobs
 
 import (
+	"context"
 	"fmt"
 	"sync"
+	"time"
 
+	"github.com/nitrictech/nitric/core/pkg/env"
 	"github.com/nitrictech/nitric/core/pkg/help"
 	batchpb "github.com/nitrictech/nitric/core/pkg/proto/batch/v1"
+	"github.com/nitrictech/nitric/core/pkg/tracing"
 	"github.com/nitrictech/nitric/core/pkg/workers"
+	"go.opentelemetry.io/otel/attribute"
 )
 
 type JobName = string
@@ -29,8 +34,9 @@ type WorkerConnection = workers.WorkerRequestBroker[*batchpb.ServerMessage, *bat
 
 type JobRequestHandler interface {
 	batchpb.JobServer
-	HandleJobRequest(request *batchpb.ServerMessage) (*batchpb.ClientMessage, error)
+	HandleJobRequest(ctx context.Context, request *batchpb.ServerMessage) (*batchpb.ClientMessage, error)
 	WorkerCount() int
+	Workers() []workers.WorkerInfo
 }
 
 var _ JobRequestHandler = (*JobManager)(nil)
@@ -38,6 +44,7 @@ var _ JobRequestHandler = (*JobManager)(nil)
 type JobManager struct {
 	handlers map[JobName]*WorkerConnection
 	lock     sync.RWMutex
+	timeout  time.Duration
 }
 
 func (s *JobManager) registerHandler(handler *WorkerConnection, registrationRequest *batchpb.RegistrationRequest) error {
@@ -70,6 +77,24 @@ func (s *JobManager) WorkerCount() int {
 	return len(s.handlers)
 }
 
+// Workers returns the handler registered for each job
+func (s *JobManager) Workers() []workers.WorkerInfo {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+
+	info := []workers.WorkerInfo{}
+	for jobName, handler := range s.handlers {
+		info = append(info, workers.WorkerInfo{
+			Kind:     "job",
+			Name:     jobName,
+			Workers:  1,
+			InFlight: handler.InFlight(),
+		})
+	}
+
+	return workers.SortWorkerInfo(info)
+}
+
 // Subscribe allows the local nitric server to register new subscribers
 //
 //	called by Nitric applications wishing to subscribe to a topic.
@@ -86,7 +111,7 @@ func (s *JobManager) HandleJob(handlerConnectionStream batchpb.Job_HandleJobServ
 		return fmt.Errorf("request received from unregistered subscriber, initial request must be a registration request. %s", help.BugInNitricHelpText())
 	}
 
-	handler := workers.NewWorkerRequestBroker[*batchpb.ServerMessage, *batchpb.ClientMessage](handlerConnectionStream)
+	handler := workers.NewWorkerRequestBroker[*batchpb.ServerMessage, *batchpb.ClientMessage](handlerConnectionStream, newCancelMessage)
 	if err := s.registerHandler(handler, registrationRequest); err != nil {
 		return err
 	}
@@ -123,7 +148,16 @@ func (s *JobManager) findMatchingHandler(jobName string) (*WorkerConnection, err
 	return worker, nil
 }
 
-func (s *JobManager) HandleJobRequest(request *batchpb.ServerMessage) (*batchpb.ClientMessage, error) {
+func newCancelMessage(id workers.RequestIdentifier) *batchpb.ServerMessage {
+	return &batchpb.ServerMessage{
+		Id: id,
+		Content: &batchpb.ServerMessage_CancelRequest{
+			CancelRequest: &batchpb.CancelRequest{},
+		},
+	}
+}
+
+func (s *JobManager) HandleJobRequest(ctx context.Context, request *batchpb.ServerMessage) (_ *batchpb.ClientMessage, err error) {
 	if request.Id == "" {
 		request.Id = workers.GenerateUniqueId()
 	}
@@ -134,12 +168,20 @@ func (s *JobManager) HandleJobRequest(request *batchpb.ServerMessage) (*batchpb.
 	}
 	jobName := messageRequest.GetJobName()
 
+	ctx, trigger := workers.StartTrigger(ctx, "job", jobName, request.GetId(), attribute.String("nitric.job", jobName))
+	defer func() { trigger.End(err) }()
+
+	request.TraceContext = tracing.Inject(ctx)
+
 	handler, err := s.findMatchingHandler(jobName)
 	if err != nil {
 		return nil, err
 	}
 
-	resp, err := handler.Send(request)
+	ctx, cancel := workers.WithTriggerTimeout(ctx, s.timeout)
+	defer cancel()
+
+	resp, err := handler.Send(ctx, request)
 	if err != nil {
 		return nil, err
 	}
@@ -150,5 +192,6 @@ func New() *JobManager {
 	return &JobManager{
 		handlers: make(map[string]*WorkerConnection),
 		lock:     sync.RWMutex{},
+		timeout:  workers.TriggerTimeout(env.JOB_TIMEOUT),
 	}
 }
@@ -15,12 +15,16 @@
 package workers
 
 import (
+	"context"
+	"errors"
 	"fmt"
 	"sync"
+	"sync/atomic"
 
-	"github.com/nitrictech/nitric/core/pkg/help"
 	"github.com/nitrictech/nitric/core/pkg/logger"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 type GrpcBidiStreamServer[ServerMessage IdentifiableMessage, ClientMessage IdentifiableMessage] interface {
@@ -31,62 +35,167 @@ type GrpcBidiStreamServer[ServerMessage IdentifiableMessage, ClientMessage Ident
 
 type RequestIdentifier = string
 
+var log = logger.Component("workers")
+
 // IdentifiableMessage is a message that has an ID, used to match requests and responses
 type IdentifiableMessage interface {
 	GetId() RequestIdentifier
 }
 
+// CancelMessageFactory creates the message sent to a worker to notify it that the request with the given ID has been cancelled
+type CancelMessageFactory[Request IdentifiableMessage] func(id RequestIdentifier) Request
+
 // WorkerRequestBroker helps manage the async bidirectional stream between the worker (typically a client SDK) and the Nitric server.
 //
 //	the broker facilitates sending requests to a worker and awaits responses, then matches them with the corresponding request.
 //	This enables users of this brokers to treat the request/response lifecycle with the worker as if they were synchronous.
 type WorkerRequestBroker[Request IdentifiableMessage, Response IdentifiableMessage] struct {
 	workerConnectionStream GrpcBidiStreamServer[Request, Response]
-	responseChannelLock    sync.RWMutex
-	responseChannels       map[RequestIdentifier]chan Response
-	running                bool
+	newCancelMessage       CancelMessageFactory[Request]
+	// grpc streams don't support concurrent calls to Send
+	sendLock            sync.Mutex
+	responseChannelLock sync.RWMutex
+	responseChannels    map[RequestIdentifier]*responseReceiver[Response]
+	// set once the worker connection is lost, no further requests can be sent to the worker
+	disconnected bool
+	inFlight     atomic.Int64
+	running      atomic.Bool
 }
 
-func (w *WorkerRequestBroker[Request, Response]) Send(req Request) (*Response, error) {
-	if !w.running {
-		return nil, fmt.Errorf("worker server not running, call Start() before sending requests")
+// responseReceiver receives the responses for a single request
+type responseReceiver[Response IdentifiableMessage] struct {
+	responses chan Response
+	// closed when a RequestStream stops receiving responses, nil for requests with a single response
+	done chan struct{}
+}
+
+// InFlight returns the number of requests sent to the worker that are still awaiting a response
+func (w *WorkerRequestBroker[Request, Response]) InFlight() int {
+	return int(w.inFlight.Load())
+}
+
+func (w *WorkerRequestBroker[Request, Response]) send(msg Request) error {
+	w.sendLock.Lock()
+	defer w.sendLock.Unlock()
+
+	return w.workerConnectionStream.Send(msg)
+}
+
+func (w *WorkerRequestBroker[Request, Response]) addResponseReceiver(id RequestIdentifier, receiver *responseReceiver[Response]) error {
+	w.responseChannelLock.Lock()
+	defer w.responseChannelLock.Unlock()
+
+	if w.disconnected {
+		return errWorkerDisconnected
+	}
+
+	if _, exists := w.responseChannels[id]; exists {
+		return fmt.Errorf("request with ID %s already exists", id)
 	}
 
-	if _, exists := w.responseChannels[req.GetId()]; exists {
-		return nil, fmt.Errorf("request with ID %s already exists", req.GetId())
+	w.responseChannels[id] = receiver
+
+	return nil
+}
+
+// failResponseReceivers closes the receivers of every request still awaiting a response, so they fail instead of waiting on a lost worker
+func (w *WorkerRequestBroker[Request, Response]) failResponseReceivers() {
+	w.responseChannelLock.Lock()
+	defer w.responseChannelLock.Unlock()
+
+	w.disconnected = true
+
+	for id, receiver := range w.responseChannels {
+		close(receiver.responses)
+		delete(w.responseChannels, id)
 	}
+}
 
+func (w *WorkerRequestBroker[Request, Response]) removeResponseChannel(id RequestIdentifier) {
 	w.responseChannelLock.Lock()
-	responseChannel := make(chan Response)
-	w.responseChannels[req.GetId()] = responseChannel
-	w.responseChannelLock.Unlock()
+	defer w.responseChannelLock.Unlock()
+
+	delete(w.responseChannels, id)
+}
+
+// cancel notifies the worker that a request is no longer being waited on, so it can stop processing it
+func (w *WorkerRequestBroker[Request, Response]) cancel(ctx context.Context, id RequestIdentifier) {
+	if w.newCancelMessage == nil {
+		return
+	}
+
+	if err := w.send(w.newCancelMessage(id)); err != nil {
+		log.DebugContext(ctx, "unable to send cancellation to worker", "error", err)
+	}
+}
 
-	if err := w.workerConnectionStream.Send(req); err != nil {
+// Send a request to the worker and wait for its response.
+//
+//	If the context is done before the worker responds, the worker is notified of the cancellation and
+//	a DeadlineExceeded or Canceled status error is returned. If the worker disconnects an Unavailable status error is returned.
+func (w *WorkerRequestBroker[Request, Response]) Send(ctx context.Context, req Request) (*Response, error) {
+	if !w.running.Load() {
+		return nil, fmt.Errorf("worker server not running, call Start() before sending requests")
+	}
+
+	if err := ctx.Err(); err != nil {
+		return nil, contextError(err)
+	}
+
+	w.inFlight.Add(1)
+	defer w.inFlight.Add(-1)
+
+	// buffered so a late response never blocks the receive loop
+	responseChannel := make(chan Response, 1)
+	if err := w.addResponseReceiver(req.GetId(), &responseReceiver[Response]{responses: responseChannel}); err != nil {
+		return nil, err
+	}
+
+	// clean up the map reference
+	defer w.removeResponseChannel(req.GetId())
+
+	if err := w.send(req); err != nil {
 		return nil, err
 	}
 
 	// wait for the response
-	response, ok := <-responseChannel
-	if !ok {
-		return nil, fmt.Errorf("error receiving response")
+	select {
+	case response, ok := <-responseChannel:
+		if !ok {
+			return nil, errWorkerDisconnected
+		}
+
+		return &response, nil
+	case <-ctx.Done():
+		w.cancel(ctx, req.GetId())
+
+		return nil, contextError(ctx.Err())
 	}
+}
 
-	// clean up the map reference
-	w.responseChannelLock.Lock()
-	delete(w.responseChannels, req.GetId())
-	w.responseChannelLock.Unlock()
+// errWorkerDisconnected is returned for requests that can't be completed because the worker connection was lost
+var errWorkerDisconnected = status.Error(codes.Unavailable, "worker disconnected before responding to the request")
+
+// contextError converts a context error into the equivalent gRPC status error
+func contextError(err error) error {
+	if errors.Is(err, context.DeadlineExceeded) {
+		return status.Error(codes.DeadlineExceeded, "worker did not respond before the request deadline")
+	}
 
-	return &response, nil
+	return status.Error(codes.Canceled, "request cancelled before the worker responded")
 }
 
 // Run the connection broker, allowing async communication with the worker (i.e.
 func (w *WorkerRequestBroker[Request, Response]) Run() error {
-	if w.running {
+	if !w.running.CompareAndSwap(false, true) {
 		return fmt.Errorf("worker already running")
 	}
+
+	// requests still waiting when the worker disconnects would otherwise never receive a response
+	defer w.failResponseReceivers()
+
 	// Read responses on the client connection stream and match them with the corresponding response channel.
 	for {
-		w.running = true
 		response, err := w.workerConnectionStream.Recv()
 		if err != nil {
 			// Most likely the client closed the connection
@@ -94,23 +203,42 @@ func (w *WorkerRequestBroker[Request, Response]) Run() error {
 		}
 
 		w.responseChannelLock.RLock()
-		responseChannel, ok := w.responseChannels[response.GetId()]
+		receiver, ok := w.responseChannels[response.GetId()]
 		w.responseChannelLock.RUnlock()
 		if !ok {
-			// This would indicate a critical bug, it means that the client (SDK) did not return a response with an ID that matches a request that was sent to it
+			// This is expected when a response arrives after its request was cancelled, otherwise it would indicate a critical bug,
+			// it means that the client (SDK) did not return a response with an ID that matches a request that was sent to it
 			// OR there may have been a network error that resulted in duplicate responses
-			logger.Errorf("nitric received a response for an unknown request, response could not be returned: %s", help.BugInNitricHelpText())
+			log.Warn("nitric received a response for an unknown or cancelled request, response will be discarded", logger.RequestIdKey, response.GetId())
+			continue
 		}
 
-		responseChannel <- response
+		if receiver.done != nil {
+			// streams receive every response in order, waiting for the stream to read them unless it's closed
+			select {
+			case receiver.responses <- response:
+			case <-receiver.done:
+			}
+
+			continue
+		}
+
+		select {
+		case receiver.responses <- response:
+		default:
+			log.Warn("nitric received a duplicate response for request, response will be discarded", logger.RequestIdKey, response.GetId())
+		}
 	}
 }
 
-func NewWorkerRequestBroker[Request IdentifiableMessage, Response IdentifiableMessage](workerConnectionStream GrpcBidiStreamServer[Request, Response]) *WorkerRequestBroker[Request, Response] {
+// NewWorkerRequestBroker creates a broker for the given worker stream.
+//
+//	newCancelMessage is used to notify the worker of cancelled requests, if nil cancellations won't be sent to the worker.
+func NewWorkerRequestBroker[Request IdentifiableMessage, Response IdentifiableMessage](workerConnectionStream GrpcBidiStreamServer[Request, Response], newCancelMessage CancelMessageFactory[Request]) *WorkerRequestBroker[Request, Response] {
 	return &WorkerRequestBroker[Request, Response]{
 		workerConnectionStream: workerConnectionStream,
+		newCancelMessage:       newCancelMessage,
 		responseChannelLock:    sync.RWMutex{},
-		responseChannels:       make(map[string]chan Response),
-		running:                false,
+		responseChannels:       make(map[string]*responseReceiver[Response]),
 	}
 }
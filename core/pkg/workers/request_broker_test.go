@@ -0,0 +1,231 @@
+// Copyright 2021 Nitric Technologies Pty Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package workers_test
+
+import (
+	"context"
+	"io"
+	"sync"
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	apispb "github.com/nitrictech/nitric/core/pkg/proto/apis/v1"
+	"github.com/nitrictech/nitric/core/pkg/workers"
+)
+
+// mockWorkerStream is an in memory worker stream, that never responds and disconnects when closed
+type mockWorkerStream struct {
+	grpc.ServerStream
+
+	sent         chan *apispb.ServerMessage
+	receiving    chan struct{}
+	receiveOnce  sync.Once
+	disconnected chan struct{}
+}
+
+func newMockWorkerStream() *mockWorkerStream {
+	return &mockWorkerStream{
+		sent:         make(chan *apispb.ServerMessage, 10),
+		receiving:    make(chan struct{}),
+		disconnected: make(chan struct{}),
+	}
+}
+
+func (m *mockWorkerStream) Send(msg *apispb.ServerMessage) error {
+	m.sent <- msg
+	return nil
+}
+
+func (m *mockWorkerStream) Recv() (*apispb.ClientMessage, error) {
+	m.receiveOnce.Do(func() { close(m.receiving) })
+
+	<-m.disconnected
+	return nil, io.EOF
+}
+
+func (m *mockWorkerStream) Context() context.Context {
+	return context.Background()
+}
+
+func newHttpRequest(id string) *apispb.ServerMessage {
+	return &apispb.ServerMessage{
+		Id: id,
+		Content: &apispb.ServerMessage_HttpRequest{
+			HttpRequest: &apispb.HttpRequest{Method: "GET", Path: "/"},
+		},
+	}
+}
+
+func newCancelRequest(id string) *apispb.ServerMessage {
+	return &apispb.ServerMessage{
+		Id: id,
+		Content: &apispb.ServerMessage_CancelRequest{
+			CancelRequest: &apispb.CancelRequest{},
+		},
+	}
+}
+
+// runBroker runs the broker, waiting until it's receiving from the worker and ready to send requests
+func runBroker(broker *workers.WorkerRequestBroker[*apispb.ServerMessage, *apispb.ClientMessage], stream *mockWorkerStream) chan error {
+	runErr := make(chan error, 1)
+	go func() {
+		runErr <- broker.Run()
+	}()
+
+	Eventually(stream.receiving).Should(BeClosed())
+
+	return runErr
+}
+
+// sentId returns the ID of a message sent to the worker
+func sentId(msg *apispb.ServerMessage) string {
+	return msg.GetId()
+}
+
+var _ = Describe("WorkerRequestBroker", func() {
+	When("the worker disconnects while a request is awaiting its response", func() {
+		It("should fail the request as unavailable", func() {
+			stream := newMockWorkerStream()
+			broker := workers.NewWorkerRequestBroker[*apispb.ServerMessage, *apispb.ClientMessage](stream, nil)
+
+			runErr := runBroker(broker, stream)
+
+			sendErr := make(chan error, 1)
+			go func() {
+				_, err := broker.Send(context.Background(), newHttpRequest("test-request"))
+				sendErr <- err
+			}()
+
+			By("waiting for the request to reach the worker")
+			Eventually(stream.sent).Should(Receive(WithTransform(sentId, Equal("test-request"))))
+
+			close(stream.disconnected)
+
+			Eventually(runErr).Should(Receive(Equal(io.EOF)))
+
+			var err error
+			Eventually(sendErr, time.Second).Should(Receive(&err))
+			Expect(status.Code(err)).To(Equal(codes.Unavailable))
+		})
+
+		It("should fail streamed requests as unavailable", func() {
+			stream := newMockWorkerStream()
+			broker := workers.NewWorkerRequestBroker[*apispb.ServerMessage, *apispb.ClientMessage](stream, nil)
+
+			runErr := runBroker(broker, stream)
+
+			requestStream, err := broker.Stream(context.Background(), newHttpRequest("test-stream"))
+			Expect(err).ShouldNot(HaveOccurred())
+			defer requestStream.Close()
+
+			close(stream.disconnected)
+			Eventually(runErr).Should(Receive())
+
+			_, err = requestStream.Recv()
+			Expect(status.Code(err)).To(Equal(codes.Unavailable))
+
+			By("rejecting new requests")
+			_, err = broker.Send(context.Background(), newHttpRequest("late-request"))
+			Expect(status.Code(err)).To(Equal(codes.Unavailable))
+		})
+	})
+
+	When("the request deadline passes before the worker responds", func() {
+		It("should fail the request and notify the worker of the cancellation", func() {
+			stream := newMockWorkerStream()
+			broker := workers.NewWorkerRequestBroker[*apispb.ServerMessage, *apispb.ClientMessage](stream, newCancelRequest)
+
+			runErr := runBroker(broker, stream)
+			defer func() {
+				close(stream.disconnected)
+				Eventually(runErr).Should(Receive())
+			}()
+
+			ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+			defer cancel()
+
+			_, err := broker.Send(ctx, newHttpRequest("test-request"))
+			Expect(status.Code(err)).To(Equal(codes.DeadlineExceeded))
+
+			Expect(stream.sent).To(Receive(WithTransform(sentId, Equal("test-request"))))
+
+			var cancelMsg *apispb.ServerMessage
+			Expect(stream.sent).To(Receive(&cancelMsg))
+			Expect(cancelMsg.GetId()).To(Equal("test-request"))
+			Expect(cancelMsg.GetCancelRequest()).ToNot(BeNil())
+
+			By("no longer counting the request as in flight")
+			Expect(broker.InFlight()).To(Equal(0))
+		})
+	})
+
+	When("the request is cancelled before the worker responds", func() {
+		It("should fail the request and notify the worker of the cancellation", func() {
+			stream := newMockWorkerStream()
+			broker := workers.NewWorkerRequestBroker[*apispb.ServerMessage, *apispb.ClientMessage](stream, newCancelRequest)
+
+			runErr := runBroker(broker, stream)
+			defer func() {
+				close(stream.disconnected)
+				Eventually(runErr).Should(Receive())
+			}()
+
+			ctx, cancel := context.WithCancel(context.Background())
+
+			sendErr := make(chan error, 1)
+			go func() {
+				_, err := broker.Send(ctx, newHttpRequest("test-request"))
+				sendErr <- err
+			}()
+
+			By("waiting for the request to reach the worker")
+			Eventually(stream.sent).Should(Receive(WithTransform(sentId, Equal("test-request"))))
+
+			cancel()
+
+			var err error
+			Eventually(sendErr).Should(Receive(&err))
+			Expect(status.Code(err)).To(Equal(codes.Canceled))
+
+			var cancelMsg *apispb.ServerMessage
+			Expect(stream.sent).To(Receive(&cancelMsg))
+			Expect(cancelMsg.GetId()).To(Equal("test-request"))
+			Expect(cancelMsg.GetCancelRequest()).ToNot(BeNil())
+		})
+
+		It("should not send requests whose context is already done", func() {
+			stream := newMockWorkerStream()
+			broker := workers.NewWorkerRequestBroker[*apispb.ServerMessage, *apispb.ClientMessage](stream, newCancelRequest)
+
+			runErr := runBroker(broker, stream)
+			defer func() {
+				close(stream.disconnected)
+				Eventually(runErr).Should(Receive())
+			}()
+
+			ctx, cancel := context.WithCancel(context.Background())
+			cancel()
+
+			_, err := broker.Send(ctx, newHttpRequest("test-request"))
+			Expect(status.Code(err)).To(Equal(codes.Canceled))
+			Expect(stream.sent).ToNot(Receive())
+		})
+	})
+})
@@ -0,0 +1,124 @@
+// Copyright 2021 Nitric Technologies Pty Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package workers
+
+import (
+	"context"
+	"fmt"
+	"sync"
+)
+
+// streamResponseBuffer is the number of responses buffered for a RequestStream before the broker waits for them to be read
+const streamResponseBuffer = 16
+
+// RequestStream is a request sent to a worker that may be followed by further messages with the same ID
+// and answered with several responses, e.g. a request or response with a streamed body.
+type RequestStream[Request IdentifiableMessage, Response IdentifiableMessage] struct {
+	broker    *WorkerRequestBroker[Request, Response]
+	ctx       context.Context
+	id        RequestIdentifier
+	responses chan Response
+	done      chan struct{}
+	closeOnce sync.Once
+}
+
+// Send another message for the request to the worker
+func (s *RequestStream[Request, Response]) Send(msg Request) error {
+	select {
+	case <-s.done:
+		return fmt.Errorf("request stream %s is closed", s.id)
+	default:
+	}
+
+	return s.broker.send(msg)
+}
+
+// Recv waits for the next response from the worker.
+//
+//	If the context is done first, the worker is notified of the cancellation and
+//	a DeadlineExceeded or Canceled status error is returned. If the worker disconnects an Unavailable status error is returned.
+func (s *RequestStream[Request, Response]) Recv() (Response, error) {
+	var empty Response
+
+	select {
+	case response, ok := <-s.responses:
+		if !ok {
+			return empty, errWorkerDisconnected
+		}
+
+		return response, nil
+	case <-s.done:
+		return empty, fmt.Errorf("request stream %s is closed", s.id)
+	case <-s.ctx.Done():
+		s.Cancel()
+
+		return empty, contextError(s.ctx.Err())
+	}
+}
+
+// Close the stream once the request is complete, any further responses for the request are discarded
+func (s *RequestStream[Request, Response]) Close() {
+	s.closeOnce.Do(func() {
+		close(s.done)
+		s.broker.removeResponseChannel(s.id)
+		s.broker.inFlight.Add(-1)
+	})
+}
+
+// Cancel closes the stream before the request is complete, notifying the worker of the cancellation
+func (s *RequestStream[Request, Response]) Cancel() {
+	select {
+	case <-s.done:
+		return
+	default:
+	}
+
+	s.Close()
+	s.broker.cancel(s.ctx, s.id)
+}
+
+// Stream sends a request to the worker, returning a RequestStream to send further messages for the request and receive its responses.
+//
+//	The stream must be closed once the request is complete.
+func (w *WorkerRequestBroker[Request, Response]) Stream(ctx context.Context, req Request) (*RequestStream[Request, Response], error) {
+	if !w.running.Load() {
+		return nil, fmt.Errorf("worker server not running, call Start() before sending requests")
+	}
+
+	if err := ctx.Err(); err != nil {
+		return nil, contextError(err)
+	}
+
+	stream := &RequestStream[Request, Response]{
+		broker:    w,
+		ctx:       ctx,
+		id:        req.GetId(),
+		responses: make(chan Response, streamResponseBuffer),
+		done:      make(chan struct{}),
+	}
+
+	if err := w.addResponseReceiver(req.GetId(), &responseReceiver[Response]{responses: stream.responses, done: stream.done}); err != nil {
+		return nil, err
+	}
+
+	w.inFlight.Add(1)
+
+	if err := w.send(req); err != nil {
+		stream.Close()
+		return nil, err
+	}
+
+	return stream, nil
+}
@@ -15,31 +15,82 @@
 package schedules
 
 import (
+	"context"
 	"fmt"
 	"sync"
+	"time"
 
+	"github.com/nitrictech/nitric/core/pkg/env"
+	"github.com/nitrictech/nitric/core/pkg/logger"
 	schedulespb "github.com/nitrictech/nitric/core/pkg/proto/schedules/v1"
+	"github.com/nitrictech/nitric/core/pkg/tracing"
 	"github.com/nitrictech/nitric/core/pkg/workers"
+	"go.opentelemetry.io/otel/attribute"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
+var log = logger.Component("workers")
+
 type ScheduleName = string
 
 type WorkerConnection = workers.WorkerRequestBroker[*schedulespb.ServerMessage, *schedulespb.ClientMessage]
 
 type ScheduleRequestHandler interface {
 	schedulespb.SchedulesServer
-	HandleRequest(request *schedulespb.ServerMessage) (*schedulespb.ClientMessage, error)
+	HandleRequest(ctx context.Context, request *schedulespb.ServerMessage) (*schedulespb.ClientMessage, error)
 	WorkerCount() int
+	Workers() []workers.WorkerInfo
+}
+
+// scheduleWorker is the worker registered for a schedule, with the concurrency policy of its runs
+type scheduleWorker struct {
+	connection *WorkerConnection
+	policy     schedulespb.ConcurrencyPolicy
+	// holds a value while a run is in progress, unused by schedules that allow concurrent runs
+	running chan struct{}
+}
+
+// acquire waits for the schedule's concurrency policy to allow a new run,
+// returning false if the run should be skipped.
+//
+// Only runs on this runtime instance are tracked, runs delivered to other instances of the service can still overlap.
+func (w *scheduleWorker) acquire(ctx context.Context) (bool, error) {
+	switch w.policy {
+	case schedulespb.ConcurrencyPolicy_Skip:
+		select {
+		case w.running <- struct{}{}:
+			return true, nil
+		default:
+			return false, nil
+		}
+	case schedulespb.ConcurrencyPolicy_Queue:
+		select {
+		case w.running <- struct{}{}:
+			return true, nil
+		case <-ctx.Done():
+			return false, ctx.Err()
+		}
+	default:
+		return true, nil
+	}
+}
+
+// release completes a run acquired with acquire
+func (w *scheduleWorker) release() {
+	if w.policy == schedulespb.ConcurrencyPolicy_Skip || w.policy == schedulespb.ConcurrencyPolicy_Queue {
+		<-w.running
+	}
 }
 
 type ScheduleWorkerManager struct {
-	workerMap map[ScheduleName]*WorkerConnection
+	workerMap map[ScheduleName]*scheduleWorker
 	mutex     sync.RWMutex
+	timeout   time.Duration
 }
 
 var _ schedulespb.SchedulesServer = &ScheduleWorkerManager{}
 
-func (s *ScheduleWorkerManager) registerSchedule(scheduleWorker *WorkerConnection, request *schedulespb.RegistrationRequest) error {
+func (s *ScheduleWorkerManager) registerSchedule(worker *WorkerConnection, request *schedulespb.RegistrationRequest) error {
 	s.mutex.Lock()
 	defer s.mutex.Unlock()
 
@@ -49,19 +100,23 @@ func (s *ScheduleWorkerManager) registerSchedule(scheduleWorker *WorkerConnectio
 		return fmt.Errorf("schedule already registered with name: %s", scheduleName)
 	}
 
-	s.workerMap[scheduleName] = scheduleWorker
+	s.workerMap[scheduleName] = &scheduleWorker{
+		connection: worker,
+		policy:     request.GetConcurrencyPolicy(),
+		running:    make(chan struct{}, 1),
+	}
 
 	return nil
 }
 
-func (s *ScheduleWorkerManager) unregisterSchedule(scheduleWorker *WorkerConnection) {
+func (s *ScheduleWorkerManager) unregisterSchedule(worker *WorkerConnection) {
 	s.mutex.Lock()
 	defer s.mutex.Unlock()
 
 	var resultKey string
 
 	for k, wrb := range s.workerMap {
-		if wrb == scheduleWorker {
+		if wrb.connection == worker {
 			resultKey = k
 			break
 		}
@@ -80,7 +135,7 @@ func (s *ScheduleWorkerManager) Schedule(stream schedulespb.Schedules_ScheduleSe
 		return fmt.Errorf("first request must be an init request")
 	}
 
-	worker := workers.NewWorkerRequestBroker[*schedulespb.ServerMessage, *schedulespb.ClientMessage](stream)
+	worker := workers.NewWorkerRequestBroker[*schedulespb.ServerMessage, *schedulespb.ClientMessage](stream, newCancelMessage)
 	if err := s.registerSchedule(worker, initRequest.GetRegistrationRequest()); err != nil {
 		return err
 	}
@@ -100,32 +155,112 @@ func (s *ScheduleWorkerManager) Schedule(stream schedulespb.Schedules_ScheduleSe
 	return worker.Run()
 }
 
-func (s *ScheduleWorkerManager) HandleRequest(request *schedulespb.ServerMessage) (*schedulespb.ClientMessage, error) {
-	s.mutex.RLock()
-	defer s.mutex.RUnlock()
+func newCancelMessage(id workers.RequestIdentifier) *schedulespb.ServerMessage {
+	return &schedulespb.ServerMessage{
+		Id: id,
+		Content: &schedulespb.ServerMessage_CancelRequest{
+			CancelRequest: &schedulespb.CancelRequest{},
+		},
+	}
+}
+
+// setRunMetadata sets the delivery time and defaults the scheduled time and attempt of the run if the provider didn't set them
+func setRunMetadata(intervalRequest *schedulespb.IntervalRequest) {
+	if intervalRequest == nil {
+		return
+	}
+
+	intervalRequest.DeliveryTime = timestamppb.Now()
+
+	if intervalRequest.ScheduledTime == nil {
+		intervalRequest.ScheduledTime = intervalRequest.DeliveryTime
+	}
+
+	if intervalRequest.Attempt < 1 {
+		intervalRequest.Attempt = 1
+	}
+}
 
+// HandleRequest forwards a run of a schedule to its worker, applying the concurrency policy of the schedule.
+//
+//	runs skipped by the policy are successfully handled without being forwarded.
+func (s *ScheduleWorkerManager) HandleRequest(ctx context.Context, request *schedulespb.ServerMessage) (_ *schedulespb.ClientMessage, err error) {
 	if request.Id == "" {
 		request.Id = workers.GenerateUniqueId()
 	}
 
-	worker, ok := s.workerMap[request.GetIntervalRequest().GetScheduleName()]
+	scheduleName := request.GetIntervalRequest().GetScheduleName()
+
+	ctx, trigger := workers.StartTrigger(ctx, "schedule", scheduleName, request.GetId(), attribute.String("nitric.schedule", scheduleName))
+	defer func() { trigger.End(err) }()
+
+	request.TraceContext = tracing.Inject(ctx)
+
+	s.mutex.RLock()
+	worker, ok := s.workerMap[scheduleName]
+	s.mutex.RUnlock()
 
 	if !ok {
-		return nil, fmt.Errorf("no worker registered for schedule: %s", request.GetIntervalRequest().GetScheduleName())
+		return nil, fmt.Errorf("no worker registered for schedule: %s", scheduleName)
+	}
+
+	ctx, cancel := workers.WithTriggerTimeout(ctx, s.timeout)
+	defer cancel()
+
+	// queued runs wait for the previous run within the trigger timeout
+	acquired, err := worker.acquire(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if !acquired {
+		log.InfoContext(ctx, "skipping schedule run, the previous run is still in progress")
+
+		return &schedulespb.ClientMessage{
+			Id: request.Id,
+			Content: &schedulespb.ClientMessage_IntervalResponse{
+				IntervalResponse: &schedulespb.IntervalResponse{},
+			},
+		}, nil
 	}
+	defer worker.release()
+
+	setRunMetadata(request.GetIntervalRequest())
 
-	resp, err := worker.Send(request)
+	resp, err := worker.connection.Send(ctx, request)
+	if err != nil {
+		return nil, err
+	}
 
-	return *resp, err
+	return *resp, nil
 }
 
 func (s *ScheduleWorkerManager) WorkerCount() int {
 	return len(s.workerMap)
 }
 
+// Workers returns the worker registered for each schedule
+func (s *ScheduleWorkerManager) Workers() []workers.WorkerInfo {
+	s.mutex.RLock()
+	defer s.mutex.RUnlock()
+
+	info := []workers.WorkerInfo{}
+	for scheduleName, worker := range s.workerMap {
+		info = append(info, workers.WorkerInfo{
+			Kind:     "schedule",
+			Name:     scheduleName,
+			Workers:  1,
+			InFlight: worker.connection.InFlight(),
+		})
+	}
+
+	return workers.SortWorkerInfo(info)
+}
+
 func New() *ScheduleWorkerManager {
 	return &ScheduleWorkerManager{
-		workerMap: make(map[string]*WorkerConnection),
+		workerMap: make(map[string]*scheduleWorker),
 		mutex:     sync.RWMutex{},
+		timeout:   workers.TriggerTimeout(env.SCHEDULE_TIMEOUT),
 	}
 }
@@ -0,0 +1,233 @@
+// Copyright 2021 Nitric Technologies Pty Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package schedules_test
+
+import (
+	"context"
+	"io"
+	"sync"
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"google.golang.org/grpc"
+
+	schedulespb "github.com/nitrictech/nitric/core/pkg/proto/schedules/v1"
+	"github.com/nitrictech/nitric/core/pkg/workers/schedules"
+)
+
+// mockScheduleWorker is an in memory Schedules_ScheduleServer stream, standing in for an SDK handling a schedule,
+// runs are held until they're completed with complete
+type mockScheduleWorker struct {
+	grpc.ServerStream
+
+	toServer chan *schedulespb.ClientMessage
+	ready    chan struct{}
+	once     sync.Once
+	recvs    int
+
+	lock     sync.Mutex
+	requests []*schedulespb.ServerMessage
+}
+
+func newMockScheduleWorker(scheduleName string, policy schedulespb.ConcurrencyPolicy) *mockScheduleWorker {
+	w := &mockScheduleWorker{
+		toServer: make(chan *schedulespb.ClientMessage, 10),
+		ready:    make(chan struct{}),
+	}
+
+	w.toServer <- &schedulespb.ClientMessage{
+		Content: &schedulespb.ClientMessage_RegistrationRequest{
+			RegistrationRequest: &schedulespb.RegistrationRequest{
+				ScheduleName:      scheduleName,
+				ConcurrencyPolicy: policy,
+			},
+		},
+	}
+
+	return w
+}
+
+func (w *mockScheduleWorker) Send(msg *schedulespb.ServerMessage) error {
+	if msg.GetIntervalRequest() == nil {
+		return nil
+	}
+
+	w.lock.Lock()
+	defer w.lock.Unlock()
+
+	w.requests = append(w.requests, msg)
+
+	return nil
+}
+
+func (w *mockScheduleWorker) Recv() (*schedulespb.ClientMessage, error) {
+	// the second receive is made by the request broker, after which it's ready to send requests
+	w.recvs++
+	if w.recvs > 1 {
+		w.once.Do(func() { close(w.ready) })
+	}
+
+	msg, ok := <-w.toServer
+	if !ok {
+		return nil, io.EOF
+	}
+
+	return msg, nil
+}
+
+func (w *mockScheduleWorker) runs() []*schedulespb.ServerMessage {
+	w.lock.Lock()
+	defer w.lock.Unlock()
+
+	return append([]*schedulespb.ServerMessage{}, w.requests...)
+}
+
+// complete the nth run received by the worker
+func (w *mockScheduleWorker) complete(n int) {
+	w.toServer <- &schedulespb.ClientMessage{
+		Id: w.runs()[n].GetId(),
+		Content: &schedulespb.ClientMessage_IntervalResponse{
+			IntervalResponse: &schedulespb.IntervalResponse{},
+		},
+	}
+}
+
+func (w *mockScheduleWorker) close() {
+	close(w.toServer)
+}
+
+func newIntervalRequest(scheduleName string) *schedulespb.ServerMessage {
+	return &schedulespb.ServerMessage{
+		Content: &schedulespb.ServerMessage_IntervalRequest{
+			IntervalRequest: &schedulespb.IntervalRequest{
+				ScheduleName: scheduleName,
+			},
+		},
+	}
+}
+
+// run handles a run of the schedule in the background, returning a channel that receives its error once it's handled
+func run(manager *schedules.ScheduleWorkerManager, scheduleName string) chan error {
+	done := make(chan error, 1)
+
+	go func() {
+		_, err := manager.HandleRequest(context.TODO(), newIntervalRequest(scheduleName))
+		done <- err
+	}()
+
+	return done
+}
+
+var _ = Describe("Schedules", func() {
+	var manager *schedules.ScheduleWorkerManager
+	var worker *mockScheduleWorker
+
+	register := func(policy schedulespb.ConcurrencyPolicy) {
+		manager = schedules.New()
+		worker = newMockScheduleWorker("hourly", policy)
+
+		go func() {
+			_ = manager.Schedule(worker)
+		}()
+
+		Eventually(worker.ready).Should(BeClosed())
+	}
+
+	AfterEach(func() {
+		worker.close()
+	})
+
+	When("a schedule is run", func() {
+		BeforeEach(func() {
+			register(schedulespb.ConcurrencyPolicy_Allow)
+		})
+
+		It("should include the run metadata", func() {
+			done := run(manager, "hourly")
+
+			Eventually(worker.runs).Should(HaveLen(1))
+			worker.complete(0)
+			Eventually(done).Should(Receive(BeNil()))
+
+			intervalRequest := worker.runs()[0].GetIntervalRequest()
+			Expect(intervalRequest.GetDeliveryTime().AsTime()).To(BeTemporally("~", time.Now(), time.Second))
+			Expect(intervalRequest.GetScheduledTime()).To(Equal(intervalRequest.GetDeliveryTime()))
+			Expect(intervalRequest.GetAttempt()).To(Equal(int32(1)))
+		})
+	})
+
+	When("the schedule allows concurrent runs", func() {
+		BeforeEach(func() {
+			register(schedulespb.ConcurrencyPolicy_Allow)
+		})
+
+		It("should start a new run while the previous run is in progress", func() {
+			first := run(manager, "hourly")
+			Eventually(worker.runs).Should(HaveLen(1))
+
+			second := run(manager, "hourly")
+			Eventually(worker.runs).Should(HaveLen(2))
+
+			worker.complete(0)
+			worker.complete(1)
+			Eventually(first).Should(Receive(BeNil()))
+			Eventually(second).Should(Receive(BeNil()))
+		})
+	})
+
+	When("the schedule skips concurrent runs", func() {
+		BeforeEach(func() {
+			register(schedulespb.ConcurrencyPolicy_Skip)
+		})
+
+		It("should skip a new run while the previous run is in progress", func() {
+			first := run(manager, "hourly")
+			Eventually(worker.runs).Should(HaveLen(1))
+
+			Eventually(run(manager, "hourly")).Should(Receive(BeNil()))
+			Consistently(worker.runs, "100ms").Should(HaveLen(1))
+
+			worker.complete(0)
+			Eventually(first).Should(Receive(BeNil()))
+
+			By("starting runs once the previous run has completed")
+			run(manager, "hourly")
+			Eventually(worker.runs).Should(HaveLen(2))
+			worker.complete(1)
+		})
+	})
+
+	When("the schedule queues concurrent runs", func() {
+		BeforeEach(func() {
+			register(schedulespb.ConcurrencyPolicy_Queue)
+		})
+
+		It("should start a new run once the previous run has completed", func() {
+			first := run(manager, "hourly")
+			Eventually(worker.runs).Should(HaveLen(1))
+
+			second := run(manager, "hourly")
+			Consistently(worker.runs, "100ms").Should(HaveLen(1))
+
+			worker.complete(0)
+			Eventually(first).Should(Receive(BeNil()))
+			Eventually(worker.runs).Should(HaveLen(2))
+
+			worker.complete(1)
+			Eventually(second).Should(Receive(BeNil()))
+		})
+	})
+})
@@ -15,13 +15,18 @@
 package storage
 
 import (
+	"context"
 	"fmt"
 	"strings"
 	"sync"
+	"time"
 
+	"github.com/nitrictech/nitric/core/pkg/env"
 	"github.com/nitrictech/nitric/core/pkg/help"
 	storagepb "github.com/nitrictech/nitric/core/pkg/proto/storage/v1"
+	"github.com/nitrictech/nitric/core/pkg/tracing"
 	"github.com/nitrictech/nitric/core/pkg/workers"
+	"go.opentelemetry.io/otel/attribute"
 )
 
 // BucketName uniquely identifies a storage bucket
@@ -31,12 +36,14 @@ type BucketName = string
 type BucketListenerManager struct {
 	listenerMap map[BucketName][]*BucketEventListener
 	mutex       sync.RWMutex
+	timeout     time.Duration
 }
 
 type BucketRequestHandler interface {
 	storagepb.StorageListenerServer
-	HandleRequest(request *storagepb.ServerMessage) (*storagepb.ClientMessage, error)
+	HandleRequest(ctx context.Context, request *storagepb.ServerMessage) (*storagepb.ClientMessage, error)
 	WorkerCount() int
+	Workers() []workers.WorkerInfo
 }
 
 // WorkerConnection handles communication between storage and worker
@@ -60,6 +67,27 @@ func (b *BucketListenerManager) WorkerCount() int {
 	return total
 }
 
+// Workers returns the listeners registered for each bucket, event type and key prefix
+func (b *BucketListenerManager) Workers() []workers.WorkerInfo {
+	b.mutex.RLock()
+	defer b.mutex.RUnlock()
+
+	info := []workers.WorkerInfo{}
+	for bucketName, listeners := range b.listenerMap {
+		for _, listener := range listeners {
+			info = append(info, workers.WorkerInfo{
+				Kind:     "bucket-listener",
+				Name:     bucketName,
+				Target:   fmt.Sprintf("%s %s", listener.eventType, listener.keyPrefixMatch),
+				Workers:  1,
+				InFlight: listener.connection.InFlight(),
+			})
+		}
+	}
+
+	return workers.SortWorkerInfo(info)
+}
+
 // findMatchingListener or error if not found, for specific bucket, event type, and key prefix
 func (b *BucketListenerManager) findMatchingListener(bucketName BucketName, eventType storagepb.BlobEventType, key string) (*BucketEventListener, error) {
 	b.mutex.RLock()
@@ -105,7 +133,7 @@ func (b *BucketListenerManager) RegisterNewListener(registration *storagepb.Regi
 		prefixFilter = ""
 	}
 
-	workerConn := workers.NewWorkerRequestBroker[*storagepb.ServerMessage, *storagepb.ClientMessage](stream)
+	workerConn := workers.NewWorkerRequestBroker[*storagepb.ServerMessage, *storagepb.ClientMessage](stream, newCancelMessage)
 
 	if b.listenerMap[bucketName] == nil {
 		b.listenerMap[bucketName] = make([]*BucketEventListener, 0, 1)
@@ -162,8 +190,17 @@ func (b *BucketListenerManager) Listen(stream storagepb.StorageListener_ListenSe
 	return workerConn.Run()
 }
 
+func newCancelMessage(id workers.RequestIdentifier) *storagepb.ServerMessage {
+	return &storagepb.ServerMessage{
+		Id: id,
+		Content: &storagepb.ServerMessage_CancelRequest{
+			CancelRequest: &storagepb.CancelRequest{},
+		},
+	}
+}
+
 // HandleRequest processes incoming requests and directs them to the appropriate listener
-func (b *BucketListenerManager) HandleRequest(request *storagepb.ServerMessage) (*storagepb.ClientMessage, error) {
+func (b *BucketListenerManager) HandleRequest(ctx context.Context, request *storagepb.ServerMessage) (_ *storagepb.ClientMessage, err error) {
 	b.mutex.RLock()
 	defer b.mutex.RUnlock()
 
@@ -180,19 +217,34 @@ func (b *BucketListenerManager) HandleRequest(request *storagepb.ServerMessage)
 	eventType := blobEventRequest.GetBlobEvent().GetType()
 	key := blobEventRequest.GetBlobEvent().GetKey()
 
+	ctx, trigger := workers.StartTrigger(ctx, "bucket", bucketID, request.GetId(),
+		attribute.String("nitric.bucket", bucketID),
+		attribute.String("nitric.bucket.event_type", eventType.String()),
+	)
+	defer func() { trigger.End(err) }()
+
+	request.TraceContext = tracing.Inject(ctx)
+
 	listener, err := b.findMatchingListener(bucketID, eventType, key)
 	if err != nil {
 		return nil, err
 	}
 
-	response, err := listener.connection.Send(request)
+	ctx, cancel := workers.WithTriggerTimeout(ctx, b.timeout)
+	defer cancel()
+
+	response, err := listener.connection.Send(ctx, request)
+	if err != nil {
+		return nil, err
+	}
 
-	return *response, err
+	return *response, nil
 }
 
 func New() *BucketListenerManager {
 	return &BucketListenerManager{
 		listenerMap: make(map[BucketName][]*BucketEventListener),
 		mutex:       sync.RWMutex{},
+		timeout:     workers.TriggerTimeout(env.BUCKET_LISTENER_TIMEOUT),
 	}
 }
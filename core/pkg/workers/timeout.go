@@ -0,0 +1,43 @@
+// Copyright 2021 Nitric Technologies Pty Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package workers
+
+import (
+	"context"
+	"time"
+
+	"github.com/nitrictech/nitric/core/pkg/env"
+)
+
+// TriggerTimeout reads a trigger timeout in seconds from an environment variable, returning 0 (no timeout) if it's invalid
+func TriggerTimeout(timeoutEnv env.EnvironmentVariable) time.Duration {
+	seconds, err := timeoutEnv.Int()
+	if err != nil || seconds < 0 {
+		log.Warn("invalid trigger timeout, triggers will wait indefinitely for workers to respond", "timeout", timeoutEnv.String())
+		return 0
+	}
+
+	return time.Duration(seconds) * time.Second
+}
+
+// WithTriggerTimeout returns a copy of the context that is cancelled once the timeout elapses,
+// a timeout of 0 only inherits the deadline of the parent context.
+func WithTriggerTimeout(ctx context.Context, timeout time.Duration) (context.Context, context.CancelFunc) {
+	if timeout <= 0 {
+		return context.WithCancel(ctx)
+	}
+
+	return context.WithTimeout(ctx, timeout)
+}
@@ -0,0 +1,98 @@
+// Copyright 2021 Nitric Technologies Pty Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package topics
+
+import (
+	"strings"
+
+	topicspb "github.com/nitrictech/nitric/core/pkg/proto/topics/v1"
+	"github.com/nitrictech/nitric/core/pkg/tracing"
+)
+
+// ReservedAttributePrefix is the prefix of message attribute names reserved for use by nitric
+const ReservedAttributePrefix = "x-nitric-"
+
+const (
+	// OrderingKeyAttribute carries the ordering key of a message to its subscribers
+	OrderingKeyAttribute = ReservedAttributePrefix + "ordering-key"
+	// DeduplicationIdAttribute carries the deduplication id of a message to its subscribers
+	DeduplicationIdAttribute = ReservedAttributePrefix + "deduplication-id"
+)
+
+// MaxAttributes is the maximum number of attributes a message can be published with.
+// SNS allows 10 message attributes, the rest are left for the deduplication id and trace propagation attributes added by nitric.
+const MaxAttributes = 5
+
+// traceAttributes are the names of the attributes that propagate traces with messages, including the AWS X-Ray trace header
+var traceAttributes = append([]string{"X-Amzn-Trace-Id"}, tracing.Fields()...)
+
+// IsReservedAttribute returns true if the attribute name is reserved for use by nitric
+func IsReservedAttribute(name string) bool {
+	return strings.HasPrefix(strings.ToLower(name), ReservedAttributePrefix)
+}
+
+// IsTraceAttribute returns true if the attribute name is used to propagate traces with messages
+func IsTraceAttribute(name string) bool {
+	return isPropagationField(name, traceAttributes)
+}
+
+// PublishAttributes returns the attributes to publish a message with,
+// including the reserved attributes carrying its ordering key and deduplication id
+func PublishAttributes(req *topicspb.TopicPublishRequest) map[string]string {
+	attributes := make(map[string]string, len(req.GetAttributes())+2)
+
+	for name, value := range req.GetAttributes() {
+		attributes[name] = value
+	}
+
+	if req.GetOrderingKey() != "" {
+		attributes[OrderingKeyAttribute] = req.GetOrderingKey()
+	}
+
+	if req.GetDeduplicationId() != "" {
+		attributes[DeduplicationIdAttribute] = req.GetDeduplicationId()
+	}
+
+	return attributes
+}
+
+// SetMessageAttributes sets the attributes, ordering key and deduplication id of a message request from the attributes of the delivered message.
+// Reserved attributes and the given trace propagation fields aren't passed to subscribers as attributes.
+func SetMessageAttributes(request *topicspb.MessageRequest, attributes map[string]string, propagationFields ...string) {
+	request.Attributes = map[string]string{}
+
+	for name, value := range attributes {
+		switch {
+		case name == OrderingKeyAttribute:
+			request.OrderingKey = value
+		case name == DeduplicationIdAttribute:
+			request.DeduplicationId = value
+		case IsReservedAttribute(name) || isPropagationField(name, propagationFields):
+			continue
+		default:
+			request.Attributes[name] = value
+		}
+	}
+}
+
+func isPropagationField(name string, propagationFields []string) bool {
+	for _, field := range propagationFields {
+		if strings.EqualFold(name, field) {
+			return true
+		}
+	}
+
+	return false
+}
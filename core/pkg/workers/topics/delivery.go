@@ -0,0 +1,96 @@
+// Copyright 2021 Nitric Technologies Pty Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package topics
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// DefaultDeliveryTTL is how long the default delivery store remembers that a subscriber handled a message
+const DefaultDeliveryTTL = 24 * time.Hour
+
+// DeliveryStore records the subscribers that have successfully handled a message,
+// so that redelivered messages are only forwarded to the subscribers that haven't.
+type DeliveryStore interface {
+	// Acked returns true if the subscriber has already successfully handled the message
+	Acked(ctx context.Context, messageId string, subscriberId string) (bool, error)
+	// Ack records that the subscriber has successfully handled the message
+	Ack(ctx context.Context, messageId string, subscriberId string) error
+}
+
+type deliveryKey struct {
+	messageId    string
+	subscriberId string
+}
+
+// MemoryDeliveryStore is an in-memory DeliveryStore, deliveries are forgotten once their TTL expires.
+//
+// Deliveries are only known to the server that recorded them, so when several servers receive redeliveries of
+// the same message, subscribers may handle it again. Use WithDeliveryStore with a shared store in that case.
+type MemoryDeliveryStore struct {
+	ttl        time.Duration
+	deliveries map[deliveryKey]time.Time
+	lastPrune  time.Time
+	lock       sync.Mutex
+}
+
+var _ DeliveryStore = &MemoryDeliveryStore{}
+
+func (m *MemoryDeliveryStore) Acked(ctx context.Context, messageId string, subscriberId string) (bool, error) {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	expiry, ok := m.deliveries[deliveryKey{messageId: messageId, subscriberId: subscriberId}]
+
+	return ok && time.Now().Before(expiry), nil
+}
+
+func (m *MemoryDeliveryStore) Ack(ctx context.Context, messageId string, subscriberId string) error {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	now := time.Now()
+	m.deliveries[deliveryKey{messageId: messageId, subscriberId: subscriberId}] = now.Add(m.ttl)
+
+	m.prune(now)
+
+	return nil
+}
+
+// prune removes expired deliveries, at most once per TTL
+func (m *MemoryDeliveryStore) prune(now time.Time) {
+	if now.Sub(m.lastPrune) < m.ttl {
+		return
+	}
+
+	for key, expiry := range m.deliveries {
+		if !now.Before(expiry) {
+			delete(m.deliveries, key)
+		}
+	}
+
+	m.lastPrune = now
+}
+
+// NewMemoryDeliveryStore creates a new in-memory DeliveryStore that remembers deliveries for the given TTL
+func NewMemoryDeliveryStore(ttl time.Duration) *MemoryDeliveryStore {
+	return &MemoryDeliveryStore{
+		ttl:        ttl,
+		deliveries: make(map[deliveryKey]time.Time),
+		lastPrune:  time.Now(),
+	}
+}
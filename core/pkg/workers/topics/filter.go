@@ -0,0 +1,90 @@
+// Copyright 2021 Nitric Technologies Pty Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package topics
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	topicspb "github.com/nitrictech/nitric/core/pkg/proto/topics/v1"
+)
+
+// ValidateFilter returns an error if the subscription filter can't be applied, a nil filter is valid
+func ValidateFilter(filter *topicspb.SubscriptionFilter) error {
+	for _, attributeFilter := range filter.GetAttributes() {
+		name := attributeFilter.GetName()
+		if name == "" {
+			return fmt.Errorf("attribute filters must have an attribute name")
+		}
+
+		if IsReservedAttribute(name) {
+			return fmt.Errorf("attribute %s can't be filtered, attribute names starting with %s are reserved", name, ReservedAttributePrefix)
+		}
+
+		if IsTraceAttribute(name) {
+			return fmt.Errorf("attribute %s can't be filtered, it's reserved for trace propagation", name)
+		}
+
+		switch condition := attributeFilter.GetCondition().(type) {
+		case *topicspb.AttributeFilter_AnyOf:
+			if len(condition.AnyOf.GetValues()) == 0 {
+				return fmt.Errorf("any_of filter for attribute %s must have at least one value", name)
+			}
+		case *topicspb.AttributeFilter_NoneOf:
+			if len(condition.NoneOf.GetValues()) == 0 {
+				return fmt.Errorf("none_of filter for attribute %s must have at least one value", name)
+			}
+		case *topicspb.AttributeFilter_Prefix:
+			if condition.Prefix == "" {
+				return fmt.Errorf("prefix filter for attribute %s must have a prefix", name)
+			}
+		case *topicspb.AttributeFilter_Exists:
+		default:
+			return fmt.Errorf("filter for attribute %s must have a condition", name)
+		}
+	}
+
+	return nil
+}
+
+// FilterMatches returns true if a message with the given attributes matches the subscription filter, every message matches a nil filter.
+// Values are compared case-sensitively, provider filters that ignore case only narrow the messages delivered to the runtime.
+func FilterMatches(filter *topicspb.SubscriptionFilter, attributes map[string]string) bool {
+	for _, attributeFilter := range filter.GetAttributes() {
+		value, present := attributes[attributeFilter.GetName()]
+
+		switch condition := attributeFilter.GetCondition().(type) {
+		case *topicspb.AttributeFilter_AnyOf:
+			if !present || !slices.Contains(condition.AnyOf.GetValues(), value) {
+				return false
+			}
+		case *topicspb.AttributeFilter_NoneOf:
+			if !present || slices.Contains(condition.NoneOf.GetValues(), value) {
+				return false
+			}
+		case *topicspb.AttributeFilter_Prefix:
+			if !present || !strings.HasPrefix(value, condition.Prefix) {
+				return false
+			}
+		case *topicspb.AttributeFilter_Exists:
+			if present != condition.Exists {
+				return false
+			}
+		}
+	}
+
+	return true
+}
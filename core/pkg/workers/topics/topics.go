@@ -16,52 +16,107 @@ package topics
 
 import (
 	"context"
+	"crypto/sha256"
+	"encoding/hex"
 	"fmt"
 	"slices"
 	"sync"
+	"sync/atomic"
+	"time"
 
+	"github.com/nitrictech/nitric/core/pkg/env"
 	"github.com/nitrictech/nitric/core/pkg/help"
+	"github.com/nitrictech/nitric/core/pkg/logger"
 	topicspb "github.com/nitrictech/nitric/core/pkg/proto/topics/v1"
+	"github.com/nitrictech/nitric/core/pkg/tracing"
 	"github.com/nitrictech/nitric/core/pkg/workers"
+	"go.opentelemetry.io/otel/attribute"
 	"golang.org/x/sync/errgroup"
+	"google.golang.org/protobuf/proto"
 )
 
+var log = logger.Component("workers")
+
 type TopicName = string
 
 type WorkerConnection = workers.WorkerRequestBroker[*topicspb.ServerMessage, *topicspb.ClientMessage]
 
 type SubscriptionRequestHandler interface {
 	topicspb.SubscriberServer
-	HandleRequest(request *topicspb.ServerMessage) (*topicspb.ClientMessage, error)
+	HandleRequest(ctx context.Context, request *topicspb.ServerMessage) (*topicspb.ClientMessage, error)
 	WorkerCount() int
+	Workers() []workers.WorkerInfo
+}
+
+// subscriber is a worker subscribed to a topic, its id identifies the subscriber when recording message deliveries
+//
+//	the id is derived from the registration, so it's the same when the subscriber reconnects to this or another server.
+type subscriber struct {
+	id         string
+	filter     *topicspb.SubscriptionFilter
+	connection *WorkerConnection
 }
 
 type SubscriberManager struct {
-	subscriberMap map[TopicName][]*WorkerConnection
+	subscriberMap map[TopicName][]*subscriber
+	deliveries    DeliveryStore
 	lock          sync.RWMutex
+	timeout       time.Duration
 }
 
-func (s *SubscriberManager) registerSubscriber(subscriber *WorkerConnection, registrationRequest *topicspb.RegistrationRequest) error {
+// registrationId returns the id of subscribers with the given registration, subscribers with an equivalent registration share an id
+func registrationId(registrationRequest *topicspb.RegistrationRequest) (string, error) {
+	if name := registrationRequest.GetSubscriberName(); name != "" {
+		return registrationRequest.GetTopicName() + "/" + name, nil
+	}
+
+	if registrationRequest.GetFilter() == nil {
+		return registrationRequest.GetTopicName(), nil
+	}
+
+	filter, err := proto.MarshalOptions{Deterministic: true}.Marshal(registrationRequest.GetFilter())
+	if err != nil {
+		return "", err
+	}
+
+	hash := sha256.Sum256(filter)
+
+	return registrationRequest.GetTopicName() + "/filter-" + hex.EncodeToString(hash[:8]), nil
+}
+
+func (s *SubscriberManager) registerSubscriber(sub *subscriber, registrationRequest *topicspb.RegistrationRequest) error {
 	s.lock.Lock()
 	defer s.lock.Unlock()
 
 	topicName := registrationRequest.GetTopicName()
 
+	id, err := registrationId(registrationRequest)
+	if err != nil {
+		return err
+	}
+
+	// subscribers with the same registration are numbered in the order they're registered,
+	// taking the lowest number that isn't in use so a subscriber that reconnects gets its previous id
+	sub.id = id
+	for n := 1; slices.ContainsFunc(s.subscriberMap[topicName], func(existing *subscriber) bool { return existing.id == sub.id }); n++ {
+		sub.id = fmt.Sprintf("%s#%d", id, n)
+	}
+
 	if _, exists := s.subscriberMap[topicName]; !exists {
-		s.subscriberMap[topicName] = make([]*WorkerConnection, 0, 1)
+		s.subscriberMap[topicName] = make([]*subscriber, 0, 1)
 	}
 
-	s.subscriberMap[topicName] = append(s.subscriberMap[topicName], subscriber)
+	s.subscriberMap[topicName] = append(s.subscriberMap[topicName], sub)
 
 	return nil
 }
 
-func (s *SubscriberManager) unregisterSubscriber(topicName string, subscriber *WorkerConnection) {
+func (s *SubscriberManager) unregisterSubscriber(topicName string, sub *subscriber) {
 	s.lock.Lock()
 	defer s.lock.Unlock()
 
-	s.subscriberMap[topicName] = slices.DeleteFunc[[]*WorkerConnection](s.subscriberMap[topicName], func(wc *WorkerConnection) bool {
-		return wc == subscriber
+	s.subscriberMap[topicName] = slices.DeleteFunc[[]*subscriber](s.subscriberMap[topicName], func(existing *subscriber) bool {
+		return existing == sub
 	})
 
 	if len(s.subscriberMap[topicName]) == 0 {
@@ -81,6 +136,29 @@ func (s *SubscriberManager) WorkerCount() int {
 	return count
 }
 
+// Workers returns the subscribers registered for each topic
+func (s *SubscriberManager) Workers() []workers.WorkerInfo {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+
+	info := []workers.WorkerInfo{}
+	for topicName, subscribers := range s.subscriberMap {
+		inFlight := 0
+		for _, sub := range subscribers {
+			inFlight += sub.connection.InFlight()
+		}
+
+		info = append(info, workers.WorkerInfo{
+			Kind:     "subscription",
+			Name:     topicName,
+			Workers:  len(subscribers),
+			InFlight: inFlight,
+		})
+	}
+
+	return workers.SortWorkerInfo(info)
+}
+
 // Subscribe allows the local nitric server to register new subscribers
 //
 //	called by Nitric applications wishing to subscribe to a topic.
@@ -97,12 +175,19 @@ func (s *SubscriberManager) Subscribe(subscriberConnectionStream topicspb.Subscr
 		return fmt.Errorf("request received from unregistered subscriber, initial request must be a registration request. %s", help.BugInNitricHelpText())
 	}
 
-	subscriber := workers.NewWorkerRequestBroker[*topicspb.ServerMessage, *topicspb.ClientMessage](subscriberConnectionStream)
-	if err := s.registerSubscriber(subscriber, registrationRequest); err != nil {
+	if err := ValidateFilter(registrationRequest.GetFilter()); err != nil {
+		return fmt.Errorf("invalid subscription filter for topic %s: %w", registrationRequest.GetTopicName(), err)
+	}
+
+	sub := &subscriber{
+		filter:     registrationRequest.GetFilter(),
+		connection: workers.NewWorkerRequestBroker[*topicspb.ServerMessage, *topicspb.ClientMessage](subscriberConnectionStream, newCancelMessage),
+	}
+	if err := s.registerSubscriber(sub, registrationRequest); err != nil {
 		return err
 	}
 
-	defer s.unregisterSubscriber(registrationRequest.GetTopicName(), subscriber)
+	defer s.unregisterSubscriber(registrationRequest.GetTopicName(), sub)
 
 	// send acknowledgement of registration
 	err = subscriberConnectionStream.Send(&topicspb.ServerMessage{
@@ -114,7 +199,7 @@ func (s *SubscriberManager) Subscribe(subscriberConnectionStream topicspb.Subscr
 		return err
 	}
 
-	err = subscriber.Run()
+	err = sub.connection.Run()
 	if err != nil {
 		return fmt.Errorf("subscriber connection broker encountered and error: %w", err)
 	}
@@ -123,31 +208,109 @@ func (s *SubscriberManager) Subscribe(subscriberConnectionStream topicspb.Subscr
 }
 
 // findMatchingSubscriber returns the subscribers for a given topic, or an error if none are found
-func (s *SubscriberManager) findMatchingSubscriber(topicName string) ([]*WorkerConnection, error) {
+func (s *SubscriberManager) findMatchingSubscriber(topicName string) ([]*subscriber, error) {
 	s.lock.RLock()
 	defer s.lock.RUnlock()
-	workers, ok := s.subscriberMap[topicName]
-	if !ok || len(workers) == 0 {
+	subscribers, ok := s.subscriberMap[topicName]
+	if !ok || len(subscribers) == 0 {
 		return nil, fmt.Errorf("no workers registered for topic subscription: %s", topicName)
 	}
 
-	return workers, nil
+	return subscribers, nil
+}
+
+// filterSubscribers returns the subscribers with filters matching the message attributes
+func filterSubscribers(subscribers []*subscriber, attributes map[string]string) []*subscriber {
+	return slices.DeleteFunc(slices.Clone(subscribers), func(sub *subscriber) bool {
+		return !FilterMatches(sub.filter, attributes)
+	})
+}
+
+// deliveryMessageId returns the id used to record deliveries of a message, or an empty string if the message has no id.
+// Messages published with a deduplication id are identified by it, so duplicates are only handled once by each subscriber.
+func deliveryMessageId(messageRequest *topicspb.MessageRequest) string {
+	id := messageRequest.GetDeduplicationId()
+	if id == "" {
+		id = messageRequest.GetMessageId()
+	}
+
+	if id == "" {
+		return ""
+	}
+
+	return messageRequest.GetTopicName() + "/" + id
+}
+
+// forwardRequestToSubscribers forwards an event to the subscribers for a given topic that haven't already successfully handled it
+// returns true if every subscriber has successfully handled the event
+func (s *SubscriberManager) forwardRequestToSubscribers(ctx context.Context, subscribers []*subscriber, request *topicspb.ServerMessage) (bool, error) {
+	messageId := deliveryMessageId(request.GetMessageRequest())
+
+	success := atomic.Bool{}
+	success.Store(true)
+
+	errs, _ := errgroup.WithContext(ctx)
+
+	for _, sub := range subscribers {
+		footLongSub := sub
+		errs.Go(func() error {
+			if messageId != "" {
+				acked, err := s.deliveries.Acked(ctx, messageId, footLongSub.id)
+				if err != nil {
+					// deliver the message again rather than risk it never being handled
+					log.ErrorContext(ctx, "error checking delivery of message to subscriber", "message_id", messageId, "subscriber", footLongSub.id, "error", err)
+				} else if acked {
+					return nil
+				}
+			}
+
+			resp, err := footLongSub.connection.Send(ctx, request)
+			if err != nil {
+				return err
+			}
+
+			if !(*resp).GetMessageResponse().GetSuccess() {
+				success.Store(false)
+				return nil
+			}
+
+			if messageId != "" {
+				if err := s.deliveries.Ack(ctx, messageId, footLongSub.id); err != nil {
+					log.ErrorContext(ctx, "error recording delivery of message to subscriber", "message_id", messageId, "subscriber", footLongSub.id, "error", err)
+				}
+			}
+
+			return nil
+		})
+	}
+
+	err := errs.Wait()
+	if err != nil {
+		return false, fmt.Errorf("errors occurred handling subscription: %w", err)
+	}
+
+	return success.Load(), nil
 }
 
 // ForwardRequestToSubscribers forwards an event to all subscribers for a given topic
 // returns a slice of errors encountered while forwarding the event
+//
+// Deprecated: SubscriberManager.HandleRequest only redelivers messages to the subscribers that haven't handled them,
+// this sends the event to every subscriber without recording deliveries.
 func ForwardRequestToSubscribers(subscribers []*WorkerConnection, request *topicspb.ServerMessage) (bool, error) {
-	success := true
+	success := atomic.Bool{}
+	success.Store(true)
+
 	errs, _ := errgroup.WithContext(context.Background())
 
 	for _, subscriber := range subscribers {
 		footLongSub := subscriber
 		errs.Go(func() error {
-			resp, err := footLongSub.Send(request)
+			resp, err := footLongSub.Send(context.Background(), request)
 			if err != nil {
 				return err
 			} else if !(*resp).GetMessageResponse().GetSuccess() {
-				success = false
+				success.Store(false)
 			}
 			return nil
 		})
@@ -155,13 +318,22 @@ func ForwardRequestToSubscribers(subscribers []*WorkerConnection, request *topic
 
 	err := errs.Wait()
 	if err != nil {
-		return false, fmt.Errorf("errors occurred handling subscription %v", errs)
+		return false, fmt.Errorf("errors occurred handling subscription: %w", err)
 	}
 
-	return success, nil
+	return success.Load(), nil
 }
 
-func (s *SubscriberManager) HandleRequest(request *topicspb.ServerMessage) (*topicspb.ClientMessage, error) {
+func newCancelMessage(id workers.RequestIdentifier) *topicspb.ServerMessage {
+	return &topicspb.ServerMessage{
+		Id: id,
+		Content: &topicspb.ServerMessage_CancelRequest{
+			CancelRequest: &topicspb.CancelRequest{},
+		},
+	}
+}
+
+func (s *SubscriberManager) HandleRequest(ctx context.Context, request *topicspb.ServerMessage) (_ *topicspb.ClientMessage, err error) {
 	if request.Id == "" {
 		request.Id = workers.GenerateUniqueId()
 	}
@@ -172,12 +344,23 @@ func (s *SubscriberManager) HandleRequest(request *topicspb.ServerMessage) (*top
 	}
 	topicName := messageRequest.GetTopicName()
 
+	ctx, trigger := workers.StartTrigger(ctx, "topic", topicName, request.GetId(), attribute.String("nitric.topic", topicName))
+	defer func() { trigger.End(err) }()
+
+	request.TraceContext = tracing.Inject(ctx)
+
 	subscribers, err := s.findMatchingSubscriber(topicName)
 	if err != nil {
 		return nil, err
 	}
 
-	success, err := ForwardRequestToSubscribers(subscribers, request)
+	// messages that don't match any subscription filter are successfully handled without being delivered
+	subscribers = filterSubscribers(subscribers, messageRequest.GetAttributes())
+
+	ctx, cancel := workers.WithTriggerTimeout(ctx, s.timeout)
+	defer cancel()
+
+	success, err := s.forwardRequestToSubscribers(ctx, subscribers, request)
 	if err != nil {
 		return nil, err
 	}
@@ -187,8 +370,8 @@ func (s *SubscriberManager) HandleRequest(request *topicspb.ServerMessage) (*top
 	// This makes topics are a unique case where the worker's response isn't directly returned.
 	// instead we return a response indicating success or failure of all subscribers.
 	//
-	// Since subscription workers should always be idempotent, this ensures a failure in any subscriber
-	// will trigger a retry of the event processing.
+	// A failure in any subscriber will trigger a retry of the event processing,
+	// messages with an id are only redelivered to the subscribers that haven't already successfully handled them.
 	return &topicspb.ClientMessage{
 		Content: &topicspb.ClientMessage_MessageResponse{
 			MessageResponse: &topicspb.MessageResponse{
@@ -198,9 +381,27 @@ func (s *SubscriberManager) HandleRequest(request *topicspb.ServerMessage) (*top
 	}, nil
 }
 
-func New() *SubscriberManager {
-	return &SubscriberManager{
-		subscriberMap: make(map[string][]*WorkerConnection),
+type SubscriberManagerOption func(*SubscriberManager)
+
+// WithDeliveryStore sets the store used to record the subscribers that have successfully handled each message,
+// defaults to a MemoryDeliveryStore
+func WithDeliveryStore(store DeliveryStore) SubscriberManagerOption {
+	return func(s *SubscriberManager) {
+		s.deliveries = store
+	}
+}
+
+func New(opts ...SubscriberManagerOption) *SubscriberManager {
+	manager := &SubscriberManager{
+		subscriberMap: make(map[string][]*subscriber),
+		deliveries:    NewMemoryDeliveryStore(DefaultDeliveryTTL),
 		lock:          sync.RWMutex{},
+		timeout:       workers.TriggerTimeout(env.SUBSCRIPTION_TIMEOUT),
 	}
+
+	for _, opt := range opts {
+		opt(manager)
+	}
+
+	return manager
 }
@@ -0,0 +1,493 @@
+// Copyright 2021 Nitric Technologies Pty Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package topics_test
+
+import (
+	"context"
+	"io"
+	"sync"
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"google.golang.org/grpc"
+
+	topicspb "github.com/nitrictech/nitric/core/pkg/proto/topics/v1"
+	"github.com/nitrictech/nitric/core/pkg/tracing"
+	"github.com/nitrictech/nitric/core/pkg/workers/topics"
+)
+
+// mockSubscriber is an in memory Subscriber_SubscribeServer stream, standing in for an SDK subscribed to a topic
+type mockSubscriber struct {
+	grpc.ServerStream
+
+	toServer chan *topicspb.ClientMessage
+	ready    chan struct{}
+	once     sync.Once
+	recvs    int
+
+	lock     sync.Mutex
+	fail     bool
+	requests []*topicspb.MessageRequest
+}
+
+func newMockSubscriber(topicName string) *mockSubscriber {
+	return newFilteredSubscriber(topicName, nil)
+}
+
+// newFilteredSubscriber creates a subscriber that only receives messages matching the filter
+func newFilteredSubscriber(topicName string, filter *topicspb.SubscriptionFilter) *mockSubscriber {
+	return newMockSubscriberWithRegistration(&topicspb.RegistrationRequest{
+		TopicName: topicName,
+		Filter:    filter,
+	})
+}
+
+// newNamedSubscriber creates a subscriber identified by its name
+func newNamedSubscriber(topicName string, name string) *mockSubscriber {
+	return newMockSubscriberWithRegistration(&topicspb.RegistrationRequest{
+		TopicName:      topicName,
+		SubscriberName: name,
+	})
+}
+
+func newMockSubscriberWithRegistration(registration *topicspb.RegistrationRequest) *mockSubscriber {
+	s := &mockSubscriber{
+		toServer: make(chan *topicspb.ClientMessage, 10),
+		ready:    make(chan struct{}),
+	}
+
+	s.toServer <- &topicspb.ClientMessage{
+		Content: &topicspb.ClientMessage_RegistrationRequest{
+			RegistrationRequest: registration,
+		},
+	}
+
+	return s
+}
+
+func (s *mockSubscriber) Send(msg *topicspb.ServerMessage) error {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	if msg.GetMessageRequest() == nil {
+		return nil
+	}
+
+	s.requests = append(s.requests, msg.GetMessageRequest())
+
+	s.toServer <- &topicspb.ClientMessage{
+		Id: msg.GetId(),
+		Content: &topicspb.ClientMessage_MessageResponse{
+			MessageResponse: &topicspb.MessageResponse{
+				Success: !s.fail,
+			},
+		},
+	}
+
+	return nil
+}
+
+func (s *mockSubscriber) Recv() (*topicspb.ClientMessage, error) {
+	// the second receive is made by the request broker, after which it's ready to send requests
+	s.recvs++
+	if s.recvs > 1 {
+		s.once.Do(func() { close(s.ready) })
+	}
+
+	msg, ok := <-s.toServer
+	if !ok {
+		return nil, io.EOF
+	}
+
+	return msg, nil
+}
+
+func (s *mockSubscriber) setFail(fail bool) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	s.fail = fail
+}
+
+func (s *mockSubscriber) requestCount() int {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	return len(s.requests)
+}
+
+func (s *mockSubscriber) close() {
+	close(s.toServer)
+}
+
+func subscribe(manager *topics.SubscriberManager, subscribers ...*mockSubscriber) {
+	for _, s := range subscribers {
+		go func(s *mockSubscriber) {
+			_ = manager.Subscribe(s)
+		}(s)
+
+		Eventually(s.ready).Should(BeClosed())
+	}
+}
+
+func newMessageRequest(topicName string, messageId string) *topicspb.ServerMessage {
+	return &topicspb.ServerMessage{
+		Content: &topicspb.ServerMessage_MessageRequest{
+			MessageRequest: &topicspb.MessageRequest{
+				TopicName: topicName,
+				MessageId: messageId,
+				Message:   &topicspb.TopicMessage{},
+			},
+		},
+	}
+}
+
+// countingDeliveryStore wraps the default delivery store, counting the deliveries recorded
+type countingDeliveryStore struct {
+	*topics.MemoryDeliveryStore
+
+	lock sync.Mutex
+	acks int
+}
+
+func (c *countingDeliveryStore) Ack(ctx context.Context, messageId string, subscriberId string) error {
+	c.lock.Lock()
+	c.acks++
+	c.lock.Unlock()
+
+	return c.MemoryDeliveryStore.Ack(ctx, messageId, subscriberId)
+}
+
+func (c *countingDeliveryStore) ackCount() int {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	return c.acks
+}
+
+var _ = Describe("SubscriberManager", func() {
+	var manager *topics.SubscriberManager
+	var store *countingDeliveryStore
+	var email, analytics *mockSubscriber
+
+	BeforeEach(func() {
+		store = &countingDeliveryStore{MemoryDeliveryStore: topics.NewMemoryDeliveryStore(topics.DefaultDeliveryTTL)}
+		manager = topics.New(topics.WithDeliveryStore(store))
+
+		email = newMockSubscriber("updates")
+		analytics = newMockSubscriber("updates")
+		subscribe(manager, email, analytics)
+	})
+
+	AfterEach(func() {
+		email.close()
+		analytics.close()
+	})
+
+	When("a subscriber fails to handle a message", func() {
+		BeforeEach(func() {
+			analytics.setFail(true)
+		})
+
+		It("should report the failure so the message is redelivered", func() {
+			resp, err := manager.HandleRequest(context.TODO(), newMessageRequest("updates", "message-1"))
+			Expect(err).ToNot(HaveOccurred())
+			Expect(resp.GetMessageResponse().GetSuccess()).To(BeFalse())
+
+			By("recording the delivery to the successful subscriber")
+			Expect(store.ackCount()).To(Equal(1))
+		})
+
+		It("should only forward the redelivered message to the failed subscriber", func() {
+			_, err := manager.HandleRequest(context.TODO(), newMessageRequest("updates", "message-1"))
+			Expect(err).ToNot(HaveOccurred())
+
+			analytics.setFail(false)
+
+			resp, err := manager.HandleRequest(context.TODO(), newMessageRequest("updates", "message-1"))
+			Expect(err).ToNot(HaveOccurred())
+			Expect(resp.GetMessageResponse().GetSuccess()).To(BeTrue())
+
+			Expect(email.requestCount()).To(Equal(1))
+			Expect(analytics.requestCount()).To(Equal(2))
+		})
+
+		It("should not redeliver the message to a subscriber that reconnects after handling it", func() {
+			_, err := manager.HandleRequest(context.TODO(), newMessageRequest("updates", "message-1"))
+			Expect(err).ToNot(HaveOccurred())
+
+			By("the successful subscriber reconnecting")
+			email.close()
+			Eventually(manager.WorkerCount).Should(Equal(1))
+			email = newMockSubscriber("updates")
+			subscribe(manager, email)
+
+			analytics.setFail(false)
+
+			resp, err := manager.HandleRequest(context.TODO(), newMessageRequest("updates", "message-1"))
+			Expect(err).ToNot(HaveOccurred())
+			Expect(resp.GetMessageResponse().GetSuccess()).To(BeTrue())
+
+			Expect(email.requestCount()).To(Equal(0))
+			Expect(analytics.requestCount()).To(Equal(2))
+		})
+
+		It("should not redeliver the message to a subscriber that handled it on another server", func() {
+			_, err := manager.HandleRequest(context.TODO(), newMessageRequest("updates", "message-1"))
+			Expect(err).ToNot(HaveOccurred())
+
+			By("the subscribers registering with another server sharing the delivery store")
+			otherManager := topics.New(topics.WithDeliveryStore(store))
+			otherEmail := newMockSubscriber("updates")
+			otherAnalytics := newMockSubscriber("updates")
+			defer otherEmail.close()
+			defer otherAnalytics.close()
+			subscribe(otherManager, otherEmail, otherAnalytics)
+
+			resp, err := otherManager.HandleRequest(context.TODO(), newMessageRequest("updates", "message-1"))
+			Expect(err).ToNot(HaveOccurred())
+			Expect(resp.GetMessageResponse().GetSuccess()).To(BeTrue())
+
+			Expect(otherEmail.requestCount()).To(Equal(0))
+			Expect(otherAnalytics.requestCount()).To(Equal(1))
+		})
+
+		It("should forward other messages to every subscriber", func() {
+			_, err := manager.HandleRequest(context.TODO(), newMessageRequest("updates", "message-1"))
+			Expect(err).ToNot(HaveOccurred())
+
+			_, err = manager.HandleRequest(context.TODO(), newMessageRequest("updates", "message-2"))
+			Expect(err).ToNot(HaveOccurred())
+
+			Expect(email.requestCount()).To(Equal(2))
+			Expect(analytics.requestCount()).To(Equal(2))
+		})
+	})
+
+	When("subscribers are named", func() {
+		It("should identify the subscribers by name regardless of their registration order", func() {
+			billing := newNamedSubscriber("orders", "billing")
+			shipping := newNamedSubscriber("orders", "shipping")
+			defer func() {
+				billing.close()
+				shipping.close()
+			}()
+			subscribe(manager, billing, shipping)
+
+			shipping.setFail(true)
+			_, err := manager.HandleRequest(context.TODO(), newMessageRequest("orders", "message-1"))
+			Expect(err).ToNot(HaveOccurred())
+
+			By("the subscribers reconnecting in a different order")
+			billing.close()
+			shipping.close()
+			Eventually(manager.WorkerCount).Should(Equal(2))
+			shipping = newNamedSubscriber("orders", "shipping")
+			billing = newNamedSubscriber("orders", "billing")
+			subscribe(manager, shipping, billing)
+
+			resp, err := manager.HandleRequest(context.TODO(), newMessageRequest("orders", "message-1"))
+			Expect(err).ToNot(HaveOccurred())
+			Expect(resp.GetMessageResponse().GetSuccess()).To(BeTrue())
+
+			Expect(billing.requestCount()).To(Equal(0))
+			Expect(shipping.requestCount()).To(Equal(1))
+		})
+	})
+
+	When("messages are published with the same deduplication id", func() {
+		It("should only forward the first message to each subscriber", func() {
+			for _, messageId := range []string{"message-1", "message-2"} {
+				request := newMessageRequest("updates", messageId)
+				request.GetMessageRequest().DeduplicationId = "order-1-created"
+
+				resp, err := manager.HandleRequest(context.TODO(), request)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(resp.GetMessageResponse().GetSuccess()).To(BeTrue())
+			}
+
+			Expect(email.requestCount()).To(Equal(1))
+			Expect(analytics.requestCount()).To(Equal(1))
+		})
+	})
+
+	When("a subscriber has a filter", func() {
+		var created *mockSubscriber
+
+		BeforeEach(func() {
+			created = newFilteredSubscriber("updates", &topicspb.SubscriptionFilter{
+				Attributes: []*topicspb.AttributeFilter{{
+					Name: "kind",
+					Condition: &topicspb.AttributeFilter_AnyOf{
+						AnyOf: &topicspb.AttributeValues{Values: []string{"created"}},
+					},
+				}},
+			})
+			subscribe(manager, created)
+		})
+
+		AfterEach(func() {
+			created.close()
+		})
+
+		It("should only forward matching messages to the subscriber", func() {
+			for _, kind := range []string{"created", "deleted"} {
+				request := newMessageRequest("updates", "message-"+kind)
+				request.GetMessageRequest().Attributes = map[string]string{"kind": kind}
+
+				resp, err := manager.HandleRequest(context.TODO(), request)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(resp.GetMessageResponse().GetSuccess()).To(BeTrue())
+			}
+
+			Expect(created.requestCount()).To(Equal(1))
+			Expect(email.requestCount()).To(Equal(2))
+		})
+	})
+
+	When("a subscriber registers an invalid filter", func() {
+		It("should reject the subscription", func() {
+			invalid := newFilteredSubscriber("updates", &topicspb.SubscriptionFilter{
+				Attributes: []*topicspb.AttributeFilter{{Name: "kind"}},
+			})
+
+			err := manager.Subscribe(invalid)
+			Expect(err).To(MatchError(ContainSubstring("must have a condition")))
+		})
+	})
+
+	When("a message has no id", func() {
+		It("should forward every delivery to every subscriber", func() {
+			for i := 0; i < 2; i++ {
+				resp, err := manager.HandleRequest(context.TODO(), newMessageRequest("updates", ""))
+				Expect(err).ToNot(HaveOccurred())
+				Expect(resp.GetMessageResponse().GetSuccess()).To(BeTrue())
+			}
+
+			Expect(email.requestCount()).To(Equal(2))
+			Expect(analytics.requestCount()).To(Equal(2))
+			Expect(store.ackCount()).To(Equal(0))
+		})
+	})
+
+	When("the message was published in a trace", func() {
+		It("should propagate the trace to the subscribers", func() {
+			traceparent := "00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01"
+			ctx := tracing.Extract(context.TODO(), map[string]string{"traceparent": traceparent})
+
+			request := newMessageRequest("updates", "message-1")
+			_, err := manager.HandleRequest(ctx, request)
+			Expect(err).ToNot(HaveOccurred())
+
+			Expect(request.TraceContext["traceparent"]).To(ContainSubstring("4bf92f3577b34da6a3ce929d0e0e4736"))
+		})
+	})
+})
+
+var _ = Describe("MemoryDeliveryStore", func() {
+	It("should forget deliveries once their TTL expires", func() {
+		store := topics.NewMemoryDeliveryStore(50 * time.Millisecond)
+
+		Expect(store.Ack(context.TODO(), "message-1", "subscriber-1")).To(Succeed())
+
+		acked, err := store.Acked(context.TODO(), "message-1", "subscriber-1")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(acked).To(BeTrue())
+
+		acked, err = store.Acked(context.TODO(), "message-1", "subscriber-2")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(acked).To(BeFalse())
+
+		Eventually(func() bool {
+			acked, _ := store.Acked(context.TODO(), "message-1", "subscriber-1")
+			return acked
+		}).Should(BeFalse())
+	})
+})
+
+var _ = Describe("FilterMatches", func() {
+	filter := func(attributeFilters ...*topicspb.AttributeFilter) *topicspb.SubscriptionFilter {
+		return &topicspb.SubscriptionFilter{Attributes: attributeFilters}
+	}
+
+	anyOf := &topicspb.AttributeFilter{
+		Name:      "kind",
+		Condition: &topicspb.AttributeFilter_AnyOf{AnyOf: &topicspb.AttributeValues{Values: []string{"created", "updated"}}},
+	}
+	noneOf := &topicspb.AttributeFilter{
+		Name:      "kind",
+		Condition: &topicspb.AttributeFilter_NoneOf{NoneOf: &topicspb.AttributeValues{Values: []string{"deleted"}}},
+	}
+	prefix := &topicspb.AttributeFilter{
+		Name:      "region",
+		Condition: &topicspb.AttributeFilter_Prefix{Prefix: "eu-"},
+	}
+	absent := &topicspb.AttributeFilter{
+		Name:      "test",
+		Condition: &topicspb.AttributeFilter_Exists{Exists: false},
+	}
+
+	When("there is no filter", func() {
+		It("should match every message", func() {
+			Expect(topics.FilterMatches(nil, map[string]string{})).To(BeTrue())
+		})
+	})
+
+	When("filtering on any of a set of values", func() {
+		It("should only match messages with one of the values", func() {
+			Expect(topics.FilterMatches(filter(anyOf), map[string]string{"kind": "updated"})).To(BeTrue())
+			Expect(topics.FilterMatches(filter(anyOf), map[string]string{"kind": "deleted"})).To(BeFalse())
+			Expect(topics.FilterMatches(filter(anyOf), map[string]string{})).To(BeFalse())
+		})
+	})
+
+	When("filtering on none of a set of values", func() {
+		It("should only match messages with the attribute and none of the values", func() {
+			Expect(topics.FilterMatches(filter(noneOf), map[string]string{"kind": "created"})).To(BeTrue())
+			Expect(topics.FilterMatches(filter(noneOf), map[string]string{"kind": "deleted"})).To(BeFalse())
+			Expect(topics.FilterMatches(filter(noneOf), map[string]string{})).To(BeFalse())
+		})
+	})
+
+	When("filtering on a prefix", func() {
+		It("should only match messages with the prefix", func() {
+			Expect(topics.FilterMatches(filter(prefix), map[string]string{"region": "eu-west-1"})).To(BeTrue())
+			Expect(topics.FilterMatches(filter(prefix), map[string]string{"region": "us-east-1"})).To(BeFalse())
+		})
+	})
+
+	When("filtering on the absence of an attribute", func() {
+		It("should only match messages without the attribute", func() {
+			Expect(topics.FilterMatches(filter(absent), map[string]string{})).To(BeTrue())
+			Expect(topics.FilterMatches(filter(absent), map[string]string{"test": "true"})).To(BeFalse())
+		})
+	})
+
+	When("filtering on several attributes", func() {
+		It("should only match messages matching every attribute filter", func() {
+			Expect(topics.FilterMatches(filter(anyOf, prefix, absent), map[string]string{"kind": "created", "region": "eu-west-1"})).To(BeTrue())
+			Expect(topics.FilterMatches(filter(anyOf, prefix), map[string]string{"kind": "created", "region": "us-east-1"})).To(BeFalse())
+		})
+	})
+
+	When("attribute values differ in case", func() {
+		It("should compare values case-sensitively", func() {
+			Expect(topics.FilterMatches(filter(anyOf), map[string]string{"kind": "Updated"})).To(BeFalse())
+			Expect(topics.FilterMatches(filter(noneOf), map[string]string{"kind": "Deleted"})).To(BeTrue())
+			Expect(topics.FilterMatches(filter(prefix), map[string]string{"region": "EU-west-1"})).To(BeFalse())
+		})
+	})
+})
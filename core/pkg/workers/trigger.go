@@ -0,0 +1,97 @@
+// Copyright 2021 Nitric Technologies Pty Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package workers
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"time"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/nitrictech/nitric/core/pkg/logger"
+	"github.com/nitrictech/nitric/core/pkg/metrics"
+	"github.com/nitrictech/nitric/core/pkg/tracing"
+)
+
+// Trigger instruments the dispatch of a trigger to workers, with a span and metrics
+type Trigger struct {
+	ctx        context.Context
+	kind       string
+	name       string
+	span       trace.Span
+	start      time.Time
+	attributes []attribute.KeyValue
+	httpStatus int
+}
+
+// StartTrigger starts instrumenting a trigger, e.g. an API request or topic message,
+// the returned context carries the trigger's span so it can be propagated to workers,
+// and the request id and trigger fields so records logged with it can be correlated with the request.
+func StartTrigger(ctx context.Context, kind string, name string, requestId RequestIdentifier, attributes ...attribute.KeyValue) (context.Context, *Trigger) {
+	ctx, span := tracing.StartTriggerSpan(ctx, kind+" "+name, attributes...)
+
+	ctx = logger.WithFields(ctx,
+		slog.String(logger.RequestIdKey, requestId),
+		slog.String(logger.TriggerKey, kind),
+		slog.String(logger.TriggerNameKey, name),
+	)
+
+	return ctx, &Trigger{
+		ctx:   ctx,
+		kind:  kind,
+		name:  name,
+		span:  span,
+		start: time.Now(),
+	}
+}
+
+// SetHttpRoute records the route template matched by an HTTP trigger, e.g. /customers/:id
+func (t *Trigger) SetHttpRoute(route string) {
+	t.setAttributes(attribute.String("http.route", route))
+}
+
+// SetHttpStatus records the response status of an HTTP trigger, server error statuses are recorded as failed triggers
+func (t *Trigger) SetHttpStatus(statusCode int) {
+	t.httpStatus = statusCode
+	t.setAttributes(attribute.Int("http.response.status_code", statusCode))
+}
+
+// setAttributes adds attributes to both the trigger's span and metrics
+func (t *Trigger) setAttributes(attributes ...attribute.KeyValue) {
+	t.attributes = append(t.attributes, attributes...)
+	t.span.SetAttributes(attributes...)
+}
+
+// End records the duration and result of the trigger
+func (t *Trigger) End(err error) {
+	duration := time.Since(t.start)
+
+	if err == nil && t.httpStatus >= http.StatusInternalServerError {
+		err = fmt.Errorf("%s %s responded with status %d", t.kind, t.name, t.httpStatus)
+	}
+
+	if err != nil {
+		logger.DebugContext(t.ctx, "trigger failed", "duration", duration, "error", err)
+	} else {
+		logger.DebugContext(t.ctx, "trigger handled", "duration", duration)
+	}
+
+	metrics.RecordTrigger(t.ctx, t.kind, t.name, duration, err, t.attributes...)
+	tracing.EndSpan(t.span, err)
+}
@@ -15,13 +15,18 @@
 package websockets
 
 import (
+	"context"
 	"fmt"
 	"strings"
 	"sync"
+	"time"
 
+	"github.com/nitrictech/nitric/core/pkg/env"
 	"github.com/nitrictech/nitric/core/pkg/help"
 	websocketspb "github.com/nitrictech/nitric/core/pkg/proto/websockets/v1"
+	"github.com/nitrictech/nitric/core/pkg/tracing"
 	workers "github.com/nitrictech/nitric/core/pkg/workers"
+	"go.opentelemetry.io/otel/attribute"
 )
 
 // WorkerConnection manages communication between websocket and worker
@@ -29,14 +34,18 @@ type WorkerConnection = workers.WorkerRequestBroker[*websocketspb.ServerMessage,
 
 type WebsocketRequestHandler interface {
 	websocketspb.WebsocketHandlerServer
-	HandleRequest(request *websocketspb.ServerMessage) (*websocketspb.ClientMessage, error)
+	HandleRequest(ctx context.Context, request *websocketspb.ServerMessage) (*websocketspb.ClientMessage, error)
 	WorkerCount() int
+	Workers() []workers.WorkerInfo
 }
 
 // WebsocketManager manages connections and event handlers for websockets
 type WebsocketManager struct {
 	handlers map[string]*WorkerConnection
-	mutex    sync.RWMutex
+	// the registration of each handler, used to describe the registered handlers
+	registrations map[string]*websocketspb.RegistrationRequest
+	mutex         sync.RWMutex
+	timeout       time.Duration
 }
 
 // generateHandlerKey creates a unique identifier for a websocket event handler
@@ -64,6 +73,7 @@ func (wm *WebsocketManager) registerHandler(handler *WorkerConnection, registrat
 	}
 
 	wm.handlers[handlerKey] = handler
+	wm.registrations[handlerKey] = registrationRequest
 	return nil
 }
 
@@ -81,6 +91,28 @@ func (wm *WebsocketManager) unregisterHandler(handler *WorkerConnection) {
 	}
 
 	delete(wm.handlers, resultKey)
+	delete(wm.registrations, resultKey)
+}
+
+// Workers returns the handler registered for each socket and event type
+func (wm *WebsocketManager) Workers() []workers.WorkerInfo {
+	wm.mutex.RLock()
+	defer wm.mutex.RUnlock()
+
+	info := []workers.WorkerInfo{}
+	for handlerKey, handler := range wm.handlers {
+		registration := wm.registrations[handlerKey]
+
+		info = append(info, workers.WorkerInfo{
+			Kind:     "websocket",
+			Name:     registration.GetSocketName(),
+			Target:   registration.GetEventType().String(),
+			Workers:  1,
+			InFlight: handler.InFlight(),
+		})
+	}
+
+	return workers.SortWorkerInfo(info)
 }
 
 // ManageEventHandlers handles the registration of new websocket event handlers
@@ -95,7 +127,7 @@ func (wm *WebsocketManager) HandleEvents(stream websocketspb.WebsocketHandler_Ha
 		return fmt.Errorf("unregistered websocket handler, expected a registration request. %s", help.BugInNitricHelpText())
 	}
 
-	handler := workers.NewWorkerRequestBroker[*websocketspb.ServerMessage, *websocketspb.ClientMessage](stream)
+	handler := workers.NewWorkerRequestBroker[*websocketspb.ServerMessage, *websocketspb.ClientMessage](stream, newCancelMessage)
 	if err := wm.registerHandler(handler, registration); err != nil {
 		return err
 	}
@@ -129,8 +161,17 @@ func (wm *WebsocketManager) FindMatchingHandler(socketName string, eventType web
 	return handler, nil
 }
 
+func newCancelMessage(id workers.RequestIdentifier) *websocketspb.ServerMessage {
+	return &websocketspb.ServerMessage{
+		Id: id,
+		Content: &websocketspb.ServerMessage_CancelRequest{
+			CancelRequest: &websocketspb.CancelRequest{},
+		},
+	}
+}
+
 // HandleRequest handles incoming requests and forwards them to the appropriate handler
-func (wm *WebsocketManager) HandleRequest(request *websocketspb.ServerMessage) (*websocketspb.ClientMessage, error) {
+func (wm *WebsocketManager) HandleRequest(ctx context.Context, request *websocketspb.ServerMessage) (_ *websocketspb.ClientMessage, err error) {
 	eventRequest := request.GetWebsocketEventRequest()
 	if eventRequest == nil {
 		return nil, fmt.Errorf("invalid request, expected a websocket event request. %s", help.BugInNitricHelpText())
@@ -143,12 +184,23 @@ func (wm *WebsocketManager) HandleRequest(request *websocketspb.ServerMessage) (
 	socketName := eventRequest.SocketName
 	eventType := determineEventType(eventRequest)
 
+	ctx, trigger := workers.StartTrigger(ctx, "websocket", socketName, request.GetId(),
+		attribute.String("nitric.websocket", socketName),
+		attribute.String("nitric.websocket.event_type", eventType.String()),
+	)
+	defer func() { trigger.End(err) }()
+
+	request.TraceContext = tracing.Inject(ctx)
+
 	handler, err := wm.FindMatchingHandler(socketName, eventType)
 	if err != nil {
 		return nil, err
 	}
 
-	response, err := handler.Send(request)
+	ctx, cancel := workers.WithTriggerTimeout(ctx, wm.timeout)
+	defer cancel()
+
+	response, err := handler.Send(ctx, request)
 	if err != nil {
 		return nil, err
 	}
@@ -169,7 +221,9 @@ func determineEventType(eventRequest *websocketspb.WebsocketEventRequest) websoc
 // NewWebsocketManager creates a new instance of WebsocketManager
 func NewWebsocketManager() *WebsocketManager {
 	return &WebsocketManager{
-		handlers: make(map[string]*WorkerConnection),
-		mutex:    sync.RWMutex{},
+		handlers:      make(map[string]*WorkerConnection),
+		registrations: make(map[string]*websocketspb.RegistrationRequest),
+		mutex:         sync.RWMutex{},
+		timeout:       workers.TriggerTimeout(env.WEBSOCKET_TIMEOUT),
 	}
 }